@@ -39,6 +39,8 @@ type PreBlockHandler struct { //golint:ignore
 
 // NewOraclePreBlockHandler returns a new PreBlockHandler. The handler
 // is responsible for writing oracle data included in vote extensions to state.
+// The given options configure the price applier used to write the prices,
+// i.e. to guard against large price movements.
 func NewOraclePreBlockHandler(
 	logger log.Logger,
 	aggregateFn aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int],
@@ -47,6 +49,7 @@ func NewOraclePreBlockHandler(
 	strategy currencypair.CurrencyPairStrategy,
 	veCodec codec.VoteExtensionCodec,
 	ecCodec codec.ExtendedCommitCodec,
+	opts ...abciaggregator.PriceApplierOption,
 ) *PreBlockHandler {
 	va := abciaggregator.NewDefaultVoteAggregator(
 		logger,
@@ -59,6 +62,7 @@ func NewOraclePreBlockHandler(
 		veCodec,
 		ecCodec,
 		logger,
+		append([]abciaggregator.PriceApplierOption{abciaggregator.WithMetrics(metrics)}, opts...)...,
 	)
 
 	return &PreBlockHandler{
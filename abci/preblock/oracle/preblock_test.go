@@ -118,6 +118,7 @@ func (s *PreBlockTestSuite) SetupSubTest() {
 	aggregationFn := voteweighted.MedianFromContext(
 		log.NewTestLogger(s.T()),
 		mockValidatorStore,
+		nil,
 		voteweighted.DefaultPowerThreshold,
 	)
 
@@ -145,6 +146,7 @@ func (s *PreBlockTestSuite) TestPreBlocker() {
 	aggregationFn := voteweighted.MedianFromContext(
 		log.NewTestLogger(s.T()),
 		mockValidatorStore,
+		nil,
 		voteweighted.DefaultPowerThreshold,
 	)
 
@@ -0,0 +1,24 @@
+package aggregator
+
+import (
+	servicemetrics "github.com/skip-mev/slinky/service/metrics"
+)
+
+// PriceApplierOption is a function that enables optional configuration of the oraclePriceApplier.
+type PriceApplierOption func(*oraclePriceApplier)
+
+// WithPriceGuard returns a PriceApplierOption that configures the oraclePriceApplier to hold back
+// price updates that deviate too far from the previous price, as configured by the price guard.
+func WithPriceGuard(guard *PriceGuard) PriceApplierOption {
+	return func(opa *oraclePriceApplier) {
+		opa.priceGuard = guard
+	}
+}
+
+// WithMetrics returns a PriceApplierOption that configures the metrics used by the
+// oraclePriceApplier to report held back price updates.
+func WithMetrics(metrics servicemetrics.Metrics) PriceApplierOption {
+	return func(opa *oraclePriceApplier) {
+		opa.metrics = metrics
+	}
+}
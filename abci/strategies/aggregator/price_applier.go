@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/slinky/abci/strategies/codec"
 	slinkyabcitypes "github.com/skip-mev/slinky/abci/types"
+	servicemetrics "github.com/skip-mev/slinky/service/metrics"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
@@ -22,7 +23,8 @@ type PriceApplier interface {
 	// ApplyPricesFromVoteExtensions derives the aggregate prices per asset in accordance with the given
 	// vote extensions + VoteAggregator. If a price exists for an asset, it is written to state. The
 	// prices aggregated from vote-extensions are returned if no errors are encountered in execution,
-	// otherwise an error is returned + nil prices.
+	// otherwise an error is returned + nil prices. Prices held back by the price guard are returned
+	// as written to state, i.e. capped prices are returned and rejected prices are omitted.
 	ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[slinkytypes.CurrencyPair]*big.Int, error)
 
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
@@ -44,6 +46,13 @@ type oraclePriceApplier struct {
 	// codecs
 	voteExtensionCodec  codec.VoteExtensionCodec
 	extendedCommitCodec codec.ExtendedCommitCodec
+
+	// priceGuard holds back price updates that deviate too far from the previous price. Price
+	// updates are not guarded if this is nil.
+	priceGuard *PriceGuard
+
+	// metrics is used to report held back price updates.
+	metrics servicemetrics.Metrics
 }
 
 // NewOraclePriceApplier returns a new oraclePriceApplier.
@@ -53,14 +62,22 @@ func NewOraclePriceApplier(
 	voteExtensionCodec codec.VoteExtensionCodec,
 	extendedCommitCodec codec.ExtendedCommitCodec,
 	logger log.Logger,
+	opts ...PriceApplierOption,
 ) PriceApplier {
-	return &oraclePriceApplier{
+	opa := &oraclePriceApplier{
 		va:                  va,
 		ok:                  ok,
 		logger:              logger,
 		voteExtensionCodec:  voteExtensionCodec,
 		extendedCommitCodec: extendedCommitCodec,
+		metrics:             servicemetrics.NewNopMetrics(),
 	}
+
+	for _, opt := range opts {
+		opt(opa)
+	}
+
+	return opa
 }
 
 func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[slinkytypes.CurrencyPair]*big.Int, error) {
@@ -121,6 +138,16 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			continue
 		}
 
+		if opa.priceGuard != nil && opa.priceGuard.Guards(cp) {
+			price = opa.guardPrice(ctx, cp, price)
+			if price == nil {
+				delete(prices, cp)
+				continue
+			}
+
+			prices[cp] = price
+		}
+
 		// Convert the price to a quote price and write it to state.
 		quotePrice := oracletypes.QuotePrice{
 			Price:          math.NewIntFromBigInt(price),
@@ -149,6 +176,48 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 	return prices, nil
 }
 
+// guardPrice checks the price update of the given currency pair against its previous price. The
+// price to write to state is returned, which is nil if the price update is rejected. An event is
+// emitted and a metric is reported if the price update is held back.
+func (opa *oraclePriceApplier) guardPrice(ctx sdk.Context, cp slinkytypes.CurrencyPair, price *big.Int) *big.Int {
+	previous, err := opa.ok.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		// There is no previous price to guard against.
+		return price
+	}
+
+	guardedPrice, mode, heldBack := opa.priceGuard.Check(cp, price, previous, uint64(ctx.BlockHeight()))
+	if !heldBack {
+		return price
+	}
+
+	appliedPrice := previous.Price.String()
+	if guardedPrice != nil {
+		appliedPrice = guardedPrice.String()
+	}
+
+	opa.logger.Info(
+		"price update deviates too far from previous price; holding back price",
+		"currency_pair", cp.String(),
+		"previous_price", previous.Price.String(),
+		"aggregated_price", price.String(),
+		"applied_price", appliedPrice,
+		"status", mode.String(),
+	)
+
+	opa.metrics.AddHeldBackPriceForTicker(cp, mode)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		oracletypes.EventTypeHeldBackPrice,
+		sdk.NewAttribute(oracletypes.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(oracletypes.AttributeKeyPreviousPrice, previous.Price.String()),
+		sdk.NewAttribute(oracletypes.AttributeKeyAggregatedPrice, price.String()),
+		sdk.NewAttribute(oracletypes.AttributeKeyAppliedPrice, appliedPrice),
+		sdk.NewAttribute(oracletypes.AttributeKeyStatus, mode.String()),
+	))
+
+	return guardedPrice
+}
+
 func (opa *oraclePriceApplier) GetPricesForValidator(validator sdk.ConsAddress) map[slinkytypes.CurrencyPair]*big.Int {
 	return opa.va.GetPriceForValidator(validator)
 }
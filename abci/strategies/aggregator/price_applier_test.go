@@ -15,9 +15,11 @@ import (
 	abcimocks "github.com/skip-mev/slinky/abci/types/mocks"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	vetypes "github.com/skip-mev/slinky/abci/ve/types"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	metricsmocks "github.com/skip-mev/slinky/service/metrics/mocks"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -228,3 +230,114 @@ func TestPriceApplier(t *testing.T) {
 		require.Equal(t, expPrices, valPrices)
 	})
 }
+
+func TestPriceApplierWithPriceGuard(t *testing.T) {
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+	extCommitcodec := codec.NewDefaultExtendedCommitCodec()
+
+	va := mocks.NewVoteAggregator(t)
+	ok := abcimocks.NewOracleKeeper(t)
+	metrics := metricsmocks.NewMetrics(t)
+
+	btcusd := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethusd := slinkytypes.NewCurrencyPair("ETH", "USD")
+	solusd := slinkytypes.NewCurrencyPair("SOL", "USD")
+
+	guard, err := aggregator.NewPriceGuard(map[slinkytypes.CurrencyPair]aggregator.PriceGuardConfig{
+		btcusd: {
+			MaxDeviation: math.LegacyNewDecWithPrec(1, 1),
+			Mode:         aggregator.CapPriceMovement,
+		},
+		ethusd: {
+			MaxDeviation: math.LegacyNewDecWithPrec(1, 1),
+			Mode:         aggregator.RejectPriceMovement,
+		},
+	})
+	require.NoError(t, err)
+
+	pa := aggregator.NewOraclePriceApplier(
+		va,
+		ok,
+		veCodec,
+		extCommitcodec,
+		log.NewNopLogger(),
+		aggregator.WithPriceGuard(guard),
+		aggregator.WithMetrics(metrics),
+	)
+
+	ca := sdk.ConsAddress("val1")
+	vote, err := testutils.CreateExtendedVoteInfo(
+		ca,
+		map[uint64][]byte{
+			1: big.NewInt(200).Bytes(),
+		},
+		veCodec,
+	)
+	require.NoError(t, err)
+
+	_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+		[]abcitypes.ExtendedVoteInfo{vote},
+		extCommitcodec,
+	)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{
+		Time: time.Now(),
+	}).WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+
+	va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[slinkytypes.CurrencyPair]*big.Int{
+		btcusd: big.NewInt(200),
+		ethusd: big.NewInt(200),
+		solusd: big.NewInt(200),
+	}, nil)
+
+	ok.On("GetAllCurrencyPairs", ctx).Return(
+		[]slinkytypes.CurrencyPair{btcusd, ethusd, solusd},
+	)
+
+	previous := oracletypes.QuotePrice{
+		Price:       math.NewInt(100),
+		BlockHeight: 1,
+	}
+	ok.On("GetPriceForCurrencyPair", ctx, btcusd).Return(previous, nil)
+	ok.On("GetPriceForCurrencyPair", ctx, ethusd).Return(previous, nil)
+
+	// BTC/USD is capped at 10% above the previous price, ETH/USD is rejected, and SOL/USD is
+	// not guarded.
+	ok.On("SetPriceForCurrencyPair", ctx, btcusd, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		qp := args.Get(2).(oracletypes.QuotePrice)
+		require.Equal(t, big.NewInt(110), qp.Price.BigInt())
+	}).Once()
+	ok.On("SetPriceForCurrencyPair", ctx, solusd, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		qp := args.Get(2).(oracletypes.QuotePrice)
+		require.Equal(t, big.NewInt(200), qp.Price.BigInt())
+	}).Once()
+
+	metrics.On("AddHeldBackPriceForTicker", btcusd, aggregator.CapPriceMovement).Once()
+	metrics.On("AddHeldBackPriceForTicker", ethusd, aggregator.RejectPriceMovement).Once()
+
+	prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+		Txs: [][]byte{extCommitInfoBz},
+	})
+	require.NoError(t, err)
+
+	// The returned prices match the prices written to state.
+	require.Equal(t, map[slinkytypes.CurrencyPair]*big.Int{
+		btcusd: big.NewInt(110),
+		solusd: big.NewInt(200),
+	}, prices)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	for _, event := range events {
+		require.Equal(t, oracletypes.EventTypeHeldBackPrice, event.Type)
+	}
+
+	appliedPrice, found := events[0].GetAttribute(oracletypes.AttributeKeyAppliedPrice)
+	require.True(t, found)
+	require.Equal(t, "110", appliedPrice.Value)
+
+	appliedPrice, found = events[1].GetAttribute(oracletypes.AttributeKeyAppliedPrice)
+	require.True(t, found)
+	require.Equal(t, "100", appliedPrice.Value)
+}
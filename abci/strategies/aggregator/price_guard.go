@@ -0,0 +1,139 @@
+package aggregator
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// PriceGuardMode determines how a price update that deviates too far from the previous price
+// is held back.
+type PriceGuardMode int
+
+const (
+	// CapPriceMovement caps the price update at the maximum deviation from the previous price.
+	CapPriceMovement PriceGuardMode = iota
+	// RejectPriceMovement rejects the price update, keeping the previous price in state.
+	RejectPriceMovement
+)
+
+func (m PriceGuardMode) String() string {
+	switch m {
+	case CapPriceMovement:
+		return "capped"
+	case RejectPriceMovement:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+// Label returns the label of the mode, this is used to label held back price metrics.
+func (m PriceGuardMode) Label() string {
+	return m.String()
+}
+
+// PriceGuardConfig configures the maximum price movement of a single market.
+type PriceGuardConfig struct {
+	// MaxDeviation is the maximum fraction (i.e. 0.1 for 10%) by which a price update may
+	// deviate from the previous price. This must be less than 1 when capping price updates,
+	// so that a capped price is always positive.
+	MaxDeviation math.LegacyDec
+
+	// Blocks is the number of blocks after the previous price was written during which price
+	// updates are guarded. Price updates are applied as is once the previous price is older.
+	// Price updates are always guarded if this is zero.
+	Blocks uint64
+
+	// Mode determines whether price updates that deviate too far are capped or rejected.
+	Mode PriceGuardMode
+}
+
+// ValidateBasic performs basic validation of the price guard config.
+func (c PriceGuardConfig) ValidateBasic() error {
+	if c.MaxDeviation.IsNil() || !c.MaxDeviation.IsPositive() {
+		return fmt.Errorf("max deviation must be positive")
+	}
+
+	if c.Mode == CapPriceMovement && c.MaxDeviation.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("max deviation must be less than 1 when capping price movements")
+	}
+
+	if c.Mode != CapPriceMovement && c.Mode != RejectPriceMovement {
+		return fmt.Errorf("invalid price guard mode %d", c.Mode)
+	}
+
+	return nil
+}
+
+// PriceGuard limits how far the price of a market can move from its previous price within a
+// number of blocks. This prevents a coordinated or faulty set of validators from moving a price
+// arbitrarily far in a single block. Markets without a config are not guarded.
+type PriceGuard struct {
+	configs map[slinkytypes.CurrencyPair]PriceGuardConfig
+}
+
+// NewPriceGuard returns a new PriceGuard that guards the given markets.
+func NewPriceGuard(configs map[slinkytypes.CurrencyPair]PriceGuardConfig) (*PriceGuard, error) {
+	for cp, cfg := range configs {
+		if err := cfg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid price guard config for %s: %w", cp.String(), err)
+		}
+	}
+
+	return &PriceGuard{
+		configs: configs,
+	}, nil
+}
+
+// Guards returns true if the price updates of the given currency pair are guarded.
+func (g *PriceGuard) Guards(cp slinkytypes.CurrencyPair) bool {
+	_, ok := g.configs[cp]
+	return ok
+}
+
+// Check checks the price update of the given currency pair against the previous price. If the
+// price update deviates too far from the previous price, the price to write to state (nil if
+// the update is rejected) and the mode used to hold back the update are returned, along with
+// true. Otherwise, the price update is returned as is, along with false.
+func (g *PriceGuard) Check(
+	cp slinkytypes.CurrencyPair,
+	price *big.Int,
+	previous oracletypes.QuotePrice,
+	height uint64,
+) (*big.Int, PriceGuardMode, bool) {
+	cfg, ok := g.configs[cp]
+	if !ok || previous.Price.IsNil() || !previous.Price.IsPositive() {
+		return price, cfg.Mode, false
+	}
+
+	// The price update is not guarded if the previous price is outside of the window.
+	if cfg.Blocks != 0 && height > previous.BlockHeight && height-previous.BlockHeight > cfg.Blocks {
+		return price, cfg.Mode, false
+	}
+
+	previousPrice := previous.Price.BigInt()
+	maxMovement := math.LegacyNewDecFromBigInt(previousPrice).Mul(cfg.MaxDeviation).TruncateInt().BigInt()
+
+	movement := new(big.Int).Sub(price, previousPrice)
+	if new(big.Int).Abs(movement).Cmp(maxMovement) <= 0 {
+		return price, cfg.Mode, false
+	}
+
+	if cfg.Mode == RejectPriceMovement {
+		return nil, cfg.Mode, true
+	}
+
+	// Cap the price at the maximum deviation in the direction of the price update. The capped
+	// price is always positive, as the max deviation is less than 1.
+	capped := new(big.Int).Add(previousPrice, maxMovement)
+	if movement.Sign() < 0 {
+		capped = new(big.Int).Sub(previousPrice, maxMovement)
+	}
+
+	return capped, cfg.Mode, true
+}
@@ -0,0 +1,197 @@
+package aggregator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/abci/strategies/aggregator"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestNewPriceGuard(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	testCases := []struct {
+		name      string
+		cfg       aggregator.PriceGuardConfig
+		expectErr bool
+	}{
+		{
+			name: "valid config",
+			cfg: aggregator.PriceGuardConfig{
+				MaxDeviation: math.LegacyNewDecWithPrec(1, 1),
+				Blocks:       10,
+				Mode:         aggregator.RejectPriceMovement,
+			},
+		},
+		{
+			name: "nil max deviation",
+			cfg: aggregator.PriceGuardConfig{
+				Blocks: 10,
+			},
+			expectErr: true,
+		},
+		{
+			name: "zero max deviation",
+			cfg: aggregator.PriceGuardConfig{
+				MaxDeviation: math.LegacyZeroDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "max deviation of 1 when capping",
+			cfg: aggregator.PriceGuardConfig{
+				MaxDeviation: math.LegacyOneDec(),
+				Mode:         aggregator.CapPriceMovement,
+			},
+			expectErr: true,
+		},
+		{
+			name: "max deviation greater than 1 when rejecting",
+			cfg: aggregator.PriceGuardConfig{
+				MaxDeviation: math.LegacyNewDec(2),
+				Mode:         aggregator.RejectPriceMovement,
+			},
+		},
+		{
+			name: "invalid mode",
+			cfg: aggregator.PriceGuardConfig{
+				MaxDeviation: math.LegacyNewDecWithPrec(1, 1),
+				Mode:         aggregator.PriceGuardMode(2),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			guard, err := aggregator.NewPriceGuard(map[slinkytypes.CurrencyPair]aggregator.PriceGuardConfig{
+				cp: tc.cfg,
+			})
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, guard.Guards(cp))
+			require.False(t, guard.Guards(slinkytypes.NewCurrencyPair("ETH", "USD")))
+		})
+	}
+}
+
+func TestPriceGuardCheck(t *testing.T) {
+	btcusd := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethusd := slinkytypes.NewCurrencyPair("ETH", "USD")
+	solusd := slinkytypes.NewCurrencyPair("SOL", "USD")
+
+	guard, err := aggregator.NewPriceGuard(map[slinkytypes.CurrencyPair]aggregator.PriceGuardConfig{
+		btcusd: {
+			MaxDeviation: math.LegacyNewDecWithPrec(1, 1),
+			Blocks:       10,
+			Mode:         aggregator.CapPriceMovement,
+		},
+		ethusd: {
+			MaxDeviation: math.LegacyNewDecWithPrec(1, 1),
+			Mode:         aggregator.RejectPriceMovement,
+		},
+	})
+	require.NoError(t, err)
+
+	previous := oracletypes.QuotePrice{
+		Price:       math.NewInt(1000),
+		BlockHeight: 100,
+	}
+
+	testCases := []struct {
+		name          string
+		cp            slinkytypes.CurrencyPair
+		price         *big.Int
+		previous      oracletypes.QuotePrice
+		height        uint64
+		expectedPrice *big.Int
+		expectedMode  aggregator.PriceGuardMode
+		heldBack      bool
+	}{
+		{
+			name:          "price within max deviation",
+			cp:            btcusd,
+			price:         big.NewInt(1100),
+			previous:      previous,
+			height:        101,
+			expectedPrice: big.NewInt(1100),
+		},
+		{
+			name:          "price increase is capped",
+			cp:            btcusd,
+			price:         big.NewInt(1900),
+			previous:      previous,
+			height:        101,
+			expectedPrice: big.NewInt(1100),
+			expectedMode:  aggregator.CapPriceMovement,
+			heldBack:      true,
+		},
+		{
+			name:          "price decrease is capped",
+			cp:            btcusd,
+			price:         big.NewInt(100),
+			previous:      previous,
+			height:        110,
+			expectedPrice: big.NewInt(900),
+			expectedMode:  aggregator.CapPriceMovement,
+			heldBack:      true,
+		},
+		{
+			name:          "previous price outside of window",
+			cp:            btcusd,
+			price:         big.NewInt(1900),
+			previous:      previous,
+			height:        111,
+			expectedPrice: big.NewInt(1900),
+		},
+		{
+			name:          "price update is rejected",
+			cp:            ethusd,
+			price:         big.NewInt(1200),
+			previous:      previous,
+			height:        1000,
+			expectedPrice: nil,
+			expectedMode:  aggregator.RejectPriceMovement,
+			heldBack:      true,
+		},
+		{
+			name:  "zero previous price",
+			cp:    ethusd,
+			price: big.NewInt(1200),
+			previous: oracletypes.QuotePrice{
+				Price:       math.ZeroInt(),
+				BlockHeight: 100,
+			},
+			height:        101,
+			expectedPrice: big.NewInt(1200),
+		},
+		{
+			name:          "market is not guarded",
+			cp:            solusd,
+			price:         big.NewInt(1900),
+			previous:      previous,
+			height:        101,
+			expectedPrice: big.NewInt(1900),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, mode, heldBack := guard.Check(tc.cp, tc.price, tc.previous, tc.height)
+			require.Equal(t, tc.expectedPrice, price)
+			require.Equal(t, tc.heldBack, heldBack)
+			if tc.heldBack {
+				require.Equal(t, tc.expectedMode, mode)
+			}
+		})
+	}
+}
@@ -86,6 +86,7 @@ func (s *VoteAggregatorTestSuite) TestAggregateOracleVotes() {
 	aggregationFn := voteweighted.MedianFromContext(
 		log.NewTestLogger(s.T()),
 		mockValidatorStore,
+		nil,
 		voteweighted.DefaultPowerThreshold,
 	)
 	mockValidatorStore.On("TotalBondedTokens", mock.Anything).Return(math.NewInt(100), nil)
@@ -0,0 +1,173 @@
+package codec_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	compression "github.com/skip-mev/slinky/abci/strategies/codec"
+	vetypes "github.com/skip-mev/slinky/abci/ve/types"
+)
+
+var (
+	benchmarkNumPairs      = []int{10, 100, 500}
+	benchmarkNumValidators = 100
+)
+
+type namedVoteExtensionCodec struct {
+	name  string
+	codec compression.VoteExtensionCodec
+}
+
+type namedExtendedCommitCodec struct {
+	name  string
+	codec compression.ExtendedCommitCodec
+}
+
+var (
+	benchmarkVoteExtensionCodecs = []namedVoteExtensionCodec{
+		{"default", compression.NewDefaultVoteExtensionCodec()},
+		{"zlib", compression.NewCompressionVoteExtensionCodec(
+			compression.NewDefaultVoteExtensionCodec(),
+			compression.NewZLibCompressor(),
+		)},
+		{"zstd", compression.NewCompressionVoteExtensionCodec(
+			compression.NewDefaultVoteExtensionCodec(),
+			compression.NewZStdCompressor(),
+		)},
+		{"compact", compression.NewCompactVoteExtensionCodec()},
+		{"compact_zstd", compression.NewCompressionVoteExtensionCodec(
+			compression.NewCompactVoteExtensionCodec(),
+			compression.NewZStdCompressor(),
+		)},
+	}
+
+	benchmarkExtendedCommitCodecs = []namedExtendedCommitCodec{
+		{"default", compression.NewDefaultExtendedCommitCodec()},
+		{"zstd", compression.NewCompressionExtendedCommitCodec(
+			compression.NewDefaultExtendedCommitCodec(),
+			compression.NewZStdCompressor(),
+		)},
+		{"compact", compression.NewCompactExtendedCommitCodec()},
+		{"compact_zstd", compression.NewCompressionExtendedCommitCodec(
+			compression.NewCompactExtendedCommitCodec(),
+			compression.NewZStdCompressor(),
+		)},
+	}
+)
+
+// benchmarkVoteExtension returns a vote extension with the given number of delta encoded prices,
+// as produced by the DeltaCurrencyPairStrategy.
+func benchmarkVoteExtension(b *testing.B, numPairs int) vetypes.OracleVoteExtension {
+	b.Helper()
+
+	ve := vetypes.OracleVoteExtension{
+		Prices: make(map[uint64][]byte, numPairs),
+	}
+	for i := 0; i < numPairs; i++ {
+		delta := big.NewInt(int64((i*7919)%20_000 - 10_000))
+
+		bz, err := delta.GobEncode()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		ve.Prices[uint64(i)] = bz
+	}
+
+	return ve
+}
+
+// benchmarkExtendedCommitInfo returns an extended commit info with a vote for each validator,
+// each of which includes the given vote extension.
+func benchmarkExtendedCommitInfo(numValidators int, voteExtension []byte) cmtabci.ExtendedCommitInfo {
+	votes := make([]cmtabci.ExtendedVoteInfo, numValidators)
+	for i := range votes {
+		address := make([]byte, 20)
+		signature := make([]byte, 64)
+		for j := range address {
+			address[j] = byte(i + j)
+		}
+		for j := range signature {
+			signature[j] = byte(i * j)
+		}
+
+		votes[i] = cmtabci.ExtendedVoteInfo{
+			Validator: cmtabci.Validator{
+				Address: address,
+				Power:   int64(1_000_000 + i),
+			},
+			VoteExtension:      voteExtension,
+			ExtensionSignature: signature,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		}
+	}
+
+	return cmtabci.ExtendedCommitInfo{
+		Round: 0,
+		Votes: votes,
+	}
+}
+
+func BenchmarkVoteExtensionCodecs(b *testing.B) {
+	for _, numPairs := range benchmarkNumPairs {
+		ve := benchmarkVoteExtension(b, numPairs)
+
+		for _, c := range benchmarkVoteExtensionCodecs {
+			codec := c.codec
+			b.Run(fmt.Sprintf("%s/pairs=%d", c.name, numPairs), func(b *testing.B) {
+				var bz []byte
+				for i := 0; i < b.N; i++ {
+					var err error
+					if bz, err = codec.Encode(ve); err != nil {
+						b.Fatal(err)
+					}
+
+					if _, err = codec.Decode(bz); err != nil {
+						b.Fatal(err)
+					}
+				}
+
+				b.ReportMetric(float64(len(bz)), "bytes")
+			})
+		}
+	}
+}
+
+func BenchmarkExtendedCommitCodecs(b *testing.B) {
+	for _, numPairs := range benchmarkNumPairs {
+		ve := benchmarkVoteExtension(b, numPairs)
+
+		// Each codec pair is benchmarked as it would be configured on a chain.
+		for _, veCodec := range benchmarkVoteExtensionCodecs {
+			voteExtension, err := veCodec.codec.Encode(ve)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			eci := benchmarkExtendedCommitInfo(benchmarkNumValidators, voteExtension)
+
+			for _, ecCodec := range benchmarkExtendedCommitCodecs {
+				codec := ecCodec.codec
+				b.Run(fmt.Sprintf("%s+%s/pairs=%d", veCodec.name, ecCodec.name, numPairs), func(b *testing.B) {
+					var bz []byte
+					for i := 0; i < b.N; i++ {
+						var err error
+						if bz, err = codec.Encode(eci); err != nil {
+							b.Fatal(err)
+						}
+
+						if _, err = codec.Decode(bz); err != nil {
+							b.Fatal(err)
+						}
+					}
+
+					b.ReportMetric(float64(len(bz)), "bytes")
+				})
+			}
+		}
+	}
+}
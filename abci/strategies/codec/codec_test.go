@@ -1,11 +1,14 @@
 package codec_test
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	compression "github.com/skip-mev/slinky/abci/strategies/codec"
 	vetypes "github.com/skip-mev/slinky/abci/ve/types"
@@ -158,3 +161,178 @@ func TestCompressionExtendedCommitCodec(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCompactVoteExtensionCodec(t *testing.T) {
+	t.Run("test encoding / decoding", func(t *testing.T) {
+		ve := vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{
+				0:              gobEncode(t, big.NewInt(0)),
+				1:              gobEncode(t, big.NewInt(1)),
+				3:              gobEncode(t, big.NewInt(-1)),
+				7:              gobEncode(t, big.NewInt(1_000_000_000)),
+				8:              gobEncode(t, big.NewInt(-1_000_000_000)),
+				20:             gobEncode(t, new(big.Int).Lsh(big.NewInt(1), 61)),
+				21:             gobEncode(t, new(big.Int).Lsh(big.NewInt(1), 62)),
+				22:             gobEncode(t, new(big.Int).Lsh(big.NewInt(1), 128)),
+				30:             []byte("not a gob encoded price"),
+				31:             {0x02, 0x00, 0x01},
+				32:             {},
+				math.MaxUint64: gobEncode(t, big.NewInt(100)),
+			},
+		}
+
+		codec := compression.NewCompactVoteExtensionCodec()
+		bz, err := codec.Encode(ve)
+		require.NoError(t, err)
+
+		decodedVe, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decodedVe.Prices)
+	})
+
+	t.Run("test encoding is smaller than the default codec", func(t *testing.T) {
+		ve := vetypes.OracleVoteExtension{
+			Prices: make(map[uint64][]byte),
+		}
+		for i := uint64(0); i < 200; i++ {
+			ve.Prices[i] = gobEncode(t, big.NewInt(int64(i)*1_000_000))
+		}
+
+		defaultBz, err := compression.NewDefaultVoteExtensionCodec().Encode(ve)
+		require.NoError(t, err)
+
+		compactBz, err := compression.NewCompactVoteExtensionCodec().Encode(ve)
+		require.NoError(t, err)
+		require.Less(t, len(compactBz), len(defaultBz))
+	})
+
+	t.Run("test encoding / decoding empty vote extension", func(t *testing.T) {
+		codec := compression.NewCompactVoteExtensionCodec()
+		bz, err := codec.Encode(vetypes.OracleVoteExtension{})
+		require.NoError(t, err)
+		require.Empty(t, bz)
+
+		decodedVe, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Empty(t, decodedVe.Prices)
+	})
+
+	t.Run("test decoding invalid byte arrays", func(t *testing.T) {
+		codec := compression.NewCompactVoteExtensionCodec()
+
+		testCases := []struct {
+			name string
+			bz   []byte
+		}{
+			{"unsupported version", []byte{0x02, 0x01, 0x00, 0x00}},
+			{"count exceeds input", []byte{0x01, 0x05, 0x00, 0x00}},
+			{"duplicate id", []byte{0x01, 0x02, 0x01, 0x00, 0x00, 0x00}},
+			{"truncated price", []byte{0x01, 0x01, 0x00, 0x07, 0x01}},
+			{"trailing bytes", []byte{0x01, 0x01, 0x00, 0x00, 0x00}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := codec.Decode(tc.bz)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestCompactExtendedCommitCodec(t *testing.T) {
+	t.Run("test encoding / decoding", func(t *testing.T) {
+		eci := cmtabci.ExtendedCommitInfo{
+			Round: -1,
+			Votes: []cmtabci.ExtendedVoteInfo{
+				{
+					Validator: cmtabci.Validator{
+						Address: []byte("1"),
+						Power:   10,
+					},
+					VoteExtension:      []byte("1"),
+					ExtensionSignature: []byte("1"),
+					BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+				},
+				{
+					Validator: cmtabci.Validator{
+						Address: []byte("2"),
+						Power:   -5,
+					},
+					BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+				},
+			},
+		}
+
+		codec := compression.NewCompactExtendedCommitCodec()
+		bz, err := codec.Encode(eci)
+		require.NoError(t, err)
+
+		decodedEci, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, eci, decodedEci)
+	})
+
+	t.Run("test encoding / decoding with compression", func(t *testing.T) {
+		eci := cmtabci.ExtendedCommitInfo{
+			Round: 1,
+			Votes: []cmtabci.ExtendedVoteInfo{
+				{
+					Validator: cmtabci.Validator{
+						Address: []byte("1"),
+						Power:   10,
+					},
+					VoteExtension:      []byte("1"),
+					ExtensionSignature: []byte("1"),
+				},
+			},
+		}
+
+		codec := compression.NewCompressionExtendedCommitCodec(
+			compression.NewCompactExtendedCommitCodec(),
+			compression.NewZStdCompressor(),
+		)
+		bz, err := codec.Encode(eci)
+		require.NoError(t, err)
+
+		decodedEci, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, eci, decodedEci)
+	})
+
+	t.Run("test decoding empty byte array", func(t *testing.T) {
+		codec := compression.NewCompactExtendedCommitCodec()
+		_, err := codec.Decode([]byte{})
+		require.NoError(t, err)
+	})
+
+	t.Run("test decoding invalid byte arrays", func(t *testing.T) {
+		codec := compression.NewCompactExtendedCommitCodec()
+
+		testCases := []struct {
+			name string
+			bz   []byte
+		}{
+			{"unsupported version", []byte{0x00, 0x00, 0x00}},
+			{"count exceeds input", []byte{0x01, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00}},
+			{"truncated address", []byte{0x01, 0x00, 0x01, 0x05, 0x00, 0x00, 0x00, 0x00}},
+			{"trailing bytes", []byte{0x01, 0x00, 0x00, 0x00}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := codec.Decode(tc.bz)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func gobEncode(t *testing.T, price *big.Int) []byte {
+	t.Helper()
+
+	bz, err := price.GobEncode()
+	require.NoError(t, err)
+
+	return bz
+}
@@ -0,0 +1,429 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	vetypes "github.com/skip-mev/slinky/abci/ve/types"
+)
+
+const (
+	// compactCodecVersion is the version of the compact encoding, it is the first byte of all
+	// non-empty compact encodings.
+	compactCodecVersion byte = 1
+
+	// gobIntVersion is the version of the big.Int gob encoding, as defined by math/big.
+	gobIntVersion byte = 1
+
+	// maxCompactPriceBits is the maximum bit length of a price that is encoded as a zig-zag
+	// varint. The zig-zag encoding of such prices fits in 63 bits, leaving a bit to tag the
+	// encoding of the price.
+	maxCompactPriceBits = 62
+)
+
+// CompactVoteExtensionCodec is a VoteExtensionCodec that uses a columnar encoding for vote
+// extensions. Currency pair IDs are sorted and encoded as varint deltas, followed by the prices.
+// Prices that are gob encoded big.Ints (as produced by the Default and Delta currency pair
+// strategies) and fit in 62 bits are encoded as zig-zag varints, all other prices are encoded
+// as length-prefixed bytes. This keeps vote extensions small on chains with many currency pairs.
+type CompactVoteExtensionCodec struct{}
+
+// NewCompactVoteExtensionCodec returns a new CompactVoteExtensionCodec.
+func NewCompactVoteExtensionCodec() *CompactVoteExtensionCodec {
+	return &CompactVoteExtensionCodec{}
+}
+
+// Encode encodes the vote extension into the compact format. An empty vote extension is encoded
+// as an empty byte array.
+func (codec *CompactVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	if len(ve.Prices) == 0 {
+		return []byte{}, nil
+	}
+
+	ids := make([]uint64, 0, len(ve.Prices))
+	for id := range ve.Prices {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	bz := []byte{compactCodecVersion}
+	bz = binary.AppendUvarint(bz, uint64(len(ids)))
+
+	// Encode the IDs as deltas from the previous ID, the first ID is encoded as is.
+	var previous uint64
+	for _, id := range ids {
+		bz = binary.AppendUvarint(bz, id-previous)
+		previous = id
+	}
+
+	for _, id := range ids {
+		bz = appendPrice(bz, ve.Prices[id])
+	}
+
+	return bz, nil
+}
+
+// Decode decodes the vote extension from the compact format.
+func (codec *CompactVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	if len(bz) == 0 {
+		return vetypes.OracleVoteExtension{}, nil
+	}
+
+	r := &compactReader{bz: bz}
+	if err := r.readVersion(); err != nil {
+		return vetypes.OracleVoteExtension{}, err
+	}
+
+	// Each ID and price takes up at least one byte.
+	n, err := r.readCount(2)
+	if err != nil {
+		return vetypes.OracleVoteExtension{}, err
+	}
+
+	ids := make([]uint64, n)
+	var previous uint64
+	for i := range ids {
+		delta, err := r.readUvarint()
+		if err != nil {
+			return vetypes.OracleVoteExtension{}, err
+		}
+
+		// IDs are strictly increasing, so only the first delta can be zero.
+		if i > 0 && delta == 0 {
+			return vetypes.OracleVoteExtension{}, fmt.Errorf("duplicate currency pair id %d", previous)
+		}
+
+		id, carry := bits.Add64(previous, delta, 0)
+		if carry != 0 {
+			return vetypes.OracleVoteExtension{}, fmt.Errorf("currency pair id overflows uint64")
+		}
+
+		ids[i] = id
+		previous = id
+	}
+
+	prices := make(map[uint64][]byte, n)
+	for _, id := range ids {
+		price, err := r.readPrice()
+		if err != nil {
+			return vetypes.OracleVoteExtension{}, err
+		}
+
+		prices[id] = price
+	}
+
+	if err := r.done(); err != nil {
+		return vetypes.OracleVoteExtension{}, err
+	}
+
+	return vetypes.OracleVoteExtension{
+		Prices: prices,
+	}, nil
+}
+
+// CompactExtendedCommitCodec is an ExtendedCommitCodec that uses a columnar encoding for extended
+// commit info. Each field of the votes is encoded as a column, which keeps similar data together
+// and integers as varints. Vote extensions are encoded as is, so that their signatures can still be
+// verified.
+type CompactExtendedCommitCodec struct{}
+
+// NewCompactExtendedCommitCodec returns a new CompactExtendedCommitCodec.
+func NewCompactExtendedCommitCodec() *CompactExtendedCommitCodec {
+	return &CompactExtendedCommitCodec{}
+}
+
+// Encode encodes the extended commit info into the compact format.
+func (codec *CompactExtendedCommitCodec) Encode(extendedCommitInfo cometabci.ExtendedCommitInfo) ([]byte, error) {
+	votes := extendedCommitInfo.Votes
+
+	bz := []byte{compactCodecVersion}
+	bz = binary.AppendVarint(bz, int64(extendedCommitInfo.Round))
+	bz = binary.AppendUvarint(bz, uint64(len(votes)))
+
+	for _, vote := range votes {
+		bz = appendBytes(bz, vote.Validator.Address)
+	}
+	for _, vote := range votes {
+		bz = binary.AppendVarint(bz, vote.Validator.Power)
+	}
+	for _, vote := range votes {
+		bz = binary.AppendVarint(bz, int64(vote.BlockIdFlag))
+	}
+	for _, vote := range votes {
+		bz = appendBytes(bz, vote.VoteExtension)
+	}
+	for _, vote := range votes {
+		bz = appendBytes(bz, vote.ExtensionSignature)
+	}
+
+	return bz, nil
+}
+
+// Decode decodes the extended commit info from the compact format.
+func (codec *CompactExtendedCommitCodec) Decode(bz []byte) (cometabci.ExtendedCommitInfo, error) {
+	if len(bz) == 0 {
+		return cometabci.ExtendedCommitInfo{}, nil
+	}
+
+	r := &compactReader{bz: bz}
+	if err := r.readVersion(); err != nil {
+		return cometabci.ExtendedCommitInfo{}, err
+	}
+
+	round, err := r.readInt32()
+	if err != nil {
+		return cometabci.ExtendedCommitInfo{}, err
+	}
+
+	// Each vote takes up at least one byte per column.
+	n, err := r.readCount(5)
+	if err != nil {
+		return cometabci.ExtendedCommitInfo{}, err
+	}
+
+	extendedCommitInfo := cometabci.ExtendedCommitInfo{
+		Round: round,
+	}
+	if n == 0 {
+		return extendedCommitInfo, r.done()
+	}
+
+	votes := make([]cometabci.ExtendedVoteInfo, n)
+	for i := range votes {
+		if votes[i].Validator.Address, err = r.readBytes(); err != nil {
+			return cometabci.ExtendedCommitInfo{}, err
+		}
+	}
+	for i := range votes {
+		if votes[i].Validator.Power, err = r.readVarint(); err != nil {
+			return cometabci.ExtendedCommitInfo{}, err
+		}
+	}
+	for i := range votes {
+		flag, err := r.readInt32()
+		if err != nil {
+			return cometabci.ExtendedCommitInfo{}, err
+		}
+
+		votes[i].BlockIdFlag = cmtproto.BlockIDFlag(flag)
+	}
+	for i := range votes {
+		if votes[i].VoteExtension, err = r.readBytes(); err != nil {
+			return cometabci.ExtendedCommitInfo{}, err
+		}
+	}
+	for i := range votes {
+		if votes[i].ExtensionSignature, err = r.readBytes(); err != nil {
+			return cometabci.ExtendedCommitInfo{}, err
+		}
+	}
+
+	if err := r.done(); err != nil {
+		return cometabci.ExtendedCommitInfo{}, err
+	}
+
+	extendedCommitInfo.Votes = votes
+	return extendedCommitInfo, nil
+}
+
+// appendPrice appends the encoding of the given price to bz. The lowest bit of the leading varint
+// tags the encoding; 0 for a zig-zag encoded price, 1 for a length-prefixed price.
+func appendPrice(bz, price []byte) []byte {
+	if v, ok := compactPrice(price); ok {
+		zigzag := uint64(v<<1) ^ uint64(v>>63)
+		return binary.AppendUvarint(bz, zigzag<<1)
+	}
+
+	bz = binary.AppendUvarint(bz, uint64(len(price))<<1|1)
+	return append(bz, price...)
+}
+
+// compactPrice returns the value of the given price if it is the canonical gob encoding of a
+// big.Int that fits within maxCompactPriceBits, in which case it can be encoded as a zig-zag
+// varint and decoded to the same bytes.
+func compactPrice(price []byte) (int64, bool) {
+	if len(price) == 0 || price[0]>>1 != gobIntVersion {
+		return 0, false
+	}
+
+	abs := price[1:]
+	neg := price[0]&1 == 1
+
+	// The canonical encoding has no leading zeros, and zero is never negative.
+	if (len(abs) > 0 && abs[0] == 0) || (len(abs) == 0 && neg) {
+		return 0, false
+	}
+
+	if len(abs) > 8 {
+		return 0, false
+	}
+
+	var v uint64
+	for _, b := range abs {
+		v = v<<8 | uint64(b)
+	}
+
+	if bits.Len64(v) > maxCompactPriceBits {
+		return 0, false
+	}
+
+	if neg {
+		return -int64(v), true
+	}
+
+	return int64(v), true
+}
+
+// gobEncodePrice returns the gob encoding of the given price, as produced by big.Int.GobEncode.
+func gobEncodePrice(v int64) []byte {
+	flag := gobIntVersion << 1
+	abs := uint64(v)
+	if v < 0 {
+		flag |= 1
+		abs = uint64(-v)
+	}
+
+	bz := []byte{flag}
+	for shift := (bits.Len64(abs) + 7) / 8 * 8; shift > 0; shift -= 8 {
+		bz = append(bz, byte(abs>>(shift-8)))
+	}
+
+	return bz
+}
+
+// appendBytes appends the given bytes to bz, prefixed with their length.
+func appendBytes(bz, b []byte) []byte {
+	bz = binary.AppendUvarint(bz, uint64(len(b)))
+	return append(bz, b...)
+}
+
+// compactReader reads the values of a compact encoding.
+type compactReader struct {
+	bz []byte
+}
+
+// readVersion reads and checks the version of the encoding.
+func (r *compactReader) readVersion() error {
+	if len(r.bz) == 0 || r.bz[0] != compactCodecVersion {
+		return fmt.Errorf("unsupported compact encoding version")
+	}
+
+	r.bz = r.bz[1:]
+	return nil
+}
+
+// readCount reads the number of encoded elements, each of which take up at least minBytes.
+func (r *compactReader) readCount(minBytes int) (int, error) {
+	n, err := r.readUvarint()
+	if err != nil {
+		return 0, err
+	}
+
+	if n > uint64(len(r.bz)/minBytes) {
+		return 0, fmt.Errorf("invalid element count %d", n)
+	}
+
+	return int(n), nil
+}
+
+func (r *compactReader) readUvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.bz)
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid uvarint")
+	}
+
+	r.bz = r.bz[n:]
+	return v, nil
+}
+
+func (r *compactReader) readVarint() (int64, error) {
+	v, n := binary.Varint(r.bz)
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid varint")
+	}
+
+	r.bz = r.bz[n:]
+	return v, nil
+}
+
+func (r *compactReader) readInt32() (int32, error) {
+	v, err := r.readVarint()
+	if err != nil {
+		return 0, err
+	}
+
+	if v != int64(int32(v)) {
+		return 0, fmt.Errorf("value %d overflows int32", v)
+	}
+
+	return int32(v), nil
+}
+
+// readBytes reads length-prefixed bytes. Empty bytes are returned as nil.
+func (r *compactReader) readBytes() ([]byte, error) {
+	n, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.readN(n)
+}
+
+func (r *compactReader) readN(n uint64) ([]byte, error) {
+	if n > uint64(len(r.bz)) {
+		return nil, fmt.Errorf("unexpected end of input")
+	}
+
+	if n == 0 {
+		return nil, nil
+	}
+
+	b := make([]byte, n)
+	copy(b, r.bz[:n])
+	r.bz = r.bz[n:]
+
+	return b, nil
+}
+
+// readPrice reads a price encoded by appendPrice.
+func (r *compactReader) readPrice() ([]byte, error) {
+	tagged, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	if tagged&1 == 1 {
+		price, err := r.readN(tagged >> 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if price == nil {
+			price = []byte{}
+		}
+
+		return price, nil
+	}
+
+	zigzag := tagged >> 1
+	v := int64(zigzag>>1) ^ -int64(zigzag&1)
+	if bits.Len64(zigzag) > maxCompactPriceBits+1 {
+		return nil, fmt.Errorf("invalid compact price")
+	}
+
+	return gobEncodePrice(v), nil
+}
+
+// done returns an error if there are unread bytes.
+func (r *compactReader) done() error {
+	if len(r.bz) != 0 {
+		return fmt.Errorf("unexpected %d trailing bytes", len(r.bz))
+	}
+
+	return nil
+}
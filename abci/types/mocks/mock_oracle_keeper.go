@@ -36,6 +36,34 @@ func (_m *OracleKeeper) GetAllCurrencyPairs(ctx types.Context) []pkgtypes.Curren
 	return r0
 }
 
+// GetPriceForCurrencyPair provides a mock function with given fields: ctx, cp
+func (_m *OracleKeeper) GetPriceForCurrencyPair(ctx types.Context, cp pkgtypes.CurrencyPair) (oracletypes.QuotePrice, error) {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceForCurrencyPair")
+	}
+
+	var r0 oracletypes.QuotePrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) (oracletypes.QuotePrice, error)); ok {
+		return rf(ctx, cp)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) oracletypes.QuotePrice); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Get(0).(oracletypes.QuotePrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, pkgtypes.CurrencyPair) error); ok {
+		r1 = rf(ctx, cp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetPriceForCurrencyPair provides a mock function with given fields: ctx, cp, qp
 func (_m *OracleKeeper) SetPriceForCurrencyPair(ctx types.Context, cp pkgtypes.CurrencyPair, qp oracletypes.QuotePrice) error {
 	ret := _m.Called(ctx, cp, qp)
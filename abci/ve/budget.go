@@ -0,0 +1,183 @@
+package ve
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	compression "github.com/skip-mev/slinky/abci/strategies/codec"
+	slinkyabci "github.com/skip-mev/slinky/abci/types"
+	"github.com/skip-mev/slinky/abci/ve/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+// PairPriority determines the order in which currency pairs are included in a vote extension
+// that exceeds its byte budget.
+type PairPriority int
+
+const (
+	// PrioritizeByPriceChange includes the currency pairs whose price moved the most, relative
+	// to their current on-chain price, first.
+	PrioritizeByPriceChange PairPriority = iota
+	// PrioritizeByStaleness includes the currency pairs whose on-chain price was updated the
+	// least recently first.
+	PrioritizeByStaleness
+)
+
+func (p PairPriority) String() string {
+	switch p {
+	case PrioritizeByPriceChange:
+		return "price_change"
+	case PrioritizeByStaleness:
+		return "staleness"
+	default:
+		return "unknown"
+	}
+}
+
+// VoteExtensionBudget limits the size of the vote extensions broadcast by a validator. When a
+// vote extension exceeds the budget, currency pairs are included by priority until the budget
+// is reached, so that large market maps degrade gracefully instead of bloating every vote.
+// Currency pairs without an on-chain price are always prioritised.
+type VoteExtensionBudget struct {
+	// maxBytes is the maximum size of an encoded vote extension.
+	maxBytes int
+
+	// priority determines the order in which currency pairs are included.
+	priority PairPriority
+
+	// oracleKeeper is used to fetch the on-chain prices of the currency pairs.
+	oracleKeeper slinkyabci.OracleKeeper
+}
+
+// NewVoteExtensionBudget returns a new VoteExtensionBudget that limits encoded vote extensions
+// to maxBytes, including currency pairs in the order determined by priority.
+func NewVoteExtensionBudget(
+	maxBytes int,
+	priority PairPriority,
+	oracleKeeper slinkyabci.OracleKeeper,
+) (*VoteExtensionBudget, error) {
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("vote extension byte budget must be positive")
+	}
+
+	if priority != PrioritizeByPriceChange && priority != PrioritizeByStaleness {
+		return nil, fmt.Errorf("invalid pair priority %d", priority)
+	}
+
+	if oracleKeeper == nil {
+		return nil, fmt.Errorf("oracle keeper cannot be nil")
+	}
+
+	return &VoteExtensionBudget{
+		maxBytes:     maxBytes,
+		priority:     priority,
+		oracleKeeper: oracleKeeper,
+	}, nil
+}
+
+// budgetedPair is a currency pair that is a candidate for inclusion in a vote extension.
+type budgetedPair struct {
+	id    uint64
+	cp    slinkytypes.CurrencyPair
+	price *big.Int
+}
+
+// pairScore is the priority of a currency pair. Unpriced pairs are included before all others,
+// followed by pairs with the highest score.
+type pairScore struct {
+	id       uint64
+	unpriced bool
+	score    *big.Rat
+}
+
+// fit returns the vote extension restricted to the highest priority currency pairs whose encoding
+// fits within the byte budget. The vote extension is returned as is if it already fits.
+func (b *VoteExtensionBudget) fit(
+	ctx sdk.Context,
+	codec compression.VoteExtensionCodec,
+	voteExt types.OracleVoteExtension,
+	pairs []budgetedPair,
+) (types.OracleVoteExtension, error) {
+	bz, err := codec.Encode(voteExt)
+	if err != nil {
+		return types.OracleVoteExtension{}, err
+	}
+
+	if len(bz) <= b.maxBytes {
+		return voteExt, nil
+	}
+
+	scores := make([]pairScore, 0, len(pairs))
+	for _, pair := range pairs {
+		scores = append(scores, b.score(ctx, pair))
+	}
+
+	// Order the pairs by priority, breaking ties by ID so that the selection is deterministic.
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].unpriced != scores[j].unpriced {
+			return scores[i].unpriced
+		}
+
+		if cmp := scores[i].score.Cmp(scores[j].score); cmp != 0 {
+			return cmp > 0
+		}
+
+		return scores[i].id < scores[j].id
+	})
+
+	prefix := func(n int) types.OracleVoteExtension {
+		prices := make(map[uint64][]byte, n)
+		for _, s := range scores[:n] {
+			prices[s.id] = voteExt.Prices[s.id]
+		}
+
+		return types.OracleVoteExtension{Prices: prices}
+	}
+
+	// Find the largest number of pairs, taken in order of priority, that fits within the budget.
+	included, hi := 0, len(scores)-1
+	for lo := 1; lo <= hi; {
+		mid := lo + (hi-lo)/2
+
+		bz, err := codec.Encode(prefix(mid))
+		if err != nil {
+			return types.OracleVoteExtension{}, err
+		}
+
+		if len(bz) <= b.maxBytes {
+			included, lo = mid, mid+1
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return prefix(included), nil
+}
+
+// score returns the priority of the given currency pair.
+func (b *VoteExtensionBudget) score(ctx sdk.Context, pair budgetedPair) pairScore {
+	unpriced := pairScore{id: pair.id, unpriced: true, score: new(big.Rat)}
+
+	qp, err := b.oracleKeeper.GetPriceForCurrencyPair(ctx, pair.cp)
+	if err != nil || qp.Price.IsNil() || !qp.Price.IsPositive() {
+		return unpriced
+	}
+
+	switch b.priority {
+	case PrioritizeByStaleness:
+		staleness := ctx.BlockHeight() - int64(qp.BlockHeight)
+		if staleness < 0 {
+			staleness = 0
+		}
+
+		return pairScore{id: pair.id, score: new(big.Rat).SetInt64(staleness)}
+	default:
+		onChain := qp.Price.BigInt()
+		change := new(big.Int).Abs(new(big.Int).Sub(pair.price, onChain))
+
+		return pairScore{id: pair.id, score: new(big.Rat).SetFrac(change, onChain)}
+	}
+}
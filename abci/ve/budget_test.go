@@ -0,0 +1,64 @@
+package ve_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	slinkyabci "github.com/skip-mev/slinky/abci/types"
+	abcimocks "github.com/skip-mev/slinky/abci/types/mocks"
+	"github.com/skip-mev/slinky/abci/ve"
+)
+
+func TestNewVoteExtensionBudget(t *testing.T) {
+	testCases := []struct {
+		name         string
+		maxBytes     int
+		priority     ve.PairPriority
+		oracleKeeper slinkyabci.OracleKeeper
+		expectErr    bool
+	}{
+		{
+			name:         "valid price change budget",
+			maxBytes:     1024,
+			priority:     ve.PrioritizeByPriceChange,
+			oracleKeeper: abcimocks.NewOracleKeeper(t),
+		},
+		{
+			name:         "valid staleness budget",
+			maxBytes:     1024,
+			priority:     ve.PrioritizeByStaleness,
+			oracleKeeper: abcimocks.NewOracleKeeper(t),
+		},
+		{
+			name:         "zero max bytes",
+			priority:     ve.PrioritizeByPriceChange,
+			oracleKeeper: abcimocks.NewOracleKeeper(t),
+			expectErr:    true,
+		},
+		{
+			name:         "invalid priority",
+			maxBytes:     1024,
+			priority:     ve.PairPriority(2),
+			oracleKeeper: abcimocks.NewOracleKeeper(t),
+			expectErr:    true,
+		},
+		{
+			name:      "nil oracle keeper",
+			maxBytes:  1024,
+			priority:  ve.PrioritizeByPriceChange,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ve.NewVoteExtensionBudget(tc.maxBytes, tc.priority, tc.oracleKeeper)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
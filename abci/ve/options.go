@@ -0,0 +1,13 @@
+package ve
+
+// Option is a function that enables optional configuration of the VoteExtensionHandler.
+type Option func(*VoteExtensionHandler)
+
+// WithVoteExtensionBudget returns an Option that configures the VoteExtensionHandler to limit
+// the size of the vote extensions it creates, including currency pairs by priority as
+// configured by the budget.
+func WithVoteExtensionBudget(budget *VoteExtensionBudget) Option {
+	return func(h *VoteExtensionHandler) {
+		h.budget = budget
+	}
+}
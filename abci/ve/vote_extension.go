@@ -48,6 +48,9 @@ type VoteExtensionHandler struct {
 
 	// metrics is the service metrics interface that the vote-extension handler will use to report metrics.
 	metrics servicemetrics.Metrics
+
+	// budget optionally limits the size of the vote extensions created by the handler.
+	budget *VoteExtensionBudget
 }
 
 // NewVoteExtensionHandler returns a new VoteExtensionHandler.
@@ -59,8 +62,9 @@ func NewVoteExtensionHandler(
 	codec compression.VoteExtensionCodec,
 	priceApplier aggregator.PriceApplier,
 	metrics servicemetrics.Metrics,
+	opts ...Option,
 ) *VoteExtensionHandler {
-	return &VoteExtensionHandler{
+	h := &VoteExtensionHandler{
 		logger:               logger,
 		oracleClient:         oracleClient,
 		timeout:              timeout,
@@ -69,6 +73,12 @@ func NewVoteExtensionHandler(
 		metrics:              metrics,
 		priceApplier:         priceApplier,
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 // ExtendVoteHandler returns a handler that extends a vote with the oracle's
@@ -287,9 +297,11 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 
 // transformOracleServicePrices transforms the oracle service prices into a vote extension. It
 // does this by iterating over the prices submitted by the oracle service and determining the
-// correct decoded price / ID based on the currency pair strategy.
+// correct decoded price / ID based on the currency pair strategy. If a byte budget is configured,
+// only the highest priority currency pairs that fit within the budget are included.
 func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, prices map[string]string) (types.OracleVoteExtension, error) {
 	strategyPrices := make(map[uint64][]byte)
+	pairs := make([]budgetedPair, 0, len(prices))
 
 	// Iterate over the prices and transform them into the correct format.
 	for currencyPairID, priceString := range prices {
@@ -334,11 +346,33 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		)
 
 		strategyPrices[cpID] = encodedPrice
+		pairs = append(pairs, budgetedPair{id: cpID, cp: cp, price: rawPrice})
 	}
 
 	h.logger.Info("transformed oracle prices", "prices", len(strategyPrices))
 
-	return types.OracleVoteExtension{
+	voteExt := types.OracleVoteExtension{
 		Prices: strategyPrices,
-	}, nil
+	}
+	if h.budget == nil {
+		return voteExt, nil
+	}
+
+	budgeted, err := h.budget.fit(ctx, h.voteExtensionCodec, voteExt, pairs)
+	if err != nil {
+		return types.OracleVoteExtension{}, err
+	}
+
+	if dropped := len(voteExt.Prices) - len(budgeted.Prices); dropped > 0 {
+		h.logger.Info(
+			"vote extension exceeds byte budget; dropped lowest priority prices",
+			"height", ctx.BlockHeight(),
+			"max_bytes", h.budget.maxBytes,
+			"priority", h.budget.priority.String(),
+			"included", len(budgeted.Prices),
+			"dropped", dropped,
+		)
+	}
+
+	return budgeted, nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/mock"
@@ -18,6 +19,7 @@ import (
 	mockstrategies "github.com/skip-mev/slinky/abci/strategies/currencypair/mocks"
 	"github.com/skip-mev/slinky/abci/testutils"
 	slinkyabci "github.com/skip-mev/slinky/abci/types"
+	abcimocks "github.com/skip-mev/slinky/abci/types/mocks"
 	"github.com/skip-mev/slinky/abci/ve"
 	abcitypes "github.com/skip-mev/slinky/abci/ve/types"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
@@ -26,6 +28,7 @@ import (
 	servicemetrics "github.com/skip-mev/slinky/service/metrics"
 	metricsmocks "github.com/skip-mev/slinky/service/metrics/mocks"
 	servicetypes "github.com/skip-mev/slinky/service/servers/oracle/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
 
 var (
@@ -322,6 +325,144 @@ func (s *VoteExtensionTestSuite) TestExtendVoteExtension() {
 	}
 }
 
+func (s *VoteExtensionTestSuite) TestExtendVoteExtensionWithBudget() {
+	solUSD := slinkytypes.NewCurrencyPair("SOL", "USD")
+	threeHundred := big.NewInt(300)
+
+	prices := map[string]string{
+		btcUSD.String(): oneHundred.String(),
+		ethUSD.String(): twoHundred.String(),
+		solUSD.String(): threeHundred.String(),
+	}
+	allPrices := map[uint64][]byte{
+		0: oneHundred.Bytes(),
+		1: twoHundred.Bytes(),
+		2: threeHundred.Bytes(),
+	}
+
+	cases := []struct {
+		name             string
+		priority         ve.PairPriority
+		maxBytes         func(cdc codec.VoteExtensionCodec) int
+		expectedResponse map[uint64][]byte
+	}{
+		{
+			name:     "vote extension within budget includes all prices",
+			priority: ve.PrioritizeByPriceChange,
+			maxBytes: func(cdc codec.VoteExtensionCodec) int {
+				bz, err := cdc.Encode(abcitypes.OracleVoteExtension{Prices: allPrices})
+				s.Require().NoError(err)
+				return len(bz)
+			},
+			expectedResponse: allPrices,
+		},
+		{
+			name:     "unpriced pairs and largest price changes are included first",
+			priority: ve.PrioritizeByPriceChange,
+			maxBytes: func(cdc codec.VoteExtensionCodec) int {
+				bz, err := cdc.Encode(abcitypes.OracleVoteExtension{Prices: map[uint64][]byte{
+					1: twoHundred.Bytes(),
+					2: threeHundred.Bytes(),
+				}})
+				s.Require().NoError(err)
+				return len(bz)
+			},
+			expectedResponse: map[uint64][]byte{
+				1: twoHundred.Bytes(),
+				2: threeHundred.Bytes(),
+			},
+		},
+		{
+			name:     "unpriced pairs and stalest prices are included first",
+			priority: ve.PrioritizeByStaleness,
+			maxBytes: func(cdc codec.VoteExtensionCodec) int {
+				bz, err := cdc.Encode(abcitypes.OracleVoteExtension{Prices: map[uint64][]byte{
+					0: oneHundred.Bytes(),
+					2: threeHundred.Bytes(),
+				}})
+				s.Require().NoError(err)
+				return len(bz)
+			},
+			expectedResponse: map[uint64][]byte{
+				0: oneHundred.Bytes(),
+				2: threeHundred.Bytes(),
+			},
+		},
+		{
+			name:     "budget smaller than a single price includes no prices",
+			priority: ve.PrioritizeByPriceChange,
+			maxBytes: func(codec.VoteExtensionCodec) int {
+				return 1
+			},
+			expectedResponse: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithBlockHeight(10)
+			cdc := codec.NewDefaultVoteExtensionCodec()
+
+			oracleClient := mocks.NewOracleClient(s.T())
+			oracleClient.On("Prices", mock.Anything, mock.Anything).Return(
+				&servicetypes.QueryPricesResponse{
+					Prices: prices,
+				},
+				nil,
+			)
+
+			cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+			cps.On("ID", mock.Anything, btcUSD).Return(uint64(0), nil)
+			cps.On("GetEncodedPrice", mock.Anything, btcUSD, oneHundred).Return(oneHundred.Bytes(), nil)
+			cps.On("ID", mock.Anything, ethUSD).Return(uint64(1), nil)
+			cps.On("GetEncodedPrice", mock.Anything, ethUSD, twoHundred).Return(twoHundred.Bytes(), nil)
+			cps.On("ID", mock.Anything, solUSD).Return(uint64(2), nil)
+			cps.On("GetEncodedPrice", mock.Anything, solUSD, threeHundred).Return(threeHundred.Bytes(), nil)
+
+			// BTC/USD has not moved but was updated least recently, ETH/USD has doubled, and
+			// SOL/USD has no price on-chain.
+			oracleKeeper := abcimocks.NewOracleKeeper(s.T())
+			oracleKeeper.On("GetPriceForCurrencyPair", mock.Anything, btcUSD).Return(
+				oracletypes.QuotePrice{Price: math.NewIntFromBigInt(oneHundred), BlockHeight: 1},
+				nil,
+			).Maybe()
+			oracleKeeper.On("GetPriceForCurrencyPair", mock.Anything, ethUSD).Return(
+				oracletypes.QuotePrice{Price: math.NewIntFromBigInt(oneHundred), BlockHeight: 9},
+				nil,
+			).Maybe()
+			oracleKeeper.On("GetPriceForCurrencyPair", mock.Anything, solUSD).Return(
+				oracletypes.QuotePrice{},
+				fmt.Errorf("no price"),
+			).Maybe()
+
+			budget, err := ve.NewVoteExtensionBudget(tc.maxBytes(cdc), tc.priority, oracleKeeper)
+			s.Require().NoError(err)
+
+			mockPriceApplier := aggregatormocks.NewPriceApplier(s.T())
+			mockPriceApplier.On("ApplyPricesFromVoteExtensions", ctx, mock.Anything).Return(nil, nil)
+
+			h := ve.NewVoteExtensionHandler(
+				log.NewTestLogger(s.T()),
+				oracleClient,
+				time.Second*1,
+				cps,
+				cdc,
+				mockPriceApplier,
+				servicemetrics.NewNopMetrics(),
+				ve.WithVoteExtensionBudget(budget),
+			)
+
+			resp, err := h.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{Height: 10})
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(resp.VoteExtension), tc.maxBytes(cdc))
+
+			ext, err := cdc.Decode(resp.VoteExtension)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedResponse, ext.Prices)
+		})
+	}
+}
+
 func (s *VoteExtensionTestSuite) TestVerifyVoteExtension() {
 	cdc := codec.NewCompressionVoteExtensionCodec(
 		codec.NewDefaultVoteExtensionCodec(),
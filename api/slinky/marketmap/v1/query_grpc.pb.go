@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_MarketMap_FullMethodName   = "/slinky.marketmap.v1.Query/MarketMap"
-	Query_Market_FullMethodName      = "/slinky.marketmap.v1.Query/Market"
-	Query_LastUpdated_FullMethodName = "/slinky.marketmap.v1.Query/LastUpdated"
-	Query_Params_FullMethodName      = "/slinky.marketmap.v1.Query/Params"
+	Query_MarketMap_FullMethodName      = "/slinky.marketmap.v1.Query/MarketMap"
+	Query_Market_FullMethodName         = "/slinky.marketmap.v1.Query/Market"
+	Query_LastUpdated_FullMethodName    = "/slinky.marketmap.v1.Query/LastUpdated"
+	Query_PowerThreshold_FullMethodName = "/slinky.marketmap.v1.Query/PowerThreshold"
+	Query_Params_FullMethodName         = "/slinky.marketmap.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +38,9 @@ type QueryClient interface {
 	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
 	// LastUpdated returns the last height the market map was updated at.
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
+	// PowerThreshold returns the power threshold configured by a market stored
+	// in the x/marketmap module.
+	PowerThreshold(ctx context.Context, in *PowerThresholdRequest, opts ...grpc.CallOption) (*PowerThresholdResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
@@ -76,6 +80,15 @@ func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, o
 	return out, nil
 }
 
+func (c *queryClient) PowerThreshold(ctx context.Context, in *PowerThresholdRequest, opts ...grpc.CallOption) (*PowerThresholdResponse, error) {
+	out := new(PowerThresholdResponse)
+	err := c.cc.Invoke(ctx, Query_PowerThreshold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
 	out := new(ParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -97,6 +110,9 @@ type QueryServer interface {
 	Market(context.Context, *MarketRequest) (*MarketResponse, error)
 	// LastUpdated returns the last height the market map was updated at.
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
+	// PowerThreshold returns the power threshold configured by a market stored
+	// in the x/marketmap module.
+	PowerThreshold(context.Context, *PowerThresholdRequest) (*PowerThresholdResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -115,6 +131,9 @@ func (UnimplementedQueryServer) Market(context.Context, *MarketRequest) (*Market
 func (UnimplementedQueryServer) LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
 }
+func (UnimplementedQueryServer) PowerThreshold(context.Context, *PowerThresholdRequest) (*PowerThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerThreshold not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -185,6 +204,24 @@ func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PowerThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PowerThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PowerThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PowerThreshold(ctx, req.(*PowerThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
@@ -222,6 +259,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LastUpdated",
 			Handler:    _Query_LastUpdated_Handler,
 		},
+		{
+			MethodName: "PowerThreshold",
+			Handler:    _Query_PowerThreshold_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
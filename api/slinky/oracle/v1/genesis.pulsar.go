@@ -1094,12 +1094,64 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_CurrencyPairGenesis_5_list)(nil)
+
+type _CurrencyPairGenesis_5_list struct {
+	list *[]*QuotePrice
+}
+
+func (x *_CurrencyPairGenesis_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrencyPairGenesis_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrencyPairGenesis_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuotePrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrencyPairGenesis_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuotePrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrencyPairGenesis_5_list) AppendMutable() protoreflect.Value {
+	v := new(QuotePrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairGenesis_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrencyPairGenesis_5_list) NewElement() protoreflect.Value {
+	v := new(QuotePrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairGenesis_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_CurrencyPairGenesis                     protoreflect.MessageDescriptor
 	fd_CurrencyPairGenesis_currency_pair       protoreflect.FieldDescriptor
 	fd_CurrencyPairGenesis_currency_pair_price protoreflect.FieldDescriptor
 	fd_CurrencyPairGenesis_nonce               protoreflect.FieldDescriptor
 	fd_CurrencyPairGenesis_id                  protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_price_history       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1109,6 +1161,7 @@ func init() {
 	fd_CurrencyPairGenesis_currency_pair_price = md_CurrencyPairGenesis.Fields().ByName("currency_pair_price")
 	fd_CurrencyPairGenesis_nonce = md_CurrencyPairGenesis.Fields().ByName("nonce")
 	fd_CurrencyPairGenesis_id = md_CurrencyPairGenesis.Fields().ByName("id")
+	fd_CurrencyPairGenesis_price_history = md_CurrencyPairGenesis.Fields().ByName("price_history")
 }
 
 var _ protoreflect.Message = (*fastReflection_CurrencyPairGenesis)(nil)
@@ -1200,6 +1253,12 @@ func (x *fastReflection_CurrencyPairGenesis) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.PriceHistory) != 0 {
+		value := protoreflect.ValueOfList(&_CurrencyPairGenesis_5_list{list: &x.PriceHistory})
+		if !f(fd_CurrencyPairGenesis_price_history, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1223,6 +1282,8 @@ func (x *fastReflection_CurrencyPairGenesis) Has(fd protoreflect.FieldDescriptor
 		return x.Nonce != uint64(0)
 	case "slinky.oracle.v1.CurrencyPairGenesis.id":
 		return x.Id != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		return len(x.PriceHistory) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
@@ -1247,6 +1308,8 @@ func (x *fastReflection_CurrencyPairGenesis) Clear(fd protoreflect.FieldDescript
 		x.Nonce = uint64(0)
 	case "slinky.oracle.v1.CurrencyPairGenesis.id":
 		x.Id = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		x.PriceHistory = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
@@ -1275,6 +1338,12 @@ func (x *fastReflection_CurrencyPairGenesis) Get(descriptor protoreflect.FieldDe
 	case "slinky.oracle.v1.CurrencyPairGenesis.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		if len(x.PriceHistory) == 0 {
+			return protoreflect.ValueOfList(&_CurrencyPairGenesis_5_list{})
+		}
+		listValue := &_CurrencyPairGenesis_5_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
@@ -1303,6 +1372,10 @@ func (x *fastReflection_CurrencyPairGenesis) Set(fd protoreflect.FieldDescriptor
 		x.Nonce = value.Uint()
 	case "slinky.oracle.v1.CurrencyPairGenesis.id":
 		x.Id = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		lv := value.List()
+		clv := lv.(*_CurrencyPairGenesis_5_list)
+		x.PriceHistory = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
@@ -1333,6 +1406,12 @@ func (x *fastReflection_CurrencyPairGenesis) Mutable(fd protoreflect.FieldDescri
 			x.CurrencyPairPrice = new(QuotePrice)
 		}
 		return protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		if x.PriceHistory == nil {
+			x.PriceHistory = []*QuotePrice{}
+		}
+		value := &_CurrencyPairGenesis_5_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(value)
 	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
 		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
 	case "slinky.oracle.v1.CurrencyPairGenesis.id":
@@ -1360,6 +1439,9 @@ func (x *fastReflection_CurrencyPairGenesis) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "slinky.oracle.v1.CurrencyPairGenesis.id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		list := []*QuotePrice{}
+		return protoreflect.ValueOfList(&_CurrencyPairGenesis_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
@@ -1443,6 +1525,12 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
+		if len(x.PriceHistory) > 0 {
+			for _, e := range x.PriceHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1472,6 +1560,22 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PriceHistory) > 0 {
+			for iNdEx := len(x.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
@@ -1669,6 +1773,40 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceHistory = append(x.PriceHistory, &QuotePrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceHistory[len(x.PriceHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2390,6 +2528,11 @@ type CurrencyPairGenesis struct {
 	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// id is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// price_history is the bounded history of prices for the CurrencyPair,
+	// ordered from oldest to newest. The last entry corresponds to the nonce
+	// above, and each preceding entry to the nonce before it. Only the most
+	// recent entries, up to the configured price history size, are imported.
+	PriceHistory []*QuotePrice `protobuf:"bytes,5,rep,name=price_history,json=priceHistory,proto3" json:"price_history,omitempty"`
 }
 
 func (x *CurrencyPairGenesis) Reset() {
@@ -2440,6 +2583,13 @@ func (x *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+func (x *CurrencyPairGenesis) GetPriceHistory() []*QuotePrice {
+	if x != nil {
+		return x.PriceHistory
+	}
+	return nil
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -2522,7 +2672,7 @@ var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
 	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
 	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
+	0x69, 0x64, 0x22, 0xa2, 0x02, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
 	0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75,
 	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
@@ -2535,28 +2685,32 @@ var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
 	0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x88,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x5f, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72,
-	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f,
-	0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
-	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69,
-	0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e,
-	0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47,
+	0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74,
+	0x49, 0x64, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10,
+	0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2585,12 +2739,13 @@ var file_slinky_oracle_v1_genesis_proto_depIdxs = []int32{
 	0, // 1: slinky.oracle.v1.CurrencyPairState.price:type_name -> slinky.oracle.v1.QuotePrice
 	5, // 2: slinky.oracle.v1.CurrencyPairGenesis.currency_pair:type_name -> slinky.types.v1.CurrencyPair
 	0, // 3: slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price:type_name -> slinky.oracle.v1.QuotePrice
-	2, // 4: slinky.oracle.v1.GenesisState.currency_pair_genesis:type_name -> slinky.oracle.v1.CurrencyPairGenesis
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0, // 4: slinky.oracle.v1.CurrencyPairGenesis.price_history:type_name -> slinky.oracle.v1.QuotePrice
+	2, // 5: slinky.oracle.v1.GenesisState.currency_pair_genesis:type_name -> slinky.oracle.v1.CurrencyPairGenesis
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_slinky_oracle_v1_genesis_proto_init() }
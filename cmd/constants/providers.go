@@ -8,7 +8,12 @@ import (
 	binanceapi "github.com/skip-mev/slinky/providers/apis/binance"
 	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
 	"github.com/skip-mev/slinky/providers/apis/coingecko"
+	"github.com/skip-mev/slinky/providers/apis/defi/astroport"
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink"
+	"github.com/skip-mev/slinky/providers/apis/defi/osmosis"
 	"github.com/skip-mev/slinky/providers/apis/defi/raydium"
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm"
+	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv2"
 	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv3"
 	"github.com/skip-mev/slinky/providers/apis/dydx"
 	krakenapi "github.com/skip-mev/slinky/providers/apis/kraken"
@@ -37,6 +42,16 @@ var (
 			API:  raydium.DefaultAPIConfig,
 			Type: types.ConfigType,
 		},
+		{
+			Name: solanaclmm.RaydiumCLMMName,
+			API:  solanaclmm.DefaultRaydiumCLMMAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: solanaclmm.OrcaWhirlpoolName,
+			API:  solanaclmm.DefaultOrcaWhirlpoolAPIConfig,
+			Type: types.ConfigType,
+		},
 		{
 			Name: uniswapv3.ProviderNames[constants.ETHEREUM],
 			API:  uniswapv3.DefaultETHAPIConfig,
@@ -47,6 +62,36 @@ var (
 			API:  uniswapv3.DefaultBaseAPIConfig,
 			Type: types.ConfigType,
 		},
+		{
+			Name: uniswapv2.ProviderNames[constants.ETHEREUM],
+			API:  uniswapv2.DefaultETHAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: uniswapv2.ProviderNames[constants.BASE],
+			API:  uniswapv2.DefaultBaseAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: chainlink.ProviderNames[constants.ETHEREUM],
+			API:  chainlink.DefaultETHAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: chainlink.ProviderNames[constants.BASE],
+			API:  chainlink.DefaultBaseAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: osmosis.Name,
+			API:  osmosis.DefaultAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: astroport.Name,
+			API:  astroport.DefaultAPIConfig,
+			Type: types.ConfigType,
+		},
 
 		// Exchange API providers
 		{
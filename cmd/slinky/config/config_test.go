@@ -348,6 +348,52 @@ func TestReadOracleConfigWithOverrides(t *testing.T) {
 		require.Equal(t, expectedConfig.UpdateInterval, cfg.UpdateInterval)
 		require.Equal(t, expectedConfig.Metrics.PrometheusServerAddress, cfg.Metrics.PrometheusServerAddress)
 	})
+
+	t.Run("adding a generic api provider via config", func(t *testing.T) {
+		tmpfile, err := os.CreateTemp("", "slinky-config-*.json")
+		require.NoError(t, err)
+
+		defer os.Remove(tmpfile.Name())
+
+		_, err = tmpfile.Write([]byte(`
+		{
+			"providers": {
+				"generic_api": {
+					"name": "generic_api",
+					"type": "price_provider",
+					"api": {
+						"name": "generic_api",
+						"enabled": true,
+						"atomic": true,
+						"timeout": "500ms",
+						"interval": "1s",
+						"reconnectTimeout": "2s",
+						"maxQueries": 1,
+						"endpoints": [
+							{
+								"url": "https://api.example.com/prices?symbols={tickers}"
+							}
+						],
+						"generic": {
+							"pricePath": "data.{ticker}.price",
+							"volumePath": "data.{ticker}.volume"
+						}
+					}
+				}
+			}
+		}
+		`))
+		require.NoError(t, err)
+
+		cfg, err := cmdconfig.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.Name)
+		require.NoError(t, err)
+
+		require.Contains(t, cfg.Providers, "generic_api")
+		require.Equal(t, oracleconfig.GenericAPIConfig{
+			PricePath:  "data.{ticker}.price",
+			VolumePath: "data.{ticker}.volume",
+		}, cfg.Providers["generic_api"].API.Generic)
+	})
 }
 
 func filterMarketMapProvidersFromOracleConfig(cfg oracleconfig.OracleConfig, mmProvider string) oracleconfig.OracleConfig {
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// configReloadDelay is the time to wait after the config file changes before it is re-read. Editors
+// typically emit several events for a single save, so reloads are debounced by this delay.
+const configReloadDelay = 100 * time.Millisecond
+
+// WatchOracleConfig watches the oracle config file at the given path and calls onUpdate with the re-read
+// config (including environment overrides) every time the file is written or the process receives a
+// SIGHUP. Configs that fail to be read or validated are logged and ignored. This blocks until the context
+// is cancelled.
+func WatchOracleConfig(
+	ctx context.Context,
+	logger *zap.Logger,
+	path string,
+	marketMapProvider string,
+	onUpdate func(config.OracleConfig) error,
+) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create config file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// Watch the directory rather than the file itself, such that the file is still watched after
+	// being replaced, i.e. by editors that write to a temporary file and rename it.
+	path = filepath.Clean(path)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to watch config file %s: %w", path, err)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	defer signal.Stop(sigs)
+
+	logger.Info("watching oracle config for changes", zap.String("path", path))
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sigs:
+			logger.Info("received SIGHUP; reloading oracle config", zap.String("path", path))
+			reload = time.After(0)
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if filepath.Clean(event.Name) != path || !event.Has(fsnotify.Write|fsnotify.Create) {
+				continue
+			}
+
+			logger.Debug("oracle config file changed", zap.String("path", path), zap.String("op", event.Op.String()))
+			reload = time.After(configReloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			logger.Error("error watching oracle config", zap.String("path", path), zap.Error(err))
+		case <-reload:
+			reload = nil
+
+			cfg, err := ReadOracleConfigWithOverrides(path, marketMapProvider)
+			if err != nil {
+				logger.Error("failed to read updated oracle config; ignoring", zap.String("path", path), zap.Error(err))
+				continue
+			}
+
+			if err := onUpdate(cfg); err != nil {
+				logger.Error("failed to apply updated oracle config", zap.String("path", path), zap.Error(err))
+				continue
+			}
+
+			logger.Info("reloaded oracle config", zap.String("path", path))
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	cmdconfig "github.com/skip-mev/slinky/cmd/slinky/config"
+	oracleconfig "github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/marketmap"
+)
+
+func TestWatchOracleConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "oracle.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"updateInterval": "1s"}`), 0o600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates := make(chan oracleconfig.OracleConfig, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- cmdconfig.WatchOracleConfig(ctx, zap.NewNop(), path, marketmap.Name, func(cfg oracleconfig.OracleConfig) error {
+			updates <- cfg
+			return nil
+		})
+	}()
+
+	// Keep writing the file until the watcher picks up the change, as the watcher may not
+	// have started yet.
+	var cfg oracleconfig.OracleConfig
+	require.Eventually(t, func() bool {
+		require.NoError(t, os.WriteFile(path, []byte(`{"updateInterval": "2s"}`), 0o600))
+
+		select {
+		case cfg = <-updates:
+			return true
+		case <-time.After(250 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 2*time.Second, cfg.UpdateInterval)
+
+	// Invalid configs are ignored.
+	require.NoError(t, os.WriteFile(path, []byte(`{"updateInterval": "0s"}`), 0o600))
+	timeout := time.After(500 * time.Millisecond)
+	for received := true; received; {
+		select {
+		case cfg = <-updates:
+			// Updates from the writes above may still be in flight.
+			require.Equal(t, 2*time.Second, cfg.UpdateInterval)
+		case <-timeout:
+			received = false
+		}
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -12,6 +12,7 @@ import (
 
 	_ "net/http/pprof" //nolint: gosec
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
@@ -23,6 +24,7 @@ import (
 	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/pkg/log"
 	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/base/recorder"
 	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
 	mmservicetypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
 	oracleserver "github.com/skip-mev/slinky/service/servers/oracle"
@@ -64,10 +66,18 @@ var (
 	maxAge              int
 	disableCompressLogs bool
 	disableRotatingLogs bool
+	priceSnapshotDir    string
+	recordTrafficPath   string
+	recordTrafficSize   int
+	recordTrafficFiles  int
+	replayTrafficPath   string
 )
 
 const (
 	DefaultLegacyConfigPath = "./oracle.json"
+
+	// priceSnapshotDBName is the name of the database in which the latest prices are persisted.
+	priceSnapshotDBName = "prices"
 )
 
 func init() {
@@ -176,7 +186,43 @@ func init() {
 		"",
 		"Use a custom listen-to endpoint for market-map (overwrites what is provided in oracle-config).",
 	)
+	rootCmd.Flags().StringVarP(
+		&priceSnapshotDir,
+		"price-snapshot-dir",
+		"",
+		"",
+		"Directory in which the latest prices are persisted, such that they can be served immediately after a restart. Disabled if empty.",
+	)
+	rootCmd.Flags().StringVarP(
+		&recordTrafficPath,
+		"record-traffic",
+		"",
+		"",
+		"File to which the raw HTTP responses and websocket frames received from the providers are recorded. Disabled if empty.",
+	)
+	rootCmd.Flags().IntVarP(
+		&recordTrafficSize,
+		"record-traffic-max-size",
+		"",
+		100,
+		"Maximum size in megabytes of the traffic recording before it is rotated.",
+	)
+	rootCmd.Flags().IntVarP(
+		&recordTrafficFiles,
+		"record-traffic-max-backups",
+		"",
+		10,
+		"Maximum number of rotated traffic recordings to retain.",
+	)
+	rootCmd.Flags().StringVarP(
+		&replayTrafficPath,
+		"replay-traffic",
+		"",
+		"",
+		"Traffic recording that is replayed by the providers instead of querying the network. Disabled if empty.",
+	)
 	rootCmd.MarkFlagsMutuallyExclusive("update-market-config-path", "market-config-path")
+	rootCmd.MarkFlagsMutuallyExclusive("record-traffic", "replay-traffic")
 	rootCmd.MarkFlagsMutuallyExclusive("market-map-endpoint", "market-config-path")
 
 	rootCmd.AddCommand(versionCmd)
@@ -253,18 +299,51 @@ func runOracle() error {
 		return fmt.Errorf("failed to create data aggregator: %w", err)
 	}
 
+	// Record or replay the traffic of the providers if configured.
+	var traffic oraclefactory.Traffic
+	if recordTrafficPath != "" {
+		traffic.Recorder, err = recorder.New(recorder.Config{
+			Path:       recordTrafficPath,
+			MaxSize:    recordTrafficSize,
+			MaxBackups: recordTrafficFiles,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create traffic recorder: %w", err)
+		}
+		defer traffic.Recorder.Close()
+
+		logger.Info("recording provider traffic", zap.String("path", recordTrafficPath))
+	}
+	if replayTrafficPath != "" {
+		traffic.Recording, err = recorder.Load(replayTrafficPath)
+		if err != nil {
+			return fmt.Errorf("failed to load traffic recording: %w", err)
+		}
+
+		logger.Info("replaying provider traffic", zap.String("path", replayTrafficPath))
+	}
+
 	// Define the oracle options. These determine how the oracle is created & executed.
 	oracleOpts := []oracle.Option{
 		oracle.WithLogger(logger),
 		oracle.WithMarketMap(marketCfg),
-		oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),             // Replace with custom API query handler factory.
-		oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory), // Replace with custom websocket query handler factory.
+		oracle.WithPriceAPIQueryHandlerFactory(traffic.APIQueryHandlerFactory()),             // Replace with custom API query handler factory.
+		oracle.WithPriceWebSocketQueryHandlerFactory(traffic.WebSocketQueryHandlerFactory()), // Replace with custom websocket query handler factory.
 		oracle.WithMarketMapperFactory(oraclefactory.MarketMapProviderFactory),
 		oracle.WithMetrics(metrics),
 	}
 	if updateMarketCfgPath != "" {
 		oracleOpts = append(oracleOpts, oracle.WithWriteTo(updateMarketCfgPath))
 	}
+	if priceSnapshotDir != "" {
+		db, err := dbm.NewGoLevelDB(priceSnapshotDBName, priceSnapshotDir, nil)
+		if err != nil {
+			return fmt.Errorf("failed to open price snapshot db in %s: %w", priceSnapshotDir, err)
+		}
+		defer db.Close()
+
+		oracleOpts = append(oracleOpts, oracle.WithPriceSnapshotDB(db))
+	}
 
 	// Create the oracle and start the oracle.
 	orc, err := oracle.New(
@@ -282,6 +361,25 @@ func runOracle() error {
 	}()
 	defer orc.Stop()
 
+	// reload the oracle config when the config file changes or on SIGHUP
+	if oracleCfgPath != "" {
+		go func() {
+			err := cmdconfig.WatchOracleConfig(ctx, logger, oracleCfgPath, marketMapProvider, func(cfg config.OracleConfig) error {
+				if marketMapEndPoint != "" {
+					var err error
+					if cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint); err != nil {
+						return fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
+					}
+				}
+
+				return orc.UpdateConfig(cfg)
+			})
+			if err != nil {
+				logger.Error("failed to watch oracle config", zap.Error(err))
+			}
+		}()
+	}
+
 	srv := oracleserver.NewOracleServer(orc, logger)
 
 	// cancel oracle on interrupt or terminate
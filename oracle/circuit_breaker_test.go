@@ -0,0 +1,103 @@
+package oracle_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	mathtestutils "github.com/skip-mev/slinky/pkg/math/testutils"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+func (s *OracleTestSuite) TestCircuitBreaker() {
+	cfg := config.OracleConfig{
+		UpdateInterval: 500 * time.Millisecond,
+		MaxPriceAge:    1 * time.Minute,
+		Metrics:        oracleCfg.Metrics,
+		Host:           oracleCfg.Host,
+		Port:           oracleCfg.Port,
+	}
+
+	providerCfg := providerCfg1
+	providerCfg.CircuitBreaker = config.CircuitBreakerConfig{
+		MaxConsecutiveFailures: 2,
+		CoolDown:               time.Minute,
+	}
+
+	// The provider reports a price once and fails afterwards, which trips its circuit breaker.
+	responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{
+		providertypes.NewGetResponse[types.ProviderTicker, *big.Float](
+			types.ResolvedPrices{
+				s.currencyPairs[0]: {
+					Value:     big.NewFloat(100),
+					Timestamp: time.Now().UTC(),
+				},
+			},
+			nil,
+		),
+		types.NewPriceResponseWithErr(
+			[]types.ProviderTicker{s.currencyPairs[0]},
+			providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+		),
+		types.NewPriceResponseWithErr(
+			[]types.ProviderTicker{s.currencyPairs[0]},
+			providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+		),
+	}
+
+	provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+		s.T(),
+		s.logger,
+		providerCfg,
+		s.currencyPairs,
+		responses,
+		200*time.Millisecond,
+	)
+
+	testOracle, err := oracle.New(
+		cfg,
+		mathtestutils.NewMedianAggregator(),
+		oracle.WithLogger(s.logger),
+		oracle.WithPriceProviders(provider),
+		oracle.WithMarketMap(s.marketmap),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates, unsubscribe := testOracle.Subscribe()
+	defer unsubscribe()
+
+	go func() {
+		err := testOracle.Start(ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			s.T().Errorf("Start() should have returned context.Canceled error. Got: %v", err)
+		}
+	}()
+
+	// Wait for a few price updates.
+	for i := 0; i < 3; i++ {
+		select {
+		case <-updates:
+		case <-time.After(5 * cfg.UpdateInterval):
+			s.T().Fatal("expected a price update")
+		}
+	}
+
+	// The provider still has a recent price, but it is quarantined.
+	s.Require().NotEmpty(provider.GetData())
+	s.Require().Empty(testOracle.GetPrices())
+
+	status := testOracle.GetProviderCircuitBreakers()[providerCfg.Name]
+	s.Require().Equal(providertypes.CircuitBreakerOpen, status.State)
+	s.Require().Equal(providertypes.CircuitBreakerFailures, status.Reason)
+
+	testOracle.Stop()
+}
@@ -2,9 +2,33 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
+const (
+	// TickersPlaceholder is the placeholder in the URL of a generic API provider that is replaced
+	// with the off-chain tickers being queried.
+	TickersPlaceholder = "{tickers}"
+
+	// TickerPlaceholder is the placeholder in the paths of a generic API provider that is replaced
+	// with the off-chain ticker whose data is being extracted.
+	TickerPlaceholder = "{ticker}"
+
+	// EndpointModeFallback is the endpoint mode in which a REST API provider queries its endpoints
+	// in order, falling back to the next endpoint for any IDs the previous endpoint failed to
+	// resolve. This is the default endpoint mode.
+	EndpointModeFallback = "fallback"
+
+	// EndpointModeFreshest is the endpoint mode in which a REST API provider queries all of its
+	// endpoints and uses the most recent value that was returned for each ID.
+	EndpointModeFreshest = "freshest"
+
+	// EndpointModeMedian is the endpoint mode in which a REST API provider queries all of its
+	// endpoints and uses the median of the prices that were returned for each ID.
+	EndpointModeMedian = "median"
+)
+
 // APIConfig defines a config for an API based data provider.
 type APIConfig struct {
 	// Enabled is a flag that indicates whether the provider is API based.
@@ -32,6 +56,16 @@ type APIConfig struct {
 	// Endpoints is a list of endpoints that the provider can query.
 	Endpoints []Endpoint `json:"endpoints"`
 
+	// EndpointMode determines how a REST API provider uses its endpoints if multiple endpoints
+	// are configured. It must be one of EndpointModeFallback, EndpointModeFreshest or
+	// EndpointModeMedian. Defaults to EndpointModeFallback.
+	EndpointMode string `json:"endpointMode"`
+
+	// Quorum is the minimum number of endpoints that must return a value for an ID for the
+	// value to be used if the endpoint mode is EndpointModeFreshest or EndpointModeMedian.
+	// Defaults to a majority of the endpoints.
+	Quorum int `json:"quorum"`
+
 	// BatchSize is the maximum number of IDs that the provider can query in a single
 	// request. This parameter must be 0 for atomic providers. Otherwise, the effective
 	// value will be max(1, BatchSize). Notice, if numCPs > batchSize * maxQueries then
@@ -40,6 +74,15 @@ type APIConfig struct {
 
 	// Name is the name of the provider that corresponds to this config.
 	Name string `json:"name"`
+
+	// RateLimit is the (optional) request budget of the provider. If set, requests to the
+	// provider are limited to the budget in addition to MaxQueries and Interval.
+	RateLimit RateLimitConfig `json:"rateLimit"`
+
+	// Generic is the (optional) config for a generic API provider, i.e. a provider whose URL and
+	// response format are entirely described by the config. If set, the provider does not need
+	// a dedicated implementation.
+	Generic GenericAPIConfig `json:"generic"`
 }
 
 // Endpoint holds all data necessary for an API provider to connect to a given endpoint
@@ -62,6 +105,53 @@ func (e Endpoint) ValidateBasic() error {
 	return e.Authentication.ValidateBasic()
 }
 
+// RateLimitConfig defines the request budget of an API provider as a token bucket, i.e. a
+// sustained rate of requests that may be exceeded by up to Burst requests at once.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained number of requests per second that may be made to the
+	// provider. Rate limiting is disabled if this is not set.
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+
+	// Burst is the maximum number of requests that may be made at once. Defaults to 1.
+	Burst int `json:"burst"`
+
+	// Group is the (optional) name of a request budget that is shared by all providers with the
+	// same group, e.g. providers that query the same API with the same API key.
+	Group string `json:"group"`
+}
+
+// Enabled returns true if rate limiting is enabled.
+func (r RateLimitConfig) Enabled() bool {
+	return r.RequestsPerSecond > 0
+}
+
+// EffectiveBurst returns the maximum number of requests that may be made at once, i.e. the
+// configured burst or 1 if the burst is not set.
+func (r RateLimitConfig) EffectiveBurst() int {
+	if r.Burst > 0 {
+		return r.Burst
+	}
+
+	return 1
+}
+
+// ValidateBasic performs basic validation of the rate limit config.
+func (r RateLimitConfig) ValidateBasic() error {
+	if r.RequestsPerSecond < 0 {
+		return fmt.Errorf("rate limit requests per second cannot be negative")
+	}
+
+	if r.Burst < 0 {
+		return fmt.Errorf("rate limit burst cannot be negative")
+	}
+
+	if !r.Enabled() && (r.Burst != 0 || len(r.Group) != 0) {
+		return fmt.Errorf("rate limit burst and group cannot be set without requests per second")
+	}
+
+	return nil
+}
+
 // Authentication holds all data necessary for an API provider to authenticate with an
 // endpoint.
 type Authentication struct {
@@ -91,6 +181,78 @@ func (a Authentication) ValidateBasic() error {
 	return nil
 }
 
+// GenericAPIConfig describes how a generic API provider creates its requests and extracts prices
+// from the responses. The URL of the provider is the URL of its first endpoint, in which the
+// TickersPlaceholder is replaced with the off-chain tickers being queried. The batching of tickers
+// follows the Atomic and BatchSize settings of the API config.
+//
+// Values are extracted using gjson paths, e.g. "data.{ticker}.price" or "result.0.last", in which
+// the TickerPlaceholder is replaced with the (escaped) off-chain ticker. Extracted values may either
+// be JSON numbers or strings.
+type GenericAPIConfig struct {
+	// TickerSeparator is the separator used to join the off-chain tickers in the URL. Defaults
+	// to ",".
+	TickerSeparator string `json:"tickerSeparator"`
+
+	// ListPath is the path to the list of ticker entries in the response, if the response is a
+	// list of entries. This is only used if TickerPath is set. If empty, the response itself is
+	// expected to be the list.
+	ListPath string `json:"listPath"`
+
+	// TickerPath is the path to the off-chain ticker within each entry of the list. If empty, the
+	// response is expected to be an object from which the data of each ticker is extracted by
+	// replacing the TickerPlaceholder in the paths below.
+	TickerPath string `json:"tickerPath"`
+
+	// PricePath is the path to the price of a ticker.
+	PricePath string `json:"pricePath"`
+
+	// VolumePath is the (optional) path to the 24 hour volume of a ticker.
+	VolumePath string `json:"volumePath"`
+
+	// BestBidPath is the (optional) path to the best bid of a ticker.
+	BestBidPath string `json:"bestBidPath"`
+
+	// BestAskPath is the (optional) path to the best ask of a ticker.
+	BestAskPath string `json:"bestAskPath"`
+}
+
+// Enabled returns true if the generic API config is set.
+func (g GenericAPIConfig) Enabled() bool {
+	return g != GenericAPIConfig{}
+}
+
+// ValidateBasic performs basic validation of the generic API config.
+func (g GenericAPIConfig) ValidateBasic() error {
+	if !g.Enabled() {
+		return nil
+	}
+
+	if len(g.PricePath) == 0 {
+		return fmt.Errorf("generic api price path cannot be empty")
+	}
+
+	if len(g.TickerPath) == 0 && len(g.ListPath) != 0 {
+		return fmt.Errorf("generic api list path cannot be set without a ticker path")
+	}
+
+	if (len(g.BestBidPath) == 0) != (len(g.BestAskPath) == 0) {
+		return fmt.Errorf("generic api best bid and best ask paths must be set together")
+	}
+
+	return nil
+}
+
+// EffectiveQuorum returns the minimum number of endpoints that must return a value for an ID,
+// i.e. the configured quorum or a majority of the endpoints if the quorum is not set.
+func (c *APIConfig) EffectiveQuorum() int {
+	if c.Quorum > 0 {
+		return c.Quorum
+	}
+
+	return len(c.Endpoints)/2 + 1
+}
+
 // ValidateBasic performs basic validation of the API config.
 func (c *APIConfig) ValidateBasic() error {
 	if !c.Enabled {
@@ -123,5 +285,44 @@ func (c *APIConfig) ValidateBasic() error {
 		}
 	}
 
+	if err := c.RateLimit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	switch c.EndpointMode {
+	case "", EndpointModeFallback:
+		if c.Quorum != 0 {
+			return fmt.Errorf("quorum can only be set for the %s and %s endpoint modes", EndpointModeFreshest, EndpointModeMedian)
+		}
+	case EndpointModeFreshest, EndpointModeMedian:
+		if c.Quorum < 0 || c.Quorum > len(c.Endpoints) {
+			return fmt.Errorf("quorum must be between 0 and the number of endpoints (%d), got %d", len(c.Endpoints), c.Quorum)
+		}
+	default:
+		return fmt.Errorf("invalid endpoint mode %s", c.EndpointMode)
+	}
+
+	if c.Generic.Enabled() {
+		if err := c.Generic.ValidateBasic(); err != nil {
+			return err
+		}
+
+		for _, e := range c.Endpoints {
+			if !strings.Contains(e.URL, TickersPlaceholder) {
+				return fmt.Errorf("generic api url must contain the %s placeholder", TickersPlaceholder)
+			}
+		}
+
+		// Without a ticker path or a ticker placeholder in the price path, the response can only
+		// hold the price of a single ticker.
+		multipleTickers := c.Atomic || c.BatchSize > 1
+		if multipleTickers && len(c.Generic.TickerPath) == 0 && !strings.Contains(c.Generic.PricePath, TickerPlaceholder) {
+			return fmt.Errorf(
+				"generic api price path must contain the %s placeholder or a ticker path must be set when querying multiple tickers",
+				TickerPlaceholder,
+			)
+		}
+	}
+
 	return nil
 }
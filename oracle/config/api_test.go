@@ -216,6 +216,280 @@ func TestAPIConfig(t *testing.T) {
 				BatchSize: 1,
 			},
 		},
+		{
+			name: "good generic config",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}},
+				BatchSize:        10,
+				Generic: config.GenericAPIConfig{
+					PricePath: "data.{ticker}.price",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good generic config with ticker path",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}},
+				Atomic:           true,
+				Generic: config.GenericAPIConfig{
+					ListPath:    "data",
+					TickerPath:  "symbol",
+					PricePath:   "price",
+					BestBidPath: "bid",
+					BestAskPath: "ask",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good generic config for a single ticker per request",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}},
+				Generic: config.GenericAPIConfig{
+					PricePath: "data.price",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad generic config with no price path",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}},
+				Generic: config.GenericAPIConfig{
+					TickerPath: "symbol",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad generic config with no tickers placeholder in the url",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				Generic: config.GenericAPIConfig{
+					PricePath: "data.price",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad generic config with list path but no ticker path",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}},
+				Generic: config.GenericAPIConfig{
+					ListPath:  "data",
+					PricePath: "price",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad generic config with only a best bid path",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}},
+				Generic: config.GenericAPIConfig{
+					PricePath:   "data.price",
+					BestBidPath: "data.bid",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad generic config querying multiple tickers without a ticker placeholder",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}},
+				BatchSize:        10,
+				Generic: config.GenericAPIConfig{
+					PricePath: "data.price",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with the median endpoint mode",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}, {URL: "http://test.org"}},
+				EndpointMode:     config.EndpointModeMedian,
+				Quorum:           2,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config with the freshest endpoint mode and default quorum",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}, {URL: "http://test.org"}},
+				EndpointMode:     config.EndpointModeFreshest,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with an invalid endpoint mode",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}, {URL: "http://test.org"}},
+				EndpointMode:     "random",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a quorum larger than the number of endpoints",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}, {URL: "http://test.org"}},
+				EndpointMode:     config.EndpointModeMedian,
+				Quorum:           3,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a quorum in the fallback endpoint mode",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}, {URL: "http://test.org"}},
+				Quorum:           1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad generic config with an endpoint without a tickers placeholder",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{tickers}"}, {URL: "http://test.org"}},
+				Generic: config.GenericAPIConfig{
+					PricePath: "data.price",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with a rate limit",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					RequestsPerSecond: 0.5,
+					Burst:             2,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a negative rate limit",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					RequestsPerSecond: -1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a rate limit burst but no rate",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					Burst: 2,
+				},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
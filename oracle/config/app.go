@@ -38,6 +38,21 @@ client_timeout = "{{ .Oracle.ClientTimeout }}"
 # this enables instrumentation of the oracle client and the interaction between
 # the oracle and the app.
 metrics_enabled = "{{ .Oracle.MetricsEnabled }}"
+
+# PrefetchPrices determines whether the oracle client fetches prices from the
+# oracle in the background. If enabled, vote extensions are built from the
+# latest prefetched prices instead of querying the oracle on demand.
+prefetch_prices = "{{ .Oracle.PrefetchPrices }}"
+
+# PrefetchInterval is the interval at which the oracle client fetches prices
+# from the oracle in the background. This is only used if prefetch_prices is
+# enabled.
+prefetch_interval = "{{ .Oracle.PrefetchInterval }}"
+
+# MaxPriceAge is the maximum age of the prefetched prices. Prefetched prices
+# that were fetched, or last synced by the oracle, longer ago are not included
+# in vote extensions. This is only used if prefetch_prices is enabled.
+max_price_age = "{{ .Oracle.MaxPriceAge }}"
 `
 )
 
@@ -47,6 +62,9 @@ const (
 	flagClientTimeout           = "oracle.client_timeout"
 	flagMetricsEnabled          = "oracle.metrics_enabled"
 	flagPrometheusServerAddress = "oracle.prometheus_server_address"
+	flagPrefetchPrices          = "oracle.prefetch_prices"
+	flagPrefetchInterval        = "oracle.prefetch_interval"
+	flagMaxPriceAge             = "oracle.max_price_age"
 )
 
 // AppConfig contains the application side oracle configurations that must
@@ -65,6 +83,19 @@ type AppConfig struct {
 
 	// MetricsEnabled is a flag that determines whether oracle metrics are enabled.
 	MetricsEnabled bool `mapstructure:"metrics_enabled" toml:"metrics_enabled"`
+
+	// PrefetchPrices is a flag that determines whether the client fetches prices
+	// from the oracle in the background and serves them from a local cache.
+	PrefetchPrices bool `mapstructure:"prefetch_prices" toml:"prefetch_prices"`
+
+	// PrefetchInterval is the interval at which the client fetches prices from
+	// the oracle in the background.
+	PrefetchInterval time.Duration `mapstructure:"prefetch_interval" toml:"prefetch_interval"`
+
+	// MaxPriceAge is the maximum age of the prefetched prices that the client
+	// serves. Prices are stale if they were fetched, or last synced by the
+	// oracle, longer ago.
+	MaxPriceAge time.Duration `mapstructure:"max_price_age" toml:"max_price_age"`
 }
 
 // ValidateBasic performs basic validation of the app config.
@@ -81,6 +112,16 @@ func (c *AppConfig) ValidateBasic() error {
 		return fmt.Errorf("oracle client timeout must be greater than 0")
 	}
 
+	if c.PrefetchPrices {
+		if c.PrefetchInterval <= 0 {
+			return fmt.Errorf("oracle prefetch interval must be greater than 0")
+		}
+
+		if c.MaxPriceAge < c.PrefetchInterval {
+			return fmt.Errorf("oracle max price age must be at least the prefetch interval")
+		}
+	}
+
 	return nil
 }
 
@@ -143,6 +184,27 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// get the prefetch prices
+	if v := opts.Get(flagPrefetchPrices); v != nil {
+		if cfg.PrefetchPrices, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	// get the prefetch interval
+	if v := opts.Get(flagPrefetchInterval); v != nil {
+		if cfg.PrefetchInterval, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	// get the max price age
+	if v := opts.Get(flagMaxPriceAge); v != nil {
+		if cfg.MaxPriceAge, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+
 	if err := cfg.ValidateBasic(); err != nil {
 		return cfg, err
 	}
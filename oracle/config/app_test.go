@@ -68,6 +68,41 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "good config with prefetched prices",
+			config: config.AppConfig{
+				Enabled:          true,
+				OracleAddress:    "localhost:8080",
+				ClientTimeout:    time.Second,
+				PrefetchPrices:   true,
+				PrefetchInterval: 500 * time.Millisecond,
+				MaxPriceAge:      2 * time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with no prefetch interval",
+			config: config.AppConfig{
+				Enabled:        true,
+				OracleAddress:  "localhost:8080",
+				ClientTimeout:  time.Second,
+				PrefetchPrices: true,
+				MaxPriceAge:    2 * time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with max price age less than prefetch interval",
+			config: config.AppConfig{
+				Enabled:          true,
+				OracleAddress:    "localhost:8080",
+				ClientTimeout:    time.Second,
+				PrefetchPrices:   true,
+				PrefetchInterval: time.Second,
+				MaxPriceAge:      500 * time.Millisecond,
+			},
+			expectedErr: true,
+		},
 		{
 			name: "bad config with no oracle address",
 			config: config.AppConfig{
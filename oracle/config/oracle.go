@@ -43,10 +43,22 @@ func (c *OracleConfig) ValidateBasic() error {
 		return fmt.Errorf("oracle max price age must be greater than 0")
 	}
 
+	// Providers that share a rate limit group must agree on the request budget.
+	rateLimitGroups := make(map[string]RateLimitConfig)
 	for _, p := range c.Providers {
 		if err := p.ValidateBasic(); err != nil {
 			return fmt.Errorf("provider is not formatted correctly: %w", err)
 		}
+
+		group := p.API.RateLimit.Group
+		if !p.API.Enabled || len(group) == 0 {
+			continue
+		}
+
+		if rateLimit, ok := rateLimitGroups[group]; ok && rateLimit != p.API.RateLimit {
+			return fmt.Errorf("provider %s has a different rate limit than other providers in group %s", p.Name, group)
+		}
+		rateLimitGroups[group] = p.API.RateLimit
 	}
 
 	if len(c.Host) == 0 {
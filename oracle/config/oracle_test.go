@@ -185,6 +185,98 @@ func TestOracleConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with a shared rate limit group",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				Providers: map[string]config.ProviderConfig{
+					"test1": {
+						Name: "test1",
+						API: config.APIConfig{
+							Enabled:          true,
+							Timeout:          time.Second,
+							Interval:         time.Second,
+							ReconnectTimeout: time.Second,
+							MaxQueries:       1,
+							Name:             "test1",
+							Endpoints:        []config.Endpoint{{URL: "https://test1.com"}},
+							RateLimit: config.RateLimitConfig{
+								RequestsPerSecond: 1,
+								Group:             "shared",
+							},
+						},
+						Type: "price_provider",
+					},
+					"test2": {
+						Name: "test2",
+						API: config.APIConfig{
+							Enabled:          true,
+							Timeout:          time.Second,
+							Interval:         time.Second,
+							ReconnectTimeout: time.Second,
+							MaxQueries:       1,
+							Name:             "test2",
+							Endpoints:        []config.Endpoint{{URL: "https://test2.com"}},
+							RateLimit: config.RateLimitConfig{
+								RequestsPerSecond: 1,
+								Group:             "shared",
+							},
+						},
+						Type: "price_provider",
+					},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with mismatched rate limits in a group",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				Providers: map[string]config.ProviderConfig{
+					"test1": {
+						Name: "test1",
+						API: config.APIConfig{
+							Enabled:          true,
+							Timeout:          time.Second,
+							Interval:         time.Second,
+							ReconnectTimeout: time.Second,
+							MaxQueries:       1,
+							Name:             "test1",
+							Endpoints:        []config.Endpoint{{URL: "https://test1.com"}},
+							RateLimit: config.RateLimitConfig{
+								RequestsPerSecond: 1,
+								Group:             "shared",
+							},
+						},
+						Type: "price_provider",
+					},
+					"test2": {
+						Name: "test2",
+						API: config.APIConfig{
+							Enabled:          true,
+							Timeout:          time.Second,
+							Interval:         time.Second,
+							ReconnectTimeout: time.Second,
+							MaxQueries:       1,
+							Name:             "test2",
+							Endpoints:        []config.Endpoint{{URL: "https://test2.com"}},
+							RateLimit: config.RateLimitConfig{
+								RequestsPerSecond: 2,
+								Group:             "shared",
+							},
+						},
+						Type: "price_provider",
+					},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
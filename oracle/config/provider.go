@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 )
 
 // ProviderConfig defines a config for a provider. To add a new provider, add the provider
@@ -21,6 +22,60 @@ type ProviderConfig struct {
 	// Type is the type of the provider (i.e. price, market map, other). This is used
 	// to determine how to construct the provider.
 	Type string `json:"type"`
+
+	// CircuitBreaker is the (optional) circuit breaker config of the provider. If enabled, the
+	// provider is quarantined for a cool-down period after repeated failures or outlier prices.
+	CircuitBreaker CircuitBreakerConfig `json:"circuitBreaker"`
+}
+
+// CircuitBreakerConfig defines when a provider is quarantined, i.e. when its data is no longer
+// used by the oracle. The circuit breaker trips (opens) after MaxConsecutiveFailures consecutive
+// failed responses or MaxConsecutiveOutliers consecutive price updates in which the provider's
+// prices were rejected as outliers. After the cool-down, the circuit breaker half-opens and the
+// provider's data is used again to probe whether it has recovered.
+type CircuitBreakerConfig struct {
+	// MaxConsecutiveFailures is the number of consecutive failed responses after which the
+	// circuit breaker trips. The circuit breaker does not trip on failures if this is not set.
+	MaxConsecutiveFailures int `json:"maxConsecutiveFailures"`
+
+	// MaxConsecutiveOutliers is the number of consecutive price updates with outlier prices
+	// after which the circuit breaker trips. The circuit breaker does not trip on outliers if
+	// this is not set.
+	MaxConsecutiveOutliers int `json:"maxConsecutiveOutliers"`
+
+	// CoolDown is the amount of time the provider is quarantined for once the circuit breaker
+	// trips.
+	CoolDown time.Duration `json:"coolDown"`
+}
+
+// Enabled returns true if the circuit breaker is enabled.
+func (c CircuitBreakerConfig) Enabled() bool {
+	return c.MaxConsecutiveFailures > 0 || c.MaxConsecutiveOutliers > 0
+}
+
+// ValidateBasic performs basic validation of the circuit breaker config.
+func (c CircuitBreakerConfig) ValidateBasic() error {
+	if c.MaxConsecutiveFailures < 0 {
+		return fmt.Errorf("circuit breaker max consecutive failures cannot be negative")
+	}
+
+	if c.MaxConsecutiveOutliers < 0 {
+		return fmt.Errorf("circuit breaker max consecutive outliers cannot be negative")
+	}
+
+	if c.CoolDown < 0 {
+		return fmt.Errorf("circuit breaker cool down cannot be negative")
+	}
+
+	if c.Enabled() && c.CoolDown == 0 {
+		return fmt.Errorf("circuit breaker cool down must be set if the circuit breaker is enabled")
+	}
+
+	if !c.Enabled() && c.CoolDown != 0 {
+		return fmt.Errorf("circuit breaker cool down cannot be set without a failure or outlier threshold")
+	}
+
+	return nil
 }
 
 func (c *ProviderConfig) ValidateBasic() error {
@@ -60,5 +115,9 @@ func (c *ProviderConfig) ValidateBasic() error {
 		return fmt.Errorf("type cannot be empty")
 	}
 
+	if err := c.CircuitBreaker.ValidateBasic(); err != nil {
+		return fmt.Errorf("circuit breaker config for %s is not formatted correctly: %w", c.Name, err)
+	}
+
 	return nil
 }
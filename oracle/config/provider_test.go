@@ -194,6 +194,93 @@ func TestProviderConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good circuit breaker config",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				},
+				Name: "test",
+				Type: "price_provider",
+				CircuitBreaker: config.CircuitBreakerConfig{
+					MaxConsecutiveFailures: 5,
+					MaxConsecutiveOutliers: 3,
+					CoolDown:               time.Minute,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "circuit breaker without cool down",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				},
+				Name: "test",
+				Type: "price_provider",
+				CircuitBreaker: config.CircuitBreakerConfig{
+					MaxConsecutiveFailures: 5,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "circuit breaker cool down without thresholds",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				},
+				Name: "test",
+				Type: "price_provider",
+				CircuitBreaker: config.CircuitBreakerConfig{
+					CoolDown: time.Minute,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative circuit breaker threshold",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				},
+				Name: "test",
+				Type: "price_provider",
+				CircuitBreaker: config.CircuitBreakerConfig{
+					MaxConsecutiveOutliers: -1,
+					CoolDown:               time.Minute,
+				},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
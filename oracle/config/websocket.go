@@ -83,7 +83,8 @@ type WebSocketConfig struct {
 	PostConnectionTimeout time.Duration `json:"postConnectionTimeout"`
 
 	// Endpoints are the websocket endpoints for the provider. At least one endpoint
-	// must be specified.
+	// must be specified. If multiple endpoints are specified, the provider rotates
+	// through them on every reconnection, skipping endpoints that recently failed.
 	Endpoints []Endpoint `json:"endpoints"`
 
 	// Name is the name of the provider that corresponds to this config.
@@ -203,7 +203,14 @@ type noOpPriceAggregator struct{}
 func (n noOpPriceAggregator) SetProviderPrices(_ string, _ oracletypes.Prices) {
 }
 
-func (n noOpPriceAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {
+func (n noOpPriceAggregator) SetProviderMarketData(_ string, _ oracletypes.TickerMarketData) {
+}
+
+func (n noOpPriceAggregator) SetProviderTimestamps(_ string, _ oracletypes.ProviderTimestamps) {
+}
+
+func (n noOpPriceAggregator) UpdateMarketMap(_ mmtypes.MarketMap) error {
+	return nil
 }
 
 func (n noOpPriceAggregator) AggregatePrices() {
@@ -213,6 +220,25 @@ func (n noOpPriceAggregator) GetPrices() oracletypes.Prices {
 	return oracletypes.Prices{}
 }
 
+func (n noOpPriceAggregator) GetPriceDispersion() oracletypes.Prices {
+	return oracletypes.Prices{}
+}
+
+func (n noOpPriceAggregator) GetMarketData() oracletypes.TickerMarketData {
+	return oracletypes.TickerMarketData{}
+}
+
+func (n noOpPriceAggregator) GetPriceDetails() oracletypes.TickerPriceDetails {
+	return oracletypes.TickerPriceDetails{}
+}
+
+func (n noOpPriceAggregator) SetIndexPrices(_ oracletypes.Prices) {
+}
+
+func (n noOpPriceAggregator) GetIndexPrices() oracletypes.Prices {
+	return oracletypes.Prices{}
+}
+
 func (n noOpPriceAggregator) Reset() {
 }
 
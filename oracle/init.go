@@ -46,11 +46,30 @@ func (o *OracleImpl) Init(ctx context.Context) error {
 
 // createPriceProvider creates a new price provider for the given provider configuration.
 func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.ProviderConfig) error {
+	state, err := o.newPriceProvider(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Add the provider to the oracle.
+	o.priceProviders[state.Provider.Name()] = state
+
+	o.logger.Info(
+		"created price provider state",
+		zap.String("provider", state.Provider.Name()),
+		zap.Int("num_tickers", len(state.Provider.GetIDs())),
+	)
+	return nil
+}
+
+// newPriceProvider returns the state of a new price provider for the given provider configuration.
+// The provider is not added to the oracle.
+func (o *OracleImpl) newPriceProvider(ctx context.Context, cfg config.ProviderConfig) (ProviderState, error) {
 	// Create the provider market map. This creates the tickers the provider is configured to
 	// support.
 	tickers, err := types.ProviderTickersFromMarketMap(cfg.Name, o.marketMap)
 	if err != nil {
-		return fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
+		return ProviderState{}, fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
 	}
 
 	// Select the query handler based on the provider's configuration.
@@ -59,7 +78,7 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 	case cfg.API.Enabled:
 		queryHandler, err := o.createAPIQueryHandler(ctx, cfg)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
 		}
 
 		provider, err = types.NewPriceProvider(
@@ -69,14 +88,15 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 			base.WithAPIConfig[types.ProviderTicker, *big.Float](cfg.API),
 			base.WithIDs[types.ProviderTicker, *big.Float](tickers),
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
+			base.WithCircuitBreakerConfig[types.ProviderTicker, *big.Float](cfg.CircuitBreaker),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
 		}
 	case cfg.WebSocket.Enabled:
 		queryHandler, err := o.createWebSocketQueryHandler(ctx, cfg)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's web socket query handler: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's web socket query handler: %w", cfg.Name, err)
 		}
 
 		provider, err = types.NewPriceProvider(
@@ -86,28 +106,19 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 			base.WithWebSocketConfig[types.ProviderTicker, *big.Float](cfg.WebSocket),
 			base.WithIDs[types.ProviderTicker, *big.Float](tickers),
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
+			base.WithCircuitBreakerConfig[types.ProviderTicker, *big.Float](cfg.CircuitBreaker),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
 		}
 	default:
-		return fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
+		return ProviderState{}, fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
 	}
 
-	state := ProviderState{
+	return ProviderState{
 		Provider: provider,
 		Cfg:      cfg,
-	}
-
-	// Add the provider to the oracle.
-	o.priceProviders[provider.Name()] = state
-
-	o.logger.Info(
-		"created price provider state",
-		zap.String("provider", provider.Name()),
-		zap.Int("num_tickers", len(provider.GetIDs())),
-	)
-	return nil
+	}, nil
 }
 
 // createAPIQueryHandler creates a new API query handler for the given provider configuration.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
@@ -15,7 +16,13 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetPriceDispersion() types.Prices
+	GetMarketData() types.TickerMarketData
+	GetPriceDetails() types.TickerPriceDetails
+	GetProviderCircuitBreakers() types.ProviderCircuitBreakers
 	GetMarketMap() mmtypes.MarketMap
+	Subscribe() (<-chan struct{}, func())
+	UpdateConfig(cfg config.OracleConfig) error
 	Start(ctx context.Context) error
 	Stop()
 }
@@ -26,9 +33,16 @@ type Oracle interface {
 //go:generate mockery --name PriceAggregator
 type PriceAggregator interface {
 	SetProviderPrices(provider string, prices types.Prices)
-	UpdateMarketMap(mmtypes.MarketMap)
+	SetProviderMarketData(provider string, data types.TickerMarketData)
+	SetProviderTimestamps(provider string, timestamps types.ProviderTimestamps)
+	UpdateMarketMap(mmtypes.MarketMap) error
 	AggregatePrices()
 	GetPrices() types.Prices
+	GetPriceDispersion() types.Prices
+	GetMarketData() types.TickerMarketData
+	GetPriceDetails() types.TickerPriceDetails
+	SetIndexPrices(prices types.Prices)
+	GetIndexPrices() types.Prices
 	Reset()
 }
 
@@ -24,6 +24,9 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	// Set the main context for the oracle.
 	ctx, _ = o.setMainCtx(ctx)
 
+	// Restore the prices persisted before the oracle was last stopped, if any.
+	o.restorePriceSnapshot()
+
 	// Start all price providers which have tickers.
 	for name, state := range o.priceProviders {
 		providerTickers, err := types.ProviderTickersFromMarketMap(name, o.marketMap)
@@ -58,7 +61,8 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	}
 
 	// Start price fetch loop.
-	ticker := time.NewTicker(o.cfg.UpdateInterval)
+	updateInterval := o.getUpdateInterval()
+	ticker := time.NewTicker(updateInterval)
 	defer ticker.Stop()
 	o.metrics.SetSlinkyBuildInfo()
 
@@ -70,6 +74,13 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 			return ctx.Err()
 		case <-ticker.C:
 			o.fetchAllPrices()
+
+			// The update interval may have been changed via UpdateConfig.
+			if interval := o.getUpdateInterval(); interval != updateInterval {
+				o.logger.Info("updating price fetch interval", zap.Duration("interval", interval))
+				ticker.Reset(interval)
+				updateInterval = interval
+			}
 		}
 	}
 }
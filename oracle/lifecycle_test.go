@@ -113,4 +113,45 @@ func TestStart(t *testing.T) {
 		// Stop the oracle.
 		o.Stop()
 	})
+
+	t.Run("notifies subscribers every time prices are updated", func(t *testing.T) {
+		orc, err := oracle.New(
+			oracleCfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMap(marketMap),
+		)
+		require.NoError(t, err)
+
+		updates, unsubscribe := orc.Subscribe()
+		unsubscribed, unsubscribeOther := orc.Subscribe()
+		unsubscribeOther()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			err := orc.Start(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Start() should have returned context.Canceled error")
+			}
+		}()
+
+		// Wait for two price updates.
+		for i := 0; i < 2; i++ {
+			select {
+			case <-updates:
+			case <-time.After(3 * oracleCfg.UpdateInterval):
+				t.Fatal("expected a price update notification")
+			}
+		}
+
+		// Unsubscribed channels should never be notified.
+		require.Len(t, unsubscribed, 0)
+		unsubscribe()
+
+		// Stop the oracle.
+		orc.Stop()
+	})
 }
@@ -52,6 +52,10 @@ type Metrics interface {
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(market string, count int)
 
+	// AddProviderOutlier increments the number of times a provider's price for a given
+	// market was rejected as an outlier before aggregation.
+	AddProviderOutlier(providerName, pairID string)
+
 	// SetSlinkyBuildInfo sets the build information for the Slinky binary.
 	SetSlinkyBuildInfo()
 }
@@ -64,6 +68,7 @@ type OracleMetricsImpl struct {
 	aggregatePrices *prometheus.GaugeVec
 	providerTick    *prometheus.CounterVec
 	providerCount   *prometheus.GaugeVec
+	providerOutlier *prometheus.CounterVec
 	slinkyBuildInfo *prometheus.GaugeVec
 }
 
@@ -109,6 +114,11 @@ func NewMetrics() Metrics {
 			Name:      "health_check_market_providers",
 			Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 		}, []string{PairIDLabel}),
+		providerOutlier: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "health_check_provider_outliers_total",
+			Help:      "Number of provider prices that were rejected as outliers before aggregation.",
+		}, []string{ProviderLabel, PairIDLabel}),
 		slinkyBuildInfo: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: OracleSubsystem,
 			Name:      "slinky_build_info",
@@ -123,6 +133,7 @@ func NewMetrics() Metrics {
 	prometheus.MustRegister(m.aggregatePrices)
 	prometheus.MustRegister(m.providerTick)
 	prometheus.MustRegister(m.providerCount)
+	prometheus.MustRegister(m.providerOutlier)
 	prometheus.MustRegister(m.slinkyBuildInfo)
 
 	return m
@@ -163,6 +174,11 @@ func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {
 }
 
+// AddProviderOutlier increments the number of times a provider's price for a given
+// market was rejected as an outlier before aggregation.
+func (m *noOpOracleMetrics) AddProviderOutlier(_, _ string) {
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary.
 func (m *noOpOracleMetrics) SetSlinkyBuildInfo() {}
 
@@ -228,6 +244,16 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	).Set(float64(count))
 }
 
+// AddProviderOutlier increments the number of times a provider's price for a given
+// market was rejected as an outlier before aggregation.
+func (m *OracleMetricsImpl) AddProviderOutlier(providerName, pairID string) {
+	m.providerOutlier.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+	},
+	).Add(1)
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary. The version exported
 // is determined by the build time version in accordance with the build pkg.
 func (m *OracleMetricsImpl) SetSlinkyBuildInfo() {
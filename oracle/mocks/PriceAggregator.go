@@ -7,6 +7,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/skip-mev/slinky/oracle/types"
+
+	time "time"
+
 	types "github.com/skip-mev/slinky/x/marketmap/types"
 )
 
@@ -20,6 +24,86 @@ func (_m *PriceAggregator) AggregatePrices() {
 	_m.Called()
 }
 
+// GetIndexPrices provides a mock function with given fields:
+func (_m *PriceAggregator) GetIndexPrices() map[string]*big.Float {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexPrices")
+	}
+
+	var r0 map[string]*big.Float
+	if rf, ok := ret.Get(0).(func() map[string]*big.Float); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*big.Float)
+		}
+	}
+
+	return r0
+}
+
+// GetMarketData provides a mock function with given fields:
+func (_m *PriceAggregator) GetMarketData() map[string]oracletypes.MarketData {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketData")
+	}
+
+	var r0 map[string]oracletypes.MarketData
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.MarketData); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.MarketData)
+		}
+	}
+
+	return r0
+}
+
+// GetPriceDetails provides a mock function with given fields:
+func (_m *PriceAggregator) GetPriceDetails() map[string]oracletypes.MarketPriceDetails {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDetails")
+	}
+
+	var r0 map[string]oracletypes.MarketPriceDetails
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.MarketPriceDetails); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.MarketPriceDetails)
+		}
+	}
+
+	return r0
+}
+
+// GetPriceDispersion provides a mock function with given fields:
+func (_m *PriceAggregator) GetPriceDispersion() map[string]*big.Float {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDispersion")
+	}
+
+	var r0 map[string]*big.Float
+	if rf, ok := ret.Get(0).(func() map[string]*big.Float); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*big.Float)
+		}
+	}
+
+	return r0
+}
+
 // GetPrices provides a mock function with given fields:
 func (_m *PriceAggregator) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -45,14 +129,42 @@ func (_m *PriceAggregator) Reset() {
 	_m.Called()
 }
 
+// SetIndexPrices provides a mock function with given fields: prices
+func (_m *PriceAggregator) SetIndexPrices(prices map[string]*big.Float) {
+	_m.Called(prices)
+}
+
+// SetProviderMarketData provides a mock function with given fields: provider, data
+func (_m *PriceAggregator) SetProviderMarketData(provider string, data map[string]oracletypes.MarketData) {
+	_m.Called(provider, data)
+}
+
 // SetProviderPrices provides a mock function with given fields: provider, prices
 func (_m *PriceAggregator) SetProviderPrices(provider string, prices map[string]*big.Float) {
 	_m.Called(provider, prices)
 }
 
+// SetProviderTimestamps provides a mock function with given fields: provider, timestamps
+func (_m *PriceAggregator) SetProviderTimestamps(provider string, timestamps map[string]time.Time) {
+	_m.Called(provider, timestamps)
+}
+
 // UpdateMarketMap provides a mock function with given fields: _a0
-func (_m *PriceAggregator) UpdateMarketMap(_a0 types.MarketMap) {
-	_m.Called(_a0)
+func (_m *PriceAggregator) UpdateMarketMap(_a0 types.MarketMap) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMarketMap")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.MarketMap) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // NewPriceAggregator creates a new instance of PriceAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
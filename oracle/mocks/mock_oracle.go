@@ -6,8 +6,14 @@ import (
 	context "context"
 	big "math/big"
 
+	config "github.com/skip-mev/slinky/oracle/config"
+
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/skip-mev/slinky/oracle/types"
+
+	providertypes "github.com/skip-mev/slinky/providers/types"
+
 	time "time"
 
 	types "github.com/skip-mev/slinky/x/marketmap/types"
@@ -36,6 +42,26 @@ func (_m *Oracle) GetLastSyncTime() time.Time {
 	return r0
 }
 
+// GetMarketData provides a mock function with given fields:
+func (_m *Oracle) GetMarketData() map[string]oracletypes.MarketData {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketData")
+	}
+
+	var r0 map[string]oracletypes.MarketData
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.MarketData); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.MarketData)
+		}
+	}
+
+	return r0
+}
+
 // GetMarketMap provides a mock function with given fields:
 func (_m *Oracle) GetMarketMap() types.MarketMap {
 	ret := _m.Called()
@@ -54,6 +80,46 @@ func (_m *Oracle) GetMarketMap() types.MarketMap {
 	return r0
 }
 
+// GetPriceDetails provides a mock function with given fields:
+func (_m *Oracle) GetPriceDetails() map[string]oracletypes.MarketPriceDetails {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDetails")
+	}
+
+	var r0 map[string]oracletypes.MarketPriceDetails
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.MarketPriceDetails); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.MarketPriceDetails)
+		}
+	}
+
+	return r0
+}
+
+// GetPriceDispersion provides a mock function with given fields:
+func (_m *Oracle) GetPriceDispersion() map[string]*big.Float {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDispersion")
+	}
+
+	var r0 map[string]*big.Float
+	if rf, ok := ret.Get(0).(func() map[string]*big.Float); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*big.Float)
+		}
+	}
+
+	return r0
+}
+
 // GetPrices provides a mock function with given fields:
 func (_m *Oracle) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -74,6 +140,26 @@ func (_m *Oracle) GetPrices() map[string]*big.Float {
 	return r0
 }
 
+// GetProviderCircuitBreakers provides a mock function with given fields:
+func (_m *Oracle) GetProviderCircuitBreakers() map[string]providertypes.CircuitBreakerStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderCircuitBreakers")
+	}
+
+	var r0 map[string]providertypes.CircuitBreakerStatus
+	if rf, ok := ret.Get(0).(func() map[string]providertypes.CircuitBreakerStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]providertypes.CircuitBreakerStatus)
+		}
+	}
+
+	return r0
+}
+
 // IsRunning provides a mock function with given fields:
 func (_m *Oracle) IsRunning() bool {
 	ret := _m.Called()
@@ -115,6 +201,56 @@ func (_m *Oracle) Stop() {
 	_m.Called()
 }
 
+// Subscribe provides a mock function with given fields:
+func (_m *Oracle) Subscribe() (<-chan struct{}, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan struct{}
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan struct{}, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateConfig provides a mock function with given fields: cfg
+func (_m *Oracle) UpdateConfig(cfg config.OracleConfig) error {
+	ret := _m.Called(cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(config.OracleConfig) error); ok {
+		r0 = rf(cfg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewOracle creates a new instance of Oracle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracle(t interface {
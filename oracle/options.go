@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	dbm "github.com/cosmos/cosmos-db"
 	"go.uber.org/zap"
 
 	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
@@ -77,6 +78,15 @@ func WithWriteTo(filePath string) Option {
 	}
 }
 
+// WithPriceSnapshotDB sets the database that the oracle persists its prices to after every price update.
+// The latest snapshot is restored when the oracle is started, such that prices can be served before all
+// providers have reconnected. Note that this is optional.
+func WithPriceSnapshotDB(db dbm.DB) Option {
+	return func(m *OracleImpl) {
+		m.snapshotDB = db
+	}
+}
+
 // WithPriceProviders allows pre-instantiated price providers to be used in the Oracle's price fetching loop.
 // This option is mainly used for testing, but can be useful for programmatically setting customized providers.
 func WithPriceProviders(pps ...*types.PriceProvider) Option {
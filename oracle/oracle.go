@@ -7,6 +7,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -47,6 +48,16 @@ type OracleImpl struct { //nolint:revive
 	aggregator PriceAggregator
 	// lastPriceSync is the last time the oracle successfully updated its prices.
 	lastPriceSync time.Time
+	// subscribers is the set of channels that are notified every time the oracle
+	// completes a price update.
+	subscribers map[chan struct{}]struct{}
+	// cachedPrices are the provider prices restored from the latest price snapshot. These are used
+	// until the provider reports a fresh price, or until they exceed the max price age. These are
+	// indexed by provider -> offChainTicker -> price.
+	cachedPrices map[string]map[string]types.PriceResult
+	// snapshotPrices are the provider prices used in the latest price update, which are persisted
+	// in the price snapshot. These are indexed by provider -> offChainTicker -> price.
+	snapshotPrices map[string]map[string]types.PriceResult
 
 	// -------------------Oracle Configuration Fields-------------------//
 	//
@@ -56,6 +67,9 @@ type OracleImpl struct { //nolint:revive
 	marketMap mmtypes.MarketMap
 	// writeTo is a path to write the market map to.
 	writeTo string
+	// snapshotDB is the (optional) database that price snapshots are persisted to, such that the
+	// oracle can serve prices immediately after a restart.
+	snapshotDB dbm.DB
 
 	// -------------------Provider Constructor Fields-------------------//
 	//
@@ -83,9 +97,8 @@ type OracleImpl struct { //nolint:revive
 type ProviderState struct {
 	// Provider is the price provider implementation.
 	Provider *types.PriceProvider
-	// Cfg is the provider configuration.
-	//
-	// TODO: Deprecate this once we have synchronous configuration updates.
+	// Cfg is the provider configuration. This is used to determine whether the provider
+	// must be recreated when the oracle configuration is updated.
 	Cfg config.ProviderConfig
 }
 
@@ -106,6 +119,9 @@ func New(
 		cfg:             cfg,
 		aggregator:      aggregator,
 		priceProviders:  make(map[string]ProviderState), // this will be initialized via the Init method.
+		subscribers:     make(map[chan struct{}]struct{}),
+		cachedPrices:    make(map[string]map[string]types.PriceResult),
+		snapshotPrices:  make(map[string]map[string]types.PriceResult),
 		logger:          zap.NewNop(),
 		wsMetrics:       wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics),
 		apiMetrics:      apimetrics.NewAPIMetricsFromConfig(cfg.Metrics),
@@ -149,3 +165,34 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 func (o *OracleImpl) GetPrices() types.Prices {
 	return o.aggregator.GetPrices()
 }
+
+// GetPriceDispersion returns the dispersion of the provider prices used to calculate each price, i.e.
+// the standard deviation of the converted prices scaled by the ticker's decimals.
+func (o *OracleImpl) GetPriceDispersion() types.Prices {
+	return o.aggregator.GetPriceDispersion()
+}
+
+// GetMarketData returns the aggregated market data (volume and best bid / ask) for each
+// ticker that reported it.
+func (o *OracleImpl) GetMarketData() types.TickerMarketData {
+	return o.aggregator.GetMarketData()
+}
+
+// GetPriceDetails returns the breakdown of the most recent index price calculation for each
+// market, i.e. the contribution of each provider and the resulting index price.
+func (o *OracleImpl) GetPriceDetails() types.TickerPriceDetails {
+	return o.aggregator.GetPriceDetails()
+}
+
+// GetProviderCircuitBreakers returns the status of each price provider's circuit breaker.
+func (o *OracleImpl) GetProviderCircuitBreakers() types.ProviderCircuitBreakers {
+	o.mut.RLock()
+	defer o.mut.RUnlock()
+
+	statuses := make(types.ProviderCircuitBreakers, len(o.priceProviders))
+	for name, state := range o.priceProviders {
+		statuses[name] = state.Provider.CircuitBreakerStatus()
+	}
+
+	return statuses
+}
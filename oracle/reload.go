@@ -0,0 +1,156 @@
+package oracle
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	mmclienttypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
+)
+
+// UpdateConfig updates the oracle's configuration without restarting the oracle. Price providers that
+// were added to the configuration are created and started, price providers that were removed are stopped,
+// and price providers whose configuration changed are recreated. All other price providers are left
+// untouched. The update interval and max price age take effect on the next price update.
+//
+// Changes to the host, port, metrics, and market map provider cannot be applied at runtime; they are
+// ignored and require the oracle to be restarted.
+func (o *OracleImpl) UpdateConfig(cfg config.OracleConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		o.logger.Error("failed to validate oracle config", zap.Error(err))
+		return err
+	}
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	cfg = o.retainStaticConfig(cfg)
+
+	// If the oracle is not running, the providers are created from the updated configuration
+	// when the oracle is (re)started.
+	if o.mainCtx == nil || o.mainCtx.Err() != nil {
+		o.cfg = cfg
+		return nil
+	}
+
+	// Create all providers that were added or reconfigured before stopping any of the existing
+	// providers, such that a configuration that fails to apply leaves the oracle unchanged.
+	updated := make(map[string]ProviderState)
+	for name, providerCfg := range cfg.Providers {
+		if providerCfg.Type != types.ConfigType {
+			continue
+		}
+
+		if state, ok := o.priceProviders[name]; ok && reflect.DeepEqual(state.Cfg, providerCfg) {
+			continue
+		}
+
+		state, err := o.newPriceProvider(o.mainCtx, providerCfg)
+		if err != nil {
+			o.logger.Error(
+				"failed to create provider",
+				zap.String("provider", name),
+				zap.Error(err),
+			)
+
+			return fmt.Errorf("failed to create %s provider: %w", name, err)
+		}
+
+		updated[name] = state
+	}
+
+	// Stop all providers that were removed or reconfigured.
+	for name, state := range o.priceProviders {
+		providerCfg, ok := cfg.Providers[name]
+		if _, reconfigured := updated[name]; ok && providerCfg.Type == types.ConfigType && !reconfigured {
+			continue
+		}
+
+		o.logger.Info("stopping price provider", zap.String("provider", name))
+		state.Provider.Stop()
+		delete(o.priceProviders, name)
+	}
+
+	// Start all providers that were added or reconfigured.
+	for name, state := range updated {
+		o.logger.Info("starting price provider", zap.String("provider", name))
+
+		updatedState, err := o.UpdateProviderState(state.Provider.GetIDs(), state)
+		if err != nil {
+			o.logger.Error("failed to update provider state", zap.String("provider", name), zap.Error(err))
+			return err
+		}
+
+		o.priceProviders[name] = updatedState
+	}
+
+	// Drop the shared rate limiters of rate limit groups that are no longer used by any provider.
+	handlers.PruneRateLimiters(cfg)
+
+	o.cfg = cfg
+	o.logger.Info(
+		"updated oracle config",
+		zap.Int("num_updated_providers", len(updated)),
+		zap.Int("num_providers", len(o.priceProviders)),
+	)
+
+	return nil
+}
+
+// retainStaticConfig returns the given configuration with the fields that cannot be updated at
+// runtime reset to the oracle's current configuration. This assumes the caller holds the lock.
+func (o *OracleImpl) retainStaticConfig(cfg config.OracleConfig) config.OracleConfig {
+	if cfg.Host != o.cfg.Host || cfg.Port != o.cfg.Port {
+		o.logger.Warn("oracle host and port cannot be updated without a restart; ignoring")
+		cfg.Host, cfg.Port = o.cfg.Host, o.cfg.Port
+	}
+
+	if cfg.Metrics != o.cfg.Metrics {
+		o.logger.Warn("oracle metrics cannot be updated without a restart; ignoring")
+		cfg.Metrics = o.cfg.Metrics
+	}
+
+	// Copy the providers such that the caller's configuration is not mutated.
+	providers := make(map[string]config.ProviderConfig, len(cfg.Providers))
+	maps.Copy(providers, cfg.Providers)
+
+	current, updated := marketMapProviderConfigs(o.cfg), marketMapProviderConfigs(cfg)
+	if !reflect.DeepEqual(current, updated) {
+		o.logger.Warn("market map provider cannot be updated without a restart; ignoring")
+
+		maps.DeleteFunc(providers, func(name string, _ config.ProviderConfig) bool {
+			_, ok := updated[name]
+			return ok
+		})
+		maps.Copy(providers, current)
+	}
+
+	cfg.Providers = providers
+	return cfg
+}
+
+// marketMapProviderConfigs returns the market map provider configurations in the given oracle configuration.
+func marketMapProviderConfigs(cfg config.OracleConfig) map[string]config.ProviderConfig {
+	providers := make(map[string]config.ProviderConfig)
+	for name, providerCfg := range cfg.Providers {
+		if providerCfg.Type == mmclienttypes.ConfigType {
+			providers[name] = providerCfg
+		}
+	}
+
+	return providers
+}
+
+// getUpdateInterval returns the interval at which the oracle updates its prices.
+func (o *OracleImpl) getUpdateInterval() time.Duration {
+	o.mut.RLock()
+	defer o.mut.RUnlock()
+
+	return o.cfg.UpdateInterval
+}
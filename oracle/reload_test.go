@@ -0,0 +1,124 @@
+package oracle_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
+	"github.com/skip-mev/slinky/providers/websockets/okx"
+)
+
+func TestUpdateConfig(t *testing.T) {
+	setup := func(t *testing.T) (*oracle.OracleImpl, context.CancelFunc) {
+		t.Helper()
+
+		orc, err := oracle.New(
+			oracleCfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMap(marketMap),
+		)
+		require.NoError(t, err)
+		o := orc.(*oracle.OracleImpl)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			err := o.Start(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Start() should have returned context.Canceled error")
+			}
+		}()
+
+		// Wait for the providers with tickers to be started.
+		require.Eventually(t, func() bool {
+			state, ok := o.GetProviderState()[coinbase.Name]
+			return ok && state.Provider.IsRunning()
+		}, 5*time.Second, 100*time.Millisecond)
+
+		return o, cancel
+	}
+
+	t.Run("errors when the config is invalid", func(t *testing.T) {
+		o, cancel := setup(t)
+		defer cancel()
+
+		cfg := copyConfig(oracleCfg)
+		cfg.UpdateInterval = 0
+		require.Error(t, o.UpdateConfig(cfg))
+
+		// Ensure that the providers are unchanged.
+		require.Len(t, o.GetProviderState(), len(oracleCfg.Providers))
+
+		o.Stop()
+	})
+
+	t.Run("only recreates the providers that were changed", func(t *testing.T) {
+		o, cancel := setup(t)
+		defer cancel()
+
+		before := make(map[string]oracle.ProviderState)
+		for name, state := range o.GetProviderState() {
+			before[name] = state
+		}
+
+		cfg := copyConfig(oracleCfg)
+		coinbaseCfg := cfg.Providers[coinbase.Name]
+		coinbaseCfg.API.Interval *= 2
+		cfg.Providers[coinbase.Name] = coinbaseCfg
+		require.NoError(t, o.UpdateConfig(cfg))
+
+		after := o.GetProviderState()
+		require.Len(t, after, len(oracleCfg.Providers))
+
+		// Unchanged providers are left untouched.
+		require.Same(t, before[binance.Name].Provider, after[binance.Name].Provider)
+		require.Same(t, before[okx.Name].Provider, after[okx.Name].Provider)
+		require.True(t, after[okx.Name].Provider.IsRunning())
+
+		// The reconfigured provider is replaced and restarted.
+		require.NotSame(t, before[coinbase.Name].Provider, after[coinbase.Name].Provider)
+		require.Equal(t, coinbaseCfg, after[coinbase.Name].Cfg)
+		require.False(t, before[coinbase.Name].Provider.IsRunning())
+		require.Eventually(t, func() bool {
+			return after[coinbase.Name].Provider.IsRunning()
+		}, 5*time.Second, 100*time.Millisecond)
+
+		o.Stop()
+	})
+
+	t.Run("adds and removes providers", func(t *testing.T) {
+		o, cancel := setup(t)
+		defer cancel()
+
+		removed := o.GetProviderState()[okx.Name].Provider
+
+		cfg := copyConfig(oracleCfg)
+		delete(cfg.Providers, okx.Name)
+		require.NoError(t, o.UpdateConfig(cfg))
+
+		state := o.GetProviderState()
+		require.Len(t, state, len(oracleCfg.Providers)-1)
+		require.NotContains(t, state, okx.Name)
+		require.False(t, removed.IsRunning())
+
+		// Add the provider back.
+		require.NoError(t, o.UpdateConfig(oracleCfg))
+
+		state = o.GetProviderState()
+		require.Len(t, state, len(oracleCfg.Providers))
+		added := state[okx.Name].Provider
+		require.NotSame(t, removed, added)
+		require.Eventually(t, added.IsRunning, 5*time.Second, 100*time.Millisecond)
+
+		o.Stop()
+	})
+}
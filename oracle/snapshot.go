@@ -0,0 +1,130 @@
+package oracle
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// priceSnapshotKey is the key under which the latest price snapshot is stored.
+var priceSnapshotKey = []byte("price_snapshot")
+
+// priceSnapshot is a copy of the oracle's price state that is persisted after every price update,
+// such that the oracle can serve prices immediately after a restart, i.e. before all providers have
+// reconnected.
+type priceSnapshot struct {
+	// ProviderPrices are the prices used in the price update, indexed by provider -> off-chain ticker.
+	ProviderPrices map[string]map[string]types.PriceResult `json:"provider_prices"`
+	// IndexPrices are the (unscaled) index prices calculated in the price update.
+	IndexPrices types.Prices `json:"index_prices"`
+	// Timestamp is the time at which the price update completed.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// restorePriceSnapshot restores the provider prices and index prices of the latest price snapshot, if
+// any. Restored provider prices are used until the provider reports a fresh price, and both provider
+// prices and index prices are only restored if they are within the max price age.
+func (o *OracleImpl) restorePriceSnapshot() {
+	if o.snapshotDB == nil {
+		return
+	}
+
+	bz, err := o.snapshotDB.Get(priceSnapshotKey)
+	if err != nil {
+		o.logger.Error("failed to read price snapshot", zap.Error(err))
+		return
+	}
+	if bz == nil {
+		o.logger.Info("no price snapshot to restore")
+		return
+	}
+
+	var snapshot priceSnapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		o.logger.Error("failed to unmarshal price snapshot", zap.Error(err))
+		return
+	}
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	now := time.Now().UTC()
+	numPrices := 0
+	for provider, results := range snapshot.ProviderPrices {
+		for ticker, result := range results {
+			if result.Value == nil || now.Sub(result.Timestamp) > o.cfg.MaxPriceAge {
+				continue
+			}
+
+			if _, ok := o.cachedPrices[provider]; !ok {
+				o.cachedPrices[provider] = make(map[string]types.PriceResult)
+			}
+
+			o.cachedPrices[provider][ticker] = result
+			numPrices++
+		}
+	}
+
+	if now.Sub(snapshot.Timestamp) <= o.cfg.MaxPriceAge {
+		o.aggregator.SetIndexPrices(snapshot.IndexPrices)
+	}
+
+	o.logger.Info(
+		"restored price snapshot",
+		zap.Time("snapshot_time", snapshot.Timestamp),
+		zap.Int("num_provider_prices", numPrices),
+		zap.Int("num_index_prices", len(snapshot.IndexPrices)),
+	)
+}
+
+// persistPriceSnapshot persists the provider prices and index prices of the latest price update.
+func (o *OracleImpl) persistPriceSnapshot() {
+	if o.snapshotDB == nil {
+		return
+	}
+
+	o.mut.RLock()
+	snapshot := priceSnapshot{
+		ProviderPrices: o.snapshotPrices,
+		IndexPrices:    o.aggregator.GetIndexPrices(),
+		Timestamp:      o.lastPriceSync,
+	}
+	bz, err := json.Marshal(snapshot)
+	o.mut.RUnlock()
+
+	if err != nil {
+		o.logger.Error("failed to marshal price snapshot", zap.Error(err))
+		return
+	}
+
+	if err := o.snapshotDB.Set(priceSnapshotKey, bz); err != nil {
+		o.logger.Error("failed to persist price snapshot", zap.Error(err))
+	}
+}
+
+// mergeCachedPrices adds the restored prices of the given provider to the given results, for all
+// tickers that the provider has not yet reported a fresh price for. Restored prices that have been
+// superseded or that exceed the max price age are dropped. This assumes the caller holds the lock.
+func (o *OracleImpl) mergeCachedPrices(provider string, results map[string]types.PriceResult) {
+	cached, ok := o.cachedPrices[provider]
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC()
+	for ticker, result := range cached {
+		if _, ok := results[ticker]; ok || now.Sub(result.Timestamp) > o.cfg.MaxPriceAge {
+			delete(cached, ticker)
+			continue
+		}
+
+		results[ticker] = result
+	}
+
+	if len(cached) == 0 {
+		delete(o.cachedPrices, provider)
+	}
+}
@@ -0,0 +1,130 @@
+package oracle_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	mathtestutils "github.com/skip-mev/slinky/pkg/math/testutils"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+func (s *OracleTestSuite) TestPriceSnapshot() {
+	cfg := config.OracleConfig{
+		UpdateInterval: 500 * time.Millisecond,
+		MaxPriceAge:    1 * time.Minute,
+		Metrics:        oracleCfg.Metrics,
+		Host:           oracleCfg.Host,
+		Port:           oracleCfg.Port,
+	}
+
+	// runOracle runs an oracle with a single provider that returns the given responses until
+	// the oracle has completed a few price updates, and returns the oracle's prices.
+	runOracle := func(
+		cfg config.OracleConfig,
+		db dbm.DB,
+		responses []providertypes.GetResponse[types.ProviderTicker, *big.Float],
+	) types.Prices {
+		provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+			s.T(),
+			s.logger,
+			providerCfg1,
+			s.currencyPairs,
+			responses,
+			200*time.Millisecond,
+		)
+
+		testOracle, err := oracle.New(
+			cfg,
+			mathtestutils.NewMedianAggregator(),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceProviders(provider),
+			oracle.WithMarketMap(s.marketmap),
+			oracle.WithPriceSnapshotDB(db),
+		)
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updates, unsubscribe := testOracle.Subscribe()
+		defer unsubscribe()
+
+		go func() {
+			err := testOracle.Start(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				s.T().Errorf("Start() should have returned context.Canceled error. Got: %v", err)
+			}
+		}()
+
+		// Wait for a few price updates.
+		for i := 0; i < 3; i++ {
+			select {
+			case <-updates:
+			case <-time.After(5 * cfg.UpdateInterval):
+				s.T().Fatal("expected a price update")
+			}
+		}
+
+		prices := testOracle.GetPrices()
+		testOracle.Stop()
+
+		return prices
+	}
+
+	s.Run("restores persisted prices after a restart", func() {
+		db := dbm.NewMemDB()
+
+		resolved := types.ResolvedPrices{
+			s.currencyPairs[0]: {
+				Value:     big.NewFloat(100),
+				Timestamp: time.Now().UTC(),
+			},
+		}
+		responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{
+			providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil),
+		}
+		expectedPrices := types.Prices{
+			s.currencyPairs[0].String(): big.NewFloat(100),
+		}
+
+		s.Require().Equal(expectedPrices, runOracle(cfg, db, responses))
+
+		// The provider no longer returns any prices, so the persisted price is used.
+		prices := runOracle(cfg, db, nil)
+		s.Require().Len(prices, len(expectedPrices))
+		for ticker, price := range expectedPrices {
+			s.Require().Zero(price.Cmp(prices[ticker]))
+		}
+	})
+
+	s.Run("does not restore prices that exceed the max price age", func() {
+		db := dbm.NewMemDB()
+
+		resolved := types.ResolvedPrices{
+			s.currencyPairs[0]: {
+				Value:     big.NewFloat(100),
+				Timestamp: time.Now().UTC().Add(-cfg.MaxPriceAge / 2),
+			},
+		}
+		responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{
+			providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil),
+		}
+
+		s.Require().Equal(types.Prices{
+			s.currencyPairs[0].String(): big.NewFloat(100),
+		}, runOracle(cfg, db, responses))
+
+		// Restart the oracle with a lower max price age.
+		restartCfg := cfg
+		restartCfg.MaxPriceAge = cfg.MaxPriceAge / 4
+		s.Require().Equal(types.Prices{}, runOracle(restartCfg, db, nil))
+	})
+}
@@ -0,0 +1,35 @@
+package oracle
+
+// Subscribe returns a channel that receives a notification every time the oracle completes a
+// price update, along with a function that must be called to unsubscribe. The channel is
+// buffered such that a subscriber that falls behind only receives a single (coalesced)
+// notification, i.e. the oracle never blocks on a slow subscriber. Subscribers are expected
+// to retrieve the latest prices via GetPrices upon notification.
+func (o *OracleImpl) Subscribe() (<-chan struct{}, func()) {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	ch := make(chan struct{}, 1)
+	o.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		o.mut.Lock()
+		defer o.mut.Unlock()
+
+		delete(o.subscribers, ch)
+	}
+}
+
+// notifySubscribers notifies all subscribers that the oracle has completed a price update.
+func (o *OracleImpl) notifySubscribers() {
+	o.mut.RLock()
+	defer o.mut.RUnlock()
+
+	for ch := range o.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// The subscriber has not yet consumed the previous notification.
+		}
+	}
+}
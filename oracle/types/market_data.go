@@ -0,0 +1,52 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+// MarketData is the optional market data that is reported alongside a price. Each field is
+// nil if it is not available.
+type MarketData struct {
+	// Volume is the trading volume, i.e. the rolling 24 hour volume in units of the base asset.
+	Volume *big.Float
+	// BestBid is the best bid.
+	BestBid *big.Float
+	// BestAsk is the best ask.
+	BestAsk *big.Float
+}
+
+// TickerMarketData is a map of ticker to the market data reported for that ticker.
+type TickerMarketData = map[string]MarketData
+
+// IsEmpty returns true if no market data is available.
+func (md MarketData) IsEmpty() bool {
+	return md.Volume == nil && md.BestBid == nil && md.BestAsk == nil
+}
+
+// MarketDataFromResult returns the market data carried by the given price result.
+func MarketDataFromResult(result PriceResult) MarketData {
+	return MarketData{
+		Volume:  result.Volume,
+		BestBid: result.BestBid,
+		BestAsk: result.BestAsk,
+	}
+}
+
+// WithParsedMarketData attaches the given volume and best bid / ask (formatted as decimal strings)
+// to the price result. Since market data is optional, any value that is empty or cannot be parsed
+// is ignored. The best bid and best ask are only attached if both can be parsed.
+func WithParsedMarketData(result PriceResult, volume, bestBid, bestAsk string) PriceResult {
+	if parsed, err := math.Float64StringToBigFloat(volume); err == nil {
+		result = result.WithVolume(parsed)
+	}
+
+	bid, bidErr := math.Float64StringToBigFloat(bestBid)
+	ask, askErr := math.Float64StringToBigFloat(bestAsk)
+	if bidErr == nil && askErr == nil {
+		result = result.WithBidAsk(bid, ask)
+	}
+
+	return result
+}
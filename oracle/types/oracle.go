@@ -72,6 +72,9 @@ type (
 	// unresolved prices are the prices that were not successfully fetched from the API.
 	PriceResponse = providertypes.GetResponse[ProviderTicker, *big.Float]
 
+	// PriceResult is a type alias for a single resolved price.
+	PriceResult = providertypes.ResolvedResult[*big.Float]
+
 	// ResolvedPrices is a type alias for the resolved prices.
 	ResolvedPrices = map[ProviderTicker]providertypes.ResolvedResult[*big.Float]
 
@@ -80,6 +83,10 @@ type (
 
 	// Prices is a type alias for a map of ticker to a price.
 	Prices = map[string]*big.Float
+
+	// ProviderCircuitBreakers is a type alias for a map of provider name to the status of the
+	// provider's circuit breaker.
+	ProviderCircuitBreakers = map[string]providertypes.CircuitBreakerStatus
 )
 
 var (
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"math/big"
+	"time"
+)
+
+// ProviderPriceStatus is the status of a provider's price in the most recent index price
+// calculation of a market.
+type ProviderPriceStatus string
+
+const (
+	// ProviderPriceUsed indicates that the price was used to calculate the index price.
+	ProviderPriceUsed ProviderPriceStatus = "used"
+	// ProviderPriceMissing indicates that the provider did not report a price.
+	ProviderPriceMissing ProviderPriceStatus = "missing"
+	// ProviderPriceStale indicates that the provider reported a price that was older than the
+	// maximum price age.
+	ProviderPriceStale ProviderPriceStatus = "stale"
+	// ProviderPriceMissingIndexPrice indicates that the price could not be converted because the
+	// index price of the pair it is normalized by is not available.
+	ProviderPriceMissingIndexPrice ProviderPriceStatus = "missing_index_price"
+	// ProviderPriceConversionFailed indicates that the price could not be converted for any
+	// other reason, e.g. a zero price that cannot be inverted.
+	ProviderPriceConversionFailed ProviderPriceStatus = "conversion_failed"
+	// ProviderPriceOutlier indicates that the converted price was rejected as an outlier.
+	ProviderPriceOutlier ProviderPriceStatus = "outlier"
+	// ProviderPriceInsufficientProviders indicates that the converted price was valid, but the
+	// market did not have enough valid prices to calculate an index price.
+	ProviderPriceInsufficientProviders ProviderPriceStatus = "insufficient_providers"
+	// ProviderPriceAggregationFailed indicates that the converted price was valid, but the
+	// market's aggregation method failed to calculate an index price.
+	ProviderPriceAggregationFailed ProviderPriceStatus = "aggregation_failed"
+)
+
+// ProviderPriceDetails is the contribution of a single provider to the index price of a market.
+type ProviderPriceDetails struct {
+	// Provider is the name of the provider.
+	Provider string
+	// OffChainTicker is the off-chain ticker of the provider's market.
+	OffChainTicker string
+	// RawPrice is the price as reported by the provider. This is nil if the price is missing or
+	// stale.
+	RawPrice *big.Float
+	// ConvertedPrice is the price converted to the market's ticker, i.e. inverted and / or
+	// normalized by an index price. This is nil if the price could not be converted.
+	ConvertedPrice *big.Float
+	// Timestamp is the time at which the provider last reported the price. This is the zero time
+	// if the price is missing.
+	Timestamp time.Time
+	// Status is the status of the price in the index price calculation.
+	Status ProviderPriceStatus
+}
+
+// MarketPriceDetails is the breakdown of the most recent index price calculation of a market.
+type MarketPriceDetails struct {
+	// Providers is the contribution of each of the market's provider configs.
+	Providers []ProviderPriceDetails
+	// MedianPrice is the (unscaled) median of the converted prices that were used. This is nil if
+	// no index price was calculated.
+	MedianPrice *big.Float
+	// AggregatedPrice is the (unscaled) index price calculated using the market's aggregation
+	// method. This is nil if no index price was calculated.
+	AggregatedPrice *big.Float
+}
+
+// TickerPriceDetails is a map of ticker to the price details of that ticker's market.
+type TickerPriceDetails = map[string]MarketPriceDetails
+
+// ProviderTimestamps is a map of off-chain ticker to the time at which the provider last reported
+// a price for that ticker.
+type ProviderTimestamps = map[string]time.Time
@@ -24,6 +24,15 @@ func (o *OracleImpl) UpdateMarketMap(marketMap mmtypes.MarketMap) error {
 		return err
 	}
 
+	// Update the aggregator first, such that a market map with an invalid aggregation config is
+	// rejected before any provider is updated.
+	if o.aggregator != nil {
+		if err := o.aggregator.UpdateMarketMap(marketMap); err != nil {
+			o.logger.Error("failed to update aggregator market map", zap.Error(err))
+			return err
+		}
+	}
+
 	// Iterate over all existing price providers and update their market maps.
 	for name, state := range o.priceProviders {
 		providerTickers, err := types.ProviderTickersFromMarketMap(name, marketMap)
@@ -43,9 +52,6 @@ func (o *OracleImpl) UpdateMarketMap(marketMap mmtypes.MarketMap) error {
 	}
 
 	o.marketMap = marketMap
-	if o.aggregator != nil {
-		o.aggregator.UpdateMarketMap(o.marketMap)
-	}
 
 	return nil
 }
@@ -86,6 +92,7 @@ func (o *OracleImpl) fetchAllPrices() {
 
 	// Retrieve the latest prices from each provider.
 	o.mut.Lock()
+	o.snapshotPrices = make(map[string]map[string]types.PriceResult)
 	for _, provider := range o.priceProviders {
 		o.fetchPrices(provider.Provider)
 	}
@@ -97,10 +104,19 @@ func (o *OracleImpl) fetchAllPrices() {
 	o.aggregator.AggregatePrices()
 	o.setLastSyncTime(time.Now().UTC())
 
+	// Report the prices that were rejected as outliers to the providers' circuit breakers.
+	o.recordOutliers()
+
 	// update the last sync time
 	o.metrics.AddTick()
 
 	o.logger.Info("oracle updated prices", zap.Time("last_sync", o.lastPriceSync), zap.Int("num_prices", len(o.aggregator.GetPrices())))
+
+	// Persist the prices such that they can be restored after a restart.
+	o.persistPriceSnapshot()
+
+	// Notify all subscribers that the prices have been updated.
+	o.notifySubscribers()
 }
 
 func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
@@ -123,6 +139,18 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		return
 	}
 
+	// If the provider's circuit breaker is open, its prices are not used until the cool-down
+	// has elapsed.
+	if provider.IsQuarantined() {
+		o.logger.Debug(
+			"provider is quarantined",
+			zap.String("provider", provider.Name()),
+			zap.Time("open_until", provider.CircuitBreakerStatus().OpenUntil),
+		)
+
+		return
+	}
+
 	o.logger.Debug(
 		"retrieving prices",
 		zap.String("provider", provider.Name()),
@@ -142,8 +170,22 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		return
 	}
 
-	timeFilteredPrices := make(types.Prices)
+	// Key the prices by off-chain ticker, and fill in the prices restored from the latest price
+	// snapshot for any tickers the provider has not reported since the oracle was started.
+	results := make(map[string]types.PriceResult, len(prices))
 	for pair, result := range prices {
+		results[pair.GetOffChainTicker()] = result
+	}
+	o.mergeCachedPrices(provider.Name(), results)
+
+	timeFilteredPrices := make(types.Prices)
+	timeFilteredMarketData := make(types.TickerMarketData)
+	timeFilteredResults := make(map[string]types.PriceResult)
+	timestamps := make(types.ProviderTimestamps)
+	for ticker, result := range results {
+		// Record the timestamp of every price, including prices that are too old to be used.
+		timestamps[ticker] = result.Timestamp
+
 		// If the price is older than the maxCacheAge, skip it.
 		diff := time.Now().UTC().Sub(result.Timestamp)
 		if diff > o.cfg.MaxPriceAge {
@@ -151,7 +193,7 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 				"skipping price",
 				zap.String("provider", provider.Name()),
 				zap.String("data handler type", string(provider.Type())),
-				zap.String("pair", pair.String()),
+				zap.String("pair", ticker),
 				zap.Duration("diff", diff),
 			)
 
@@ -162,11 +204,17 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 			"adding price",
 			zap.String("provider", provider.Name()),
 			zap.String("data handler type", string(provider.Type())),
-			zap.String("pair", pair.String()),
+			zap.String("pair", ticker),
 			zap.String("price", result.Value.String()),
 			zap.Duration("diff", diff),
 		)
-		timeFilteredPrices[pair.GetOffChainTicker()] = result.Value
+		timeFilteredPrices[ticker] = result.Value
+		timeFilteredResults[ticker] = result
+
+		// Market data (volume and best bid / ask) is optional and only reported by some providers.
+		if data := types.MarketDataFromResult(result); !data.IsEmpty() {
+			timeFilteredMarketData[ticker] = data
+		}
 	}
 
 	o.logger.Debug("provider returned prices",
@@ -175,6 +223,39 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.Int("prices", len(prices)),
 	)
 	o.aggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
+	o.aggregator.SetProviderMarketData(provider.Name(), timeFilteredMarketData)
+	o.aggregator.SetProviderTimestamps(provider.Name(), timestamps)
+	o.snapshotPrices[provider.Name()] = timeFilteredResults
+}
+
+// recordOutliers reports the number of prices of each provider that were rejected as outliers in
+// the latest index price calculation to the provider's circuit breaker. Providers whose prices
+// were not considered, e.g. because they are missing or stale, are skipped.
+func (o *OracleImpl) recordOutliers() {
+	outliers := make(map[string]int)
+	for _, market := range o.aggregator.GetPriceDetails() {
+		for _, details := range market.Providers {
+			switch details.Status {
+			case types.ProviderPriceOutlier:
+				outliers[details.Provider]++
+			case types.ProviderPriceUsed,
+				types.ProviderPriceInsufficientProviders,
+				types.ProviderPriceAggregationFailed:
+				if _, ok := outliers[details.Provider]; !ok {
+					outliers[details.Provider] = 0
+				}
+			}
+		}
+	}
+
+	o.mut.RLock()
+	defer o.mut.RUnlock()
+
+	for name, rejected := range outliers {
+		if state, ok := o.priceProviders[name]; ok {
+			state.Provider.RecordOutliers(rejected)
+		}
+	}
 }
 
 func (o *OracleImpl) setLastSyncTime(t time.Time) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/skip-mev/slinky/oracle"
 	"github.com/skip-mev/slinky/oracle/types"
+	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
 	"github.com/skip-mev/slinky/providers/apis/binance"
 	"github.com/skip-mev/slinky/providers/apis/coinbase"
 	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
@@ -40,6 +41,34 @@ func TestUpdateWithMarketMap(t *testing.T) {
 		o.Stop()
 	})
 
+	t.Run("market map with an invalid aggregation config is rejected", func(t *testing.T) {
+		aggregator, err := oraclemath.NewIndexPriceAggregator(logger, mmtypes.MarketMap{}, nil)
+		require.NoError(t, err)
+
+		orc, err := oracle.New(
+			oracleCfg,
+			aggregator,
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		require.NoError(t, err)
+		o := orc.(*oracle.OracleImpl)
+		require.NoError(t, o.Init(context.Background()))
+
+		invalid := mmtypes.MarketMap{Markets: make(map[string]mmtypes.Market)}
+		for ticker, market := range marketMap.Markets {
+			market.Ticker.Metadata_JSON = `{"aggregation":{"method":"mode"}}`
+			invalid.Markets[ticker] = market
+		}
+
+		require.Error(t, o.UpdateMarketMap(invalid))
+		require.Empty(t, o.GetMarketMap().Markets)
+		require.Empty(t, aggregator.GetMarketMap().Markets)
+
+		o.Stop()
+	})
+
 	t.Run("can update the oracle's market map and update the providers' market maps with no running providers", func(t *testing.T) {
 		orc, err := oracle.New(
 			oracleCfg,
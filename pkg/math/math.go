@@ -138,6 +138,159 @@ func CalculateMedian(values []*big.Float) *big.Float {
 	return median
 }
 
+// CalculateWeightedMedian calculates the weighted median from a list of big.Float values and their
+// corresponding (non-negative) weights. The weighted median is the smallest value at which the cumulative
+// weight reaches half of the total weight. If the cumulative weight is exactly half of the total weight,
+// the average of that value and the next value (with a non-zero weight) is returned, which is consistent
+// with CalculateMedian when all weights are equal. Returns nil if the inputs are empty, of mismatched
+// length, or if the total weight is not positive.
+func CalculateWeightedMedian(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	// Sort the values alongside their weights.
+	indices := make([]int, len(values))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return values[indices[i]].Cmp(values[indices[j]]) < 0
+	})
+
+	total := new(big.Float)
+	for _, weight := range weights {
+		if weight == nil || weight.Sign() < 0 {
+			return nil
+		}
+		total.Add(total, weight)
+	}
+
+	if total.Sign() <= 0 {
+		return nil
+	}
+
+	half := new(big.Float).Quo(total, new(big.Float).SetUint64(2))
+	cumulative := new(big.Float)
+	for i, idx := range indices {
+		if weights[idx].Sign() == 0 {
+			continue
+		}
+
+		cumulative.Add(cumulative, weights[idx])
+		switch cumulative.Cmp(half) {
+		case -1:
+			continue
+		case 0:
+			// Average with the next value that carries weight.
+			for _, next := range indices[i+1:] {
+				if weights[next].Sign() == 0 {
+					continue
+				}
+
+				median := new(big.Float).Add(values[idx], values[next])
+				return median.Quo(median, new(big.Float).SetUint64(2))
+			}
+		}
+
+		return new(big.Float).Copy(values[idx])
+	}
+
+	return nil
+}
+
+// CalculateTrimmedMean calculates the mean of a list of big.Float values after discarding the
+// given fraction of values from each end of the sorted list. The number of values discarded from
+// each end is rounded down. If the fraction is not in [0, 0.5) or the inputs are empty, nil is
+// returned. Note that this sorts the values in place.
+func CalculateTrimmedMean(values []*big.Float, fraction float64) *big.Float {
+	if len(values) == 0 || fraction < 0 || fraction >= 0.5 {
+		return nil
+	}
+	SortBigFloats(values)
+
+	trim := int(float64(len(values)) * fraction)
+	trimmed := values[trim : len(values)-trim]
+
+	sum := new(big.Float)
+	for _, value := range trimmed {
+		sum.Add(sum, value)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(trimmed))))
+}
+
+// CalculateWeightedMean calculates the mean of a list of big.Float values weighted by the
+// corresponding (non-negative) weights i.e. sum(value * weight) / sum(weight). Returns nil if the
+// inputs are empty, of mismatched length, or if the total weight is not positive.
+func CalculateWeightedMean(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	sum := new(big.Float)
+	total := new(big.Float)
+	for i, value := range values {
+		if weights[i] == nil || weights[i].Sign() < 0 {
+			return nil
+		}
+
+		sum.Add(sum, new(big.Float).Mul(value, weights[i]))
+		total.Add(total, weights[i])
+	}
+
+	if total.Sign() <= 0 {
+		return nil
+	}
+
+	return sum.Quo(sum, total)
+}
+
+// CalculateMedianAbsoluteDeviation calculates the median absolute deviation (MAD) of a list of
+// big.Float values i.e. the median of the absolute deviations of each value from the median of
+// the values. The input values are not modified. Returns nil if the input is empty.
+func CalculateMedianAbsoluteDeviation(values []*big.Float) *big.Float {
+	if len(values) == 0 {
+		return nil
+	}
+
+	median := CalculateMedian(append([]*big.Float(nil), values...))
+
+	deviations := make([]*big.Float, len(values))
+	for i, value := range values {
+		deviations[i] = new(big.Float).Sub(value, median)
+		deviations[i].Abs(deviations[i])
+	}
+
+	return CalculateMedian(deviations)
+}
+
+// CalculateStandardDeviation calculates the (population) standard deviation of a list of big.Float
+// values i.e. sqrt(sum((value - mean)^2) / n). The input values are not modified. Returns nil if the
+// input is empty.
+func CalculateStandardDeviation(values []*big.Float) *big.Float {
+	if len(values) == 0 {
+		return nil
+	}
+
+	n := new(big.Float).SetInt64(int64(len(values)))
+
+	mean := new(big.Float)
+	for _, value := range values {
+		mean.Add(mean, value)
+	}
+	mean.Quo(mean, n)
+
+	variance := new(big.Float)
+	for _, value := range values {
+		deviation := new(big.Float).Sub(value, mean)
+		variance.Add(variance, deviation.Mul(deviation, deviation))
+	}
+	variance.Quo(variance, n)
+
+	return variance.Sqrt(variance)
+}
+
 // GetScalingFactor returns the scaling factor for the price based on the difference between
 // the token decimals in the erc20 token contracts or similar.
 func GetScalingFactor(
@@ -412,6 +412,325 @@ func TestCalculateMedian(t *testing.T) {
 	}
 }
 
+func TestCalculateWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			weights:  nil,
+			expected: nil,
+		},
+		{
+			name: "mismatched lengths",
+			values: []*big.Float{
+				big.NewFloat(1),
+			},
+			weights:  nil,
+			expected: nil,
+		},
+		{
+			name: "negative weight",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(-1),
+			},
+			expected: nil,
+		},
+		{
+			name: "zero total weight",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+			},
+			weights: []*big.Float{
+				big.NewFloat(0),
+				big.NewFloat(0),
+			},
+			expected: nil,
+		},
+		{
+			name: "equal weights with an odd number of values is the median",
+			values: []*big.Float{
+				big.NewFloat(10),
+				big.NewFloat(-2),
+				big.NewFloat(100),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(1),
+			},
+			expected: big.NewFloat(10),
+		},
+		{
+			name: "equal weights with an even number of values is the median",
+			values: []*big.Float{
+				big.NewFloat(-2),
+				big.NewFloat(0),
+				big.NewFloat(10),
+				big.NewFloat(100),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(1),
+			},
+			expected: big.NewFloat(5),
+		},
+		{
+			name: "heavy weight dominates",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(100),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(5),
+			},
+			expected: big.NewFloat(100),
+		},
+		{
+			name: "zero weights are ignored",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(3),
+				big.NewFloat(100),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(0),
+				big.NewFloat(1),
+				big.NewFloat(0),
+			},
+			expected: big.NewFloat(2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, math.CalculateWeightedMedian(tc.values, tc.weights))
+		})
+	}
+}
+
+func TestCalculateTrimmedMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		fraction float64
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			fraction: 0.1,
+			expected: nil,
+		},
+		{
+			name: "invalid fraction",
+			values: []*big.Float{
+				big.NewFloat(1),
+			},
+			fraction: 0.5,
+			expected: nil,
+		},
+		{
+			name: "no trimming is the mean",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(6),
+			},
+			fraction: 0,
+			expected: big.NewFloat(3),
+		},
+		{
+			name: "fraction too small to trim any values",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(6),
+			},
+			fraction: 0.2,
+			expected: big.NewFloat(3),
+		},
+		{
+			name: "trims outliers from both ends",
+			values: []*big.Float{
+				big.NewFloat(1000),
+				big.NewFloat(10),
+				big.NewFloat(11),
+				big.NewFloat(-1000),
+				big.NewFloat(12),
+			},
+			fraction: 0.2,
+			expected: big.NewFloat(11),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, math.CalculateTrimmedMean(tc.values, tc.fraction))
+		})
+	}
+}
+
+func TestCalculateWeightedMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			weights:  nil,
+			expected: nil,
+		},
+		{
+			name: "zero total weight",
+			values: []*big.Float{
+				big.NewFloat(1),
+			},
+			weights: []*big.Float{
+				big.NewFloat(0),
+			},
+			expected: nil,
+		},
+		{
+			name: "weighted mean",
+			values: []*big.Float{
+				big.NewFloat(10),
+				big.NewFloat(20),
+			},
+			weights: []*big.Float{
+				big.NewFloat(3),
+				big.NewFloat(1),
+			},
+			expected: big.NewFloat(12.5),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, math.CalculateWeightedMean(tc.values, tc.weights))
+		})
+	}
+}
+
+func TestCalculateMedianAbsoluteDeviation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name: "identical values have no deviation",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(1),
+			},
+			expected: big.NewFloat(0),
+		},
+		{
+			name: "calculate mad with an outlier",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(3),
+				big.NewFloat(4),
+				big.NewFloat(100),
+			},
+			expected: big.NewFloat(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := append([]*big.Float(nil), tc.values...)
+			mad := math.CalculateMedianAbsoluteDeviation(tc.values)
+			require.Equal(t, original, tc.values)
+			if tc.expected == nil {
+				require.Nil(t, mad)
+				return
+			}
+
+			require.Zero(t, tc.expected.Cmp(mad))
+		})
+	}
+}
+
+func TestCalculateStandardDeviation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name: "identical values have no deviation",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(1),
+			},
+			expected: big.NewFloat(0),
+		},
+		{
+			name: "calculate the population standard deviation",
+			values: []*big.Float{
+				big.NewFloat(2),
+				big.NewFloat(4),
+				big.NewFloat(4),
+				big.NewFloat(4),
+				big.NewFloat(5),
+				big.NewFloat(5),
+				big.NewFloat(7),
+				big.NewFloat(9),
+			},
+			expected: big.NewFloat(2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := append([]*big.Float(nil), tc.values...)
+			stddev := math.CalculateStandardDeviation(tc.values)
+			require.Equal(t, original, tc.values)
+			if tc.expected == nil {
+				require.Nil(t, stddev)
+				return
+			}
+
+			require.Zero(t, tc.expected.Cmp(stddev))
+		})
+	}
+}
+
 func TestSortBigInts(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -0,0 +1,246 @@
+package oracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/pkg/math"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// AggregationMethod is the method used to aggregate the converted provider prices of a market
+// into a single index price.
+type AggregationMethod string
+
+const (
+	// MedianAggregation takes the median of the converted prices. This is the default method.
+	MedianAggregation AggregationMethod = "median"
+	// WeightedMedianAggregation takes the median of the converted prices, where each price is
+	// weighted by the configured weight of the provider that reported it.
+	WeightedMedianAggregation AggregationMethod = "weighted_median"
+	// TrimmedMeanAggregation discards a configured fraction of the highest and lowest converted
+	// prices and takes the mean of the remaining prices.
+	TrimmedMeanAggregation AggregationMethod = "trimmed_mean"
+	// VWAPAggregation takes the mean of the converted prices weighted by the volume reported by
+	// each provider. Prices without a reported volume are ignored. If no provider reports volume,
+	// the median of the converted prices is used instead.
+	VWAPAggregation AggregationMethod = "vwap"
+)
+
+// DefaultProviderWeight is the weight assigned to a provider that is not explicitly weighted in
+// a market's aggregation configuration.
+const DefaultProviderWeight = 1.0
+
+// TickerMetadata is the subset of a ticker's Metadata_JSON that is consumed by the aggregator.
+//
+// ex.
+//
+//	{
+//		"aggregation": {
+//			"method": "weighted_median",
+//			"provider_weights": {
+//				"binance_ws": 3,
+//				"uniswapv3_api-ethereum": 0.5
+//			}
+//		},
+//		"outlier_filter": {
+//			"method": "mad",
+//			"threshold": 3
+//		}
+//	}
+type TickerMetadata struct {
+	// Aggregation is the aggregation configuration for the market.
+	Aggregation AggregationConfig `json:"aggregation"`
+	// OutlierFilter is the outlier filter configuration for the market.
+	OutlierFilter OutlierFilterConfig `json:"outlier_filter"`
+}
+
+// ValidateBasic performs basic validation on the ticker metadata.
+func (tm TickerMetadata) ValidateBasic() error {
+	if err := tm.Aggregation.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tm.OutlierFilter.ValidateBasic()
+}
+
+// ParseTickerMetadata parses the aggregator's configuration from a ticker's Metadata_JSON. An
+// empty metadata string resolves to the default configuration i.e. the median with no outlier
+// filtering.
+func ParseTickerMetadata(metadata string) (TickerMetadata, error) {
+	if len(metadata) == 0 {
+		return TickerMetadata{
+			Aggregation: AggregationConfig{Method: MedianAggregation},
+		}, nil
+	}
+
+	var tickerMetadata TickerMetadata
+	if err := json.Unmarshal([]byte(metadata), &tickerMetadata); err != nil {
+		return TickerMetadata{}, fmt.Errorf("failed to unmarshal ticker metadata: %w", err)
+	}
+
+	if err := tickerMetadata.ValidateBasic(); err != nil {
+		return TickerMetadata{}, err
+	}
+
+	tickerMetadata.Aggregation.Method = tickerMetadata.Aggregation.GetMethod()
+	return tickerMetadata, nil
+}
+
+// parseMarketMapMetadata parses and validates the aggregator's configuration of every market in
+// the given market map, indexed by ticker. An error is returned if any market's configuration is
+// invalid.
+func parseMarketMapMetadata(marketMap mmtypes.MarketMap) (map[string]TickerMetadata, error) {
+	metadata := make(map[string]TickerMetadata, len(marketMap.Markets))
+	for ticker, market := range marketMap.Markets {
+		tickerMetadata, err := ParseTickerMetadata(market.Ticker.Metadata_JSON)
+		if err != nil {
+			return nil, fmt.Errorf("invalid aggregation config for market %s: %w", ticker, err)
+		}
+
+		metadata[ticker] = tickerMetadata
+	}
+
+	return metadata, nil
+}
+
+// AggregationConfig configures how the converted prices of a market are aggregated into an
+// index price.
+type AggregationConfig struct {
+	// Method is the aggregation method used for the market. If empty, the median is used.
+	Method AggregationMethod `json:"method,omitempty"`
+	// ProviderWeights is a map of provider name to the weight of that provider's prices. This is
+	// only used by the weighted median method. Providers that are not present in the map are
+	// assigned the DefaultProviderWeight.
+	ProviderWeights map[string]float64 `json:"provider_weights,omitempty"`
+	// TrimFraction is the fraction of prices discarded from each end of the sorted prices before
+	// taking the mean. This is only used by the trimmed mean method and must be in [0, 0.5).
+	TrimFraction float64 `json:"trim_fraction,omitempty"`
+}
+
+// ValidateBasic performs basic validation on the aggregation configuration.
+func (c AggregationConfig) ValidateBasic() error {
+	switch c.Method {
+	case "", MedianAggregation, WeightedMedianAggregation, TrimmedMeanAggregation, VWAPAggregation:
+	default:
+		return fmt.Errorf("unknown aggregation method: %s", c.Method)
+	}
+
+	for provider, weight := range c.ProviderWeights {
+		if weight < 0 {
+			return fmt.Errorf("weight for provider %s cannot be negative; got %f", provider, weight)
+		}
+	}
+
+	if c.TrimFraction < 0 || c.TrimFraction >= 0.5 {
+		return fmt.Errorf("trim fraction must be in [0, 0.5); got %f", c.TrimFraction)
+	}
+
+	return nil
+}
+
+// GetMethod returns the configured aggregation method, defaulting to the median.
+func (c AggregationConfig) GetMethod() AggregationMethod {
+	if c.Method == "" {
+		return MedianAggregation
+	}
+
+	return c.Method
+}
+
+// GetProviderWeight returns the weight of the given provider.
+func (c AggregationConfig) GetProviderWeight(provider string) float64 {
+	if weight, ok := c.ProviderWeights[provider]; ok {
+		return weight
+	}
+
+	return DefaultProviderWeight
+}
+
+// ParseAggregationConfig parses the aggregation configuration from a ticker's Metadata_JSON. An
+// empty metadata string (or one without an aggregation configuration) resolves to the median.
+func ParseAggregationConfig(metadata string) (AggregationConfig, error) {
+	tickerMetadata, err := ParseTickerMetadata(metadata)
+	if err != nil {
+		return AggregationConfig{}, err
+	}
+
+	return tickerMetadata.Aggregation, nil
+}
+
+// convertedPrice is a provider price that has been converted to the target ticker of a market,
+// along with the provider configuration that was used to fetch it.
+type convertedPrice struct {
+	Provider       string     `json:"provider"`
+	OffChainTicker string     `json:"off_chain_ticker"`
+	Price          *big.Float `json:"price"`
+
+	cfg mmtypes.ProviderConfig
+}
+
+// pricesOf returns the prices of the given converted prices.
+func pricesOf(convertedPrices []convertedPrice) []*big.Float {
+	prices := make([]*big.Float, len(convertedPrices))
+	for i, cp := range convertedPrices {
+		prices[i] = cp.Price
+	}
+
+	return prices
+}
+
+// aggregateConvertedPrices aggregates the converted prices of a market into a single index price
+// using the given aggregation configuration.
+func (m *IndexPriceAggregator) aggregateConvertedPrices(
+	market mmtypes.Market,
+	cfg AggregationConfig,
+	convertedPrices []convertedPrice,
+) (*big.Float, AggregationMethod, error) {
+	prices := pricesOf(convertedPrices)
+
+	var price *big.Float
+	switch cfg.GetMethod() {
+	case WeightedMedianAggregation:
+		weights := make([]*big.Float, len(convertedPrices))
+		for i, cp := range convertedPrices {
+			weights[i] = big.NewFloat(cfg.GetProviderWeight(cp.cfg.Name))
+		}
+
+		price = math.CalculateWeightedMedian(prices, weights)
+	case TrimmedMeanAggregation:
+		price = math.CalculateTrimmedMean(prices, cfg.TrimFraction)
+	case VWAPAggregation:
+		volumePrices := make([]*big.Float, 0, len(convertedPrices))
+		volumes := make([]*big.Float, 0, len(convertedPrices))
+		for _, cp := range convertedPrices {
+			volume, err := m.GetProviderVolume(cp.cfg)
+			if err != nil || volume.Sign() <= 0 {
+				continue
+			}
+
+			volumePrices = append(volumePrices, cp.Price)
+			volumes = append(volumes, volume)
+		}
+
+		if len(volumes) == 0 {
+			m.logger.Debug(
+				"no volume reported for market; defaulting to median",
+				zap.String("target_ticker", market.Ticker.String()),
+			)
+
+			return math.CalculateMedian(prices), MedianAggregation, nil
+		}
+
+		price = math.CalculateWeightedMean(volumePrices, volumes)
+	default:
+		price = math.CalculateMedian(prices)
+	}
+
+	if price == nil {
+		return nil, cfg.GetMethod(), fmt.Errorf("failed to aggregate prices using %s", cfg.GetMethod())
+	}
+
+	return price, cfg.GetMethod(), nil
+}
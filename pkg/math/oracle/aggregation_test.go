@@ -0,0 +1,488 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/metrics"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestParseAggregationConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata string
+		expected oracle.AggregationConfig
+		expErr   bool
+	}{
+		{
+			name:     "empty metadata defaults to median",
+			metadata: "",
+			expected: oracle.AggregationConfig{Method: oracle.MedianAggregation},
+		},
+		{
+			name:     "metadata without aggregation config defaults to median",
+			metadata: `{"foo":"bar"}`,
+			expected: oracle.AggregationConfig{Method: oracle.MedianAggregation},
+		},
+		{
+			name:     "weighted median",
+			metadata: `{"aggregation":{"method":"weighted_median","provider_weights":{"binance_api":3}}}`,
+			expected: oracle.AggregationConfig{
+				Method: oracle.WeightedMedianAggregation,
+				ProviderWeights: map[string]float64{
+					binance.Name: 3,
+				},
+			},
+		},
+		{
+			name:     "trimmed mean",
+			metadata: `{"aggregation":{"method":"trimmed_mean","trim_fraction":0.2}}`,
+			expected: oracle.AggregationConfig{
+				Method:       oracle.TrimmedMeanAggregation,
+				TrimFraction: 0.2,
+			},
+		},
+		{
+			name:     "vwap",
+			metadata: `{"aggregation":{"method":"vwap"}}`,
+			expected: oracle.AggregationConfig{Method: oracle.VWAPAggregation},
+		},
+		{
+			name:     "invalid json",
+			metadata: `{aggregation`,
+			expErr:   true,
+		},
+		{
+			name:     "unknown method",
+			metadata: `{"aggregation":{"method":"mode"}}`,
+			expErr:   true,
+		},
+		{
+			name:     "negative provider weight",
+			metadata: `{"aggregation":{"method":"weighted_median","provider_weights":{"binance_api":-1}}}`,
+			expErr:   true,
+		},
+		{
+			name:     "invalid trim fraction",
+			metadata: `{"aggregation":{"method":"trimmed_mean","trim_fraction":0.5}}`,
+			expErr:   true,
+		},
+		{
+			name:     "invalid outlier filter invalidates the aggregation config",
+			metadata: `{"aggregation":{"method":"vwap"},"outlier_filter":{"method":"zscore","threshold":3}}`,
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := oracle.ParseAggregationConfig(tc.metadata)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, cfg)
+		})
+	}
+}
+
+// newBTCMarketMap returns a market map with a single BTC/USD market with the given ticker
+// metadata, resolved from three direct feeds (coinbase, binance, and kucoin).
+func newBTCMarketMap(metadata string) mmtypes.MarketMap {
+	ticker := BTC_USD
+	ticker.Metadata_JSON = metadata
+
+	return mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+					{
+						Name:           binance.Name,
+						OffChainTicker: "BTCUSD",
+					},
+					{
+						Name:           kucoin.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+		},
+	}
+}
+
+// setBTCPrices sets the provider prices for the market returned by newBTCMarketMap.
+func setBTCPrices(aggregator *oracle.IndexPriceAggregator, coinbasePrice, binancePrice, kucoinPrice float64) {
+	aggregator.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(coinbasePrice)})
+	aggregator.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(binancePrice)})
+	aggregator.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(kucoinPrice)})
+}
+
+func TestAggregateDataWithAggregationMethods(t *testing.T) {
+	testCases := []struct {
+		name          string
+		metadata      string
+		malleate      func(aggregator *oracle.IndexPriceAggregator)
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "default is the median",
+			metadata:      "",
+			malleate:      func(*oracle.IndexPriceAggregator) {},
+			expectedPrice: big.NewFloat(71_000),
+		},
+		{
+			name:          "weighted median ignores zero-weight provider",
+			metadata:      `{"aggregation":{"method":"weighted_median","provider_weights":{"kucoin_ws":0}}}`,
+			malleate:      func(*oracle.IndexPriceAggregator) {},
+			expectedPrice: big.NewFloat(70_500), // average of 70_000 and 71_000
+		},
+		{
+			name:          "weighted median with a dominant provider",
+			metadata:      `{"aggregation":{"method":"weighted_median","provider_weights":{"coinbase_api":3}}}`,
+			malleate:      func(*oracle.IndexPriceAggregator) {},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "trimmed mean",
+			metadata:      `{"aggregation":{"method":"trimmed_mean","trim_fraction":0.34}}`,
+			malleate:      func(*oracle.IndexPriceAggregator) {},
+			expectedPrice: big.NewFloat(71_000),
+		},
+		{
+			name:     "vwap ignores providers without volume",
+			metadata: `{"aggregation":{"method":"vwap"}}`,
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderMarketData(coinbase.Name, types.TickerMarketData{
+					"BTC-USD": {Volume: big.NewFloat(1)},
+				})
+				aggregator.SetProviderMarketData(binance.Name, types.TickerMarketData{
+					"BTCUSD": {Volume: big.NewFloat(3)},
+				})
+			},
+			expectedPrice: big.NewFloat(70_750), // (70_000 * 1 + 71_000 * 3) / 4
+		},
+		{
+			name:          "vwap without volumes defaults to the median",
+			metadata:      `{"aggregation":{"method":"vwap"}}`,
+			malleate:      func(*oracle.IndexPriceAggregator) {},
+			expectedPrice: big.NewFloat(71_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, newBTCMarketMap(tc.metadata), metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			setBTCPrices(m, 70_000, 71_000, 90_000)
+			tc.malleate(m)
+
+			m.AggregatePrices()
+
+			result := m.GetIndexPrices()
+			require.Len(t, result, 1)
+
+			price, ok := result[BTC_USD.String()]
+			require.True(t, ok)
+			require.Equal(t, tc.expectedPrice.SetPrec(36), price.SetPrec(36))
+		})
+	}
+}
+
+func TestInvalidAggregationConfigIsRejected(t *testing.T) {
+	invalid := newBTCMarketMap(`{"aggregation":{"method":"mode"}}`)
+
+	t.Run("on creation", func(t *testing.T) {
+		_, err := oracle.NewIndexPriceAggregator(logger, invalid, metrics.NewNopMetrics())
+		require.Error(t, err)
+	})
+
+	t.Run("on update", func(t *testing.T) {
+		valid := newBTCMarketMap(`{"aggregation":{"method":"trimmed_mean"}}`)
+		m, err := oracle.NewIndexPriceAggregator(logger, valid, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		require.Error(t, m.UpdateMarketMap(invalid))
+		require.Equal(t, valid, *m.GetMarketMap())
+
+		// The previous market map and aggregation config are retained.
+		setBTCPrices(m, 70_000, 71_000, 90_000)
+		m.AggregatePrices()
+
+		price, ok := m.GetIndexPrices()[BTC_USD.String()]
+		require.True(t, ok)
+		require.Equal(t, big.NewFloat(77_000).SetPrec(36), price.SetPrec(36))
+	})
+}
+
+func TestParseTickerMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata string
+		expected oracle.TickerMetadata
+		expErr   bool
+	}{
+		{
+			name:     "empty metadata defaults to median without outlier filter",
+			metadata: "",
+			expected: oracle.TickerMetadata{
+				Aggregation: oracle.AggregationConfig{Method: oracle.MedianAggregation},
+			},
+		},
+		{
+			name:     "mad outlier filter",
+			metadata: `{"outlier_filter":{"method":"mad","threshold":3}}`,
+			expected: oracle.TickerMetadata{
+				Aggregation: oracle.AggregationConfig{Method: oracle.MedianAggregation},
+				OutlierFilter: oracle.OutlierFilterConfig{
+					Method:    oracle.MADOutlierFilter,
+					Threshold: 3,
+				},
+			},
+		},
+		{
+			name:     "deviation outlier filter with aggregation method",
+			metadata: `{"aggregation":{"method":"trimmed_mean","trim_fraction":0.1},"outlier_filter":{"method":"deviation","threshold":0.05}}`,
+			expected: oracle.TickerMetadata{
+				Aggregation: oracle.AggregationConfig{
+					Method:       oracle.TrimmedMeanAggregation,
+					TrimFraction: 0.1,
+				},
+				OutlierFilter: oracle.OutlierFilterConfig{
+					Method:    oracle.DeviationOutlierFilter,
+					Threshold: 0.05,
+				},
+			},
+		},
+		{
+			name:     "unknown outlier filter method",
+			metadata: `{"outlier_filter":{"method":"zscore","threshold":3}}`,
+			expErr:   true,
+		},
+		{
+			name:     "outlier filter without threshold",
+			metadata: `{"outlier_filter":{"method":"mad"}}`,
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, err := oracle.ParseTickerMetadata(tc.metadata)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, metadata)
+		})
+	}
+}
+
+func TestAggregateDataWithOutlierFilter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		metadata      string
+		prices        [3]float64
+		indexPrice    *big.Float
+		expectedPrice *big.Float
+		expectedFound bool
+	}{
+		{
+			name:          "no outlier filter uses every price",
+			metadata:      `{"aggregation":{"method":"trimmed_mean"}}`,
+			prices:        [3]float64{70_000, 71_000, 90_000},
+			expectedPrice: big.NewFloat(77_000),
+			expectedFound: true,
+		},
+		{
+			name:          "mad filter rejects the outlier",
+			metadata:      `{"aggregation":{"method":"trimmed_mean"},"outlier_filter":{"method":"mad","threshold":3}}`,
+			prices:        [3]float64{70_000, 71_000, 90_000},
+			expectedPrice: big.NewFloat(70_500),
+			expectedFound: true,
+		},
+		{
+			name:          "mad filter with no dispersion rejects nothing",
+			metadata:      `{"aggregation":{"method":"trimmed_mean"},"outlier_filter":{"method":"mad","threshold":3}}`,
+			prices:        [3]float64{70_000, 70_000, 90_000},
+			expectedPrice: big.NewFloat(76_666.666666666666666666),
+			expectedFound: true,
+		},
+		{
+			name:          "deviation filter rejects prices far from the median",
+			metadata:      `{"aggregation":{"method":"trimmed_mean"},"outlier_filter":{"method":"deviation","threshold":0.05}}`,
+			prices:        [3]float64{70_000, 71_000, 90_000},
+			expectedPrice: big.NewFloat(70_500),
+			expectedFound: true,
+		},
+		{
+			name:          "deviation filter keeps a market move that every provider agrees on",
+			metadata:      `{"aggregation":{"method":"trimmed_mean"},"outlier_filter":{"method":"deviation","threshold":0.05}}`,
+			prices:        [3]float64{80_000, 80_500, 81_000},
+			indexPrice:    big.NewFloat(70_000),
+			expectedPrice: big.NewFloat(80_500),
+			expectedFound: true,
+		},
+		{
+			name:          "deviation filter ignores the previous index price",
+			metadata:      `{"aggregation":{"method":"trimmed_mean"},"outlier_filter":{"method":"deviation","threshold":0.05}}`,
+			prices:        [3]float64{70_000, 80_000, 80_500},
+			indexPrice:    big.NewFloat(70_000),
+			expectedPrice: big.NewFloat(80_250),
+			expectedFound: true,
+		},
+		{
+			name:          "rejecting outliers can leave too few providers",
+			metadata:      `{"outlier_filter":{"method":"deviation","threshold":0.01}}`,
+			prices:        [3]float64{70_000, 75_000, 90_000},
+			expectedFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			marketMap := newBTCMarketMap(tc.metadata)
+			market := marketMap.Markets[BTC_USD.String()]
+			market.Ticker.MinProviderCount = 2
+			marketMap.Markets[BTC_USD.String()] = market
+
+			m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			setBTCPrices(m, tc.prices[0], tc.prices[1], tc.prices[2])
+			if tc.indexPrice != nil {
+				m.SetIndexPrices(types.Prices{BTC_USD.String(): tc.indexPrice})
+			}
+
+			m.AggregatePrices()
+
+			price, ok := m.GetIndexPrices()[BTC_USD.String()]
+			require.Equal(t, tc.expectedFound, ok)
+			if !tc.expectedFound {
+				return
+			}
+
+			require.Equal(t, tc.expectedPrice.SetPrec(36), price.SetPrec(36))
+		})
+	}
+}
+
+func TestAggregateMarketData(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, newBTCMarketMap(""), metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	setBTCPrices(m, 70_000, 71_000, 72_000)
+	m.SetProviderMarketData(coinbase.Name, types.TickerMarketData{
+		"BTC-USD": {
+			Volume:  big.NewFloat(1),
+			BestBid: big.NewFloat(69_990),
+			BestAsk: big.NewFloat(70_010),
+		},
+	})
+	m.SetProviderMarketData(binance.Name, types.TickerMarketData{
+		"BTCUSD": {
+			Volume:  big.NewFloat(2),
+			BestBid: big.NewFloat(70_990),
+			BestAsk: big.NewFloat(71_010),
+		},
+	})
+
+	m.AggregatePrices()
+
+	marketData := m.GetMarketData()
+	require.Len(t, marketData, 1)
+
+	data, ok := marketData[BTC_USD.String()]
+	require.True(t, ok)
+	require.Equal(t, 0, data.Volume.Cmp(big.NewFloat(3)))
+
+	// The best bid / ask are the medians of the reported best bids / asks, scaled by the ticker's decimals.
+	require.Equal(t, 0, data.BestBid.Cmp(big.NewFloat(70_490*1e8)))
+	require.Equal(t, 0, data.BestAsk.Cmp(big.NewFloat(70_510*1e8)))
+
+	// Aggregating the market data must not mutate the provider's market data.
+	cfg := mmtypes.ProviderConfig{Name: coinbase.Name, OffChainTicker: "BTC-USD"}
+	providerData, err := m.GetProviderMarketData(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 0, providerData.BestBid.Cmp(big.NewFloat(69_990)))
+
+	// Markets without market data are not reported.
+	m.Reset()
+	setBTCPrices(m, 70_000, 71_000, 72_000)
+	m.AggregatePrices()
+	require.Empty(t, m.GetMarketData())
+}
+
+func TestGetProviderMarketData(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, mmtypes.MarketMap{}, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USDT": big.NewFloat(2)})
+	m.SetProviderMarketData(coinbase.Name, types.TickerMarketData{
+		"BTC-USDT": {
+			Volume:  big.NewFloat(10),
+			BestBid: big.NewFloat(1.9),
+			BestAsk: big.NewFloat(2.1),
+		},
+	})
+	m.SetIndexPrices(types.Prices{USDT_USD.String(): big.NewFloat(2)})
+
+	t.Run("missing provider", func(t *testing.T) {
+		_, err := m.GetProviderMarketData(mmtypes.ProviderConfig{Name: binance.Name, OffChainTicker: "BTCUSDT"})
+		require.Error(t, err)
+	})
+
+	t.Run("missing ticker", func(t *testing.T) {
+		_, err := m.GetProviderMarketData(mmtypes.ProviderConfig{Name: coinbase.Name, OffChainTicker: "ETH-USDT"})
+		require.Error(t, err)
+	})
+
+	t.Run("direct", func(t *testing.T) {
+		data, err := m.GetProviderMarketData(mmtypes.ProviderConfig{Name: coinbase.Name, OffChainTicker: "BTC-USDT"})
+		require.NoError(t, err)
+		require.Equal(t, 0, data.Volume.Cmp(big.NewFloat(10)))
+		require.Equal(t, 0, data.BestBid.Cmp(big.NewFloat(1.9)))
+		require.Equal(t, 0, data.BestAsk.Cmp(big.NewFloat(2.1)))
+	})
+
+	t.Run("inverted", func(t *testing.T) {
+		data, err := m.GetProviderMarketData(mmtypes.ProviderConfig{
+			Name:           coinbase.Name,
+			OffChainTicker: "BTC-USDT",
+			Invert:         true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, data.Volume.Cmp(big.NewFloat(20)))
+		require.Equal(t, 0, data.BestBid.Cmp(new(big.Float).Quo(big.NewFloat(1), big.NewFloat(2.1))))
+		require.Equal(t, 0, data.BestAsk.Cmp(new(big.Float).Quo(big.NewFloat(1), big.NewFloat(1.9))))
+	})
+
+	t.Run("normalized", func(t *testing.T) {
+		data, err := m.GetProviderMarketData(mmtypes.ProviderConfig{
+			Name:            coinbase.Name,
+			OffChainTicker:  "BTC-USDT",
+			NormalizeByPair: &USDT_USD.CurrencyPair,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, data.Volume.Cmp(big.NewFloat(10)))
+		require.Equal(t, 0, data.BestBid.Cmp(new(big.Float).Mul(big.NewFloat(1.9), big.NewFloat(2))))
+		require.Equal(t, 0, data.BestAsk.Cmp(new(big.Float).Mul(big.NewFloat(2.1), big.NewFloat(2))))
+	})
+}
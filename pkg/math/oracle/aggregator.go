@@ -26,17 +26,39 @@ type IndexPriceAggregator struct {
 	cfg     mmtypes.MarketMap
 	metrics oraclemetrics.Metrics
 
+	// metadata cache the parsed aggregation configuration of each market in the market map,
+	// indexed by ticker. This is validated whenever the market map is set.
+	metadata map[string]TickerMetadata
+
 	// indexPrices cache the median prices for each ticker. These are unscaled prices.
 	indexPrices types.Prices
 	// scaledPrices cache the scaled prices for each ticker. These are the prices that can be
 	// consumed by consumers.
 	scaledPrices types.Prices
+	// dispersion cache the standard deviation of the converted prices used to calculate each
+	// index price. These are scaled by the ticker's decimals.
+	dispersion types.Prices
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// providerMarketData cache the market data (volume and best bid / ask) reported by each
+	// provider. These are indexed by provider -> offChainTicker -> market data, and are used
+	// for volume-weighted aggregation.
+	providerMarketData map[string]types.TickerMarketData
+	// marketData cache the aggregated market data for each ticker. The best bid / ask are
+	// scaled by the ticker's decimals.
+	marketData types.TickerMarketData
+	// providerTimestamps cache the time at which each provider last reported a price,
+	// including prices that were too old to be used. These are indexed by
+	// provider -> offChainTicker -> timestamp.
+	providerTimestamps map[string]types.ProviderTimestamps
+	// priceDetails cache the breakdown of the most recent index price calculation for each
+	// ticker.
+	priceDetails types.TickerPriceDetails
 }
 
-// NewIndexPriceAggregator returns a new Index Price Aggregator.
+// NewIndexPriceAggregator returns a new Index Price Aggregator. An error is returned if the
+// aggregation configuration of any market in the market map is invalid.
 func NewIndexPriceAggregator(
 	logger *zap.Logger,
 	cfg mmtypes.MarketMap,
@@ -51,31 +73,48 @@ func NewIndexPriceAggregator(
 		metrics = oraclemetrics.NewNopMetrics()
 	}
 
+	metadata, err := parseMarketMapMetadata(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &IndexPriceAggregator{
-		logger:         logger.With(zap.String("process", "index_price_aggregator")),
-		cfg:            cfg,
-		metrics:        metrics,
-		indexPrices:    make(types.Prices),
-		scaledPrices:   make(types.Prices),
-		providerPrices: make(map[string]types.Prices),
+		logger:             logger.With(zap.String("process", "index_price_aggregator")),
+		cfg:                cfg,
+		metrics:            metrics,
+		metadata:           metadata,
+		indexPrices:        make(types.Prices),
+		scaledPrices:       make(types.Prices),
+		dispersion:         make(types.Prices),
+		providerPrices:     make(map[string]types.Prices),
+		providerMarketData: make(map[string]types.TickerMarketData),
+		marketData:         make(types.TickerMarketData),
+		providerTimestamps: make(map[string]types.ProviderTimestamps),
+		priceDetails:       make(types.TickerPriceDetails),
 	}, nil
 }
 
-// AggregatePrices implements the aggregate function for the median price calculation. Specifically, this
+// AggregatePrices implements the aggregate function for the index price calculation. Specifically, this
 // aggregation function aggregates the prices seen by each provider by first converting each price to a
-// common ticker and then calculating the median of the converted prices. Prices are converted either
+// common ticker and then aggregating the converted prices using the market's configured aggregation
+// method (the median by default, see AggregationConfig). Prices are converted either
 //
 //  1. Directly from the base ticker to the target ticker. i.e. I have BTC/USD and I want BTC/USD.
 //  2. Using the index price of an asset. i.e. I have BTC/USDT and I want BTC/USD. I can convert
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
 //
-// The index price cache contains the previously calculated median prices.
+// The index price cache contains the previously calculated index prices. The market data (volume
+// and best bid / ask) reported by the providers that contributed to each index price is aggregated
+// alongside the price.
 func (m *IndexPriceAggregator) AggregatePrices() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	indexPrices := make(types.Prices)
 	scaledPrices := make(types.Prices)
+	dispersion := make(types.Prices)
+	marketData := make(types.TickerMarketData)
+	priceDetails := make(types.TickerPriceDetails)
 
 	for ticker, market := range m.cfg.Markets {
 		if !market.Ticker.Enabled {
@@ -87,10 +126,17 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		// ex. BTC/USDT * Index USDT/USD = BTC/USD
 		//     BTC/USDC * Index USDC/USD = BTC/USD
 		target := market.Ticker
-		convertedPrices := m.CalculateConvertedPrices(market)
+		candidatePrices := m.calculateConvertedPrices(market)
+
+		// Get the market's aggregation configuration, which was validated when the market map
+		// was set.
+		metadata := m.metadata[ticker]
+
+		// Drop any converted prices that are outliers relative to the other providers.
+		convertedPrices := m.filterOutliers(market, metadata.OutlierFilter, candidatePrices)
 		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
 
-		// We need to have at least the minimum number of providers to calculate the median.
+		// We need to have at least the minimum number of providers to calculate the index price.
 		if len(convertedPrices) < int(target.MinProviderCount) {
 			m.logger.Error(
 				"insufficient amount of converted prices",
@@ -100,20 +146,66 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 				zap.Int("min_provider_count", int(target.MinProviderCount)),
 			)
 
+			priceDetails[target.String()] = m.marketPriceDetails(
+				market,
+				candidatePrices,
+				convertedPrices,
+				types.ProviderPriceInsufficientProviders,
+				nil,
+			)
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
+		// Aggregate the converted prices using the market's aggregation method.
+		price, method, err := m.aggregateConvertedPrices(market, metadata.Aggregation, convertedPrices)
+		if err != nil {
+			m.logger.Error(
+				"failed to aggregate converted prices",
+				zap.String("target_ticker", ticker),
+				zap.String("method", string(method)),
+				zap.Error(err),
+			)
+
+			priceDetails[target.String()] = m.marketPriceDetails(
+				market,
+				candidatePrices,
+				convertedPrices,
+				types.ProviderPriceAggregationFailed,
+				nil,
+			)
+			continue
+		}
 		indexPrices[target.String()] = new(big.Float).Copy(price)
+		priceDetails[target.String()] = m.marketPriceDetails(
+			market,
+			candidatePrices,
+			convertedPrices,
+			types.ProviderPriceUsed,
+			price,
+		)
 
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
 
+		// Calculate how much the converted prices disagree, scaled to the target ticker's decimals.
+		if stddev := math.CalculateStandardDeviation(pricesOf(convertedPrices)); stddev != nil {
+			dispersion[target.String()] = math.ScaleBigFloat(stddev, target.Decimals)
+		}
+
+		// Aggregate the market data of the providers, scaling the best bid / ask to the target
+		// ticker's decimals.
+		if data := m.aggregateMarketData(convertedPrices); !data.IsEmpty() {
+			if data.BestBid != nil && data.BestAsk != nil {
+				data.BestBid = math.ScaleBigFloat(data.BestBid, target.Decimals)
+				data.BestAsk = math.ScaleBigFloat(data.BestAsk, target.Decimals)
+			}
+			marketData[target.String()] = data
+		}
+
 		m.logger.Debug(
-			"calculated median price",
+			"calculated index price",
 			zap.String("target_ticker", ticker),
+			zap.String("method", string(method)),
 
 			zap.String("unscaled_price", indexPrices[target.String()].String()),
 			zap.String("scaled_price", scaledPrices[target.String()].String()),
@@ -126,9 +218,12 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 
 	// Update the aggregated data. These prices are going to be used as the index prices the
 	// next time we calculate prices.
-	m.logger.Debug("calculated median prices for price feeds", zap.Int("num_prices", len(indexPrices)))
+	m.logger.Debug("calculated index prices for price feeds", zap.Int("num_prices", len(indexPrices)))
 	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.dispersion = dispersion
+	m.marketData = marketData
+	m.priceDetails = priceDetails
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -137,6 +232,18 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
 ) []*big.Float {
+	convertedPrices := m.calculateConvertedPrices(market)
+
+	prices := pricesOf(convertedPrices)
+
+	return prices
+}
+
+// calculateConvertedPrices calculates the converted prices for a given market, retaining the
+// provider configuration that each converted price was derived from.
+func (m *IndexPriceAggregator) calculateConvertedPrices(
+	market mmtypes.Market,
+) []convertedPrice {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -147,7 +254,7 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		return nil
 	}
 
-	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
+	convertedPrices := make([]convertedPrice, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
 		// Calculate the converted price.
 		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
@@ -163,7 +270,12 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			continue
 		}
 
-		convertedPrices = append(convertedPrices, adjustedPrice)
+		convertedPrices = append(convertedPrices, convertedPrice{
+			Provider:       cfg.Name,
+			OffChainTicker: cfg.OffChainTicker,
+			Price:          adjustedPrice,
+			cfg:            cfg,
+		})
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
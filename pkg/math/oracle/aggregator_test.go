@@ -164,6 +164,89 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestAggregateDispersion(t *testing.T) {
+	testCases := []struct {
+		name               string
+		malleate           func(aggregator *oracle.IndexPriceAggregator)
+		expectedDispersion map[string]int64
+	}{
+		{
+			name:               "no data",
+			malleate:           func(*oracle.IndexPriceAggregator) {},
+			expectedDispersion: map[string]int64{},
+		},
+		{
+			name: "coinbase direct feed for BTC/USD - no dispersion since it does not have enough providers",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"BTC-USD": big.NewFloat(70_000),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+			},
+			expectedDispersion: map[string]int64{},
+		},
+		{
+			name: "coinbase USDT direct, binance USDT/USD direct feeds for USDT/USD - success",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"USDT-USD": big.NewFloat(1.1),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				prices = types.Prices{
+					"USDTUSD": big.NewFloat(1.2),
+				}
+				aggregator.SetProviderPrices(binance.Name, prices)
+			},
+			expectedDispersion: map[string]int64{
+				USDT_USD.String(): 50_000, // standard deviation of 1.1, 1.2 scaled by 6 decimals
+			},
+		},
+		{
+			name: "coinbase USDT direct, binance USDT/USD direct feeds with the same price for USDT/USD - success",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"USDT-USD": big.NewFloat(1.1),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				prices = types.Prices{
+					"USDTUSD": big.NewFloat(1.1),
+				}
+				aggregator.SetProviderPrices(binance.Name, prices)
+			},
+			expectedDispersion: map[string]int64{
+				USDT_USD.String(): 0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			// Update the price aggregator with relevant data.
+			tc.malleate(m)
+
+			// Aggregate the data.
+			m.AggregatePrices()
+
+			// Ensure that the dispersion is as expected.
+			result := m.GetPriceDispersion()
+			require.Equal(t, len(tc.expectedDispersion), len(result))
+			for ticker, dispersion := range result {
+				expectedDispersion, ok := tc.expectedDispersion[ticker]
+				require.True(t, ok)
+
+				// Round to the nearest integer to account for floating point imprecision.
+				rounded, _ := new(big.Float).Add(dispersion, big.NewFloat(0.5)).Int(nil)
+				require.Equal(t, big.NewInt(expectedDispersion), rounded)
+			}
+		})
+	}
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name           string
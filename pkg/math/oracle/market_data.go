@@ -0,0 +1,154 @@
+package oracle
+
+import (
+	"fmt"
+	"maps"
+	"math/big"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// GetProviderMarketData returns the market data reported by the provider for the given provider
+// config, converted in the same manner as the provider's price. Specifically,
+//
+//  1. If the provider config is inverted, the volume is converted from units of the base asset to
+//     units of the quote asset (using the provider's price), and the best bid / ask are inverted
+//     and swapped i.e. the best bid of the inverted market is 1 / best ask.
+//  2. If the provider config is normalized by a pair, the best bid / ask are adjusted by the index
+//     price of the pair. The volume is left as is since the base asset does not change.
+//
+// Note that the aggregator's provider market data cache stores market data in the form of
+// providerName -> offChainTicker -> market data.
+func (m *IndexPriceAggregator) GetProviderMarketData(
+	cfg mmtypes.ProviderConfig,
+) (types.MarketData, error) {
+	cache, ok := m.providerMarketData[cfg.Name]
+	if !ok {
+		return types.MarketData{}, fmt.Errorf("missing provider market data for provider: %s", cfg.Name)
+	}
+
+	data, ok := cache[cfg.OffChainTicker]
+	if !ok || data.IsEmpty() {
+		return types.MarketData{}, fmt.Errorf("missing %s market data for ticker: %s", cfg.Name, cfg.OffChainTicker)
+	}
+
+	converted := types.MarketData{
+		Volume:  data.Volume,
+		BestBid: data.BestBid,
+		BestAsk: data.BestAsk,
+	}
+
+	if cfg.Invert {
+		converted.Volume = nil
+		if data.Volume != nil {
+			price, ok := m.providerPrices[cfg.Name][cfg.OffChainTicker]
+			if ok && price != nil {
+				converted.Volume = new(big.Float).Mul(data.Volume, price)
+			}
+		}
+
+		converted.BestBid, converted.BestAsk = nil, nil
+		if data.BestBid != nil && data.BestAsk != nil && data.BestBid.Sign() > 0 && data.BestAsk.Sign() > 0 {
+			converted.BestBid = new(big.Float).Quo(big.NewFloat(1), data.BestAsk)
+			converted.BestAsk = new(big.Float).Quo(big.NewFloat(1), data.BestBid)
+		}
+	}
+
+	if cfg.NormalizeByPair != nil && converted.BestBid != nil && converted.BestAsk != nil {
+		normalizeByIndexPrice, err := m.GetIndexPrice(*cfg.NormalizeByPair)
+		if err != nil {
+			converted.BestBid, converted.BestAsk = nil, nil
+		} else {
+			converted.BestBid = new(big.Float).Mul(converted.BestBid, normalizeByIndexPrice)
+			converted.BestAsk = new(big.Float).Mul(converted.BestAsk, normalizeByIndexPrice)
+		}
+	}
+
+	return converted, nil
+}
+
+// GetProviderVolume returns the volume reported by the provider for the given provider config,
+// converted to units of the base asset of the target market (see GetProviderMarketData).
+func (m *IndexPriceAggregator) GetProviderVolume(
+	cfg mmtypes.ProviderConfig,
+) (*big.Float, error) {
+	data, err := m.GetProviderMarketData(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Volume == nil {
+		return nil, fmt.Errorf("missing %s volume for ticker: %s", cfg.Name, cfg.OffChainTicker)
+	}
+
+	return data.Volume, nil
+}
+
+// SetProviderMarketData updates the data aggregator with the market data (volume and best bid /
+// ask) reported by the given provider.
+func (m *IndexPriceAggregator) SetProviderMarketData(provider string, data types.TickerMarketData) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if data == nil {
+		data = make(types.TickerMarketData)
+	}
+
+	m.providerMarketData[provider] = data
+}
+
+// GetMarketData returns the aggregated market data the aggregator has. The best bid / ask are
+// scaled by the respective ticker's decimals, while the volume is reported in units of the base
+// asset.
+func (m *IndexPriceAggregator) GetMarketData() types.TickerMarketData {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.TickerMarketData)
+	maps.Copy(cpy, m.marketData)
+
+	return cpy
+}
+
+// aggregateMarketData aggregates the market data of the providers that contributed the given
+// converted prices. The volume is the sum of the converted volumes, and the best bid / ask are
+// the medians of the converted best bids / asks of the providers that report both.
+func (m *IndexPriceAggregator) aggregateMarketData(convertedPrices []convertedPrice) types.MarketData {
+	var (
+		volume *big.Float
+		bids   = make([]*big.Float, 0, len(convertedPrices))
+		asks   = make([]*big.Float, 0, len(convertedPrices))
+	)
+
+	for _, cp := range convertedPrices {
+		data, err := m.GetProviderMarketData(cp.cfg)
+		if err != nil {
+			continue
+		}
+
+		if data.Volume != nil {
+			if volume == nil {
+				volume = new(big.Float)
+			}
+			volume.Add(volume, data.Volume)
+		}
+
+		if data.BestBid != nil && data.BestAsk != nil {
+			bids = append(bids, data.BestBid)
+			asks = append(asks, data.BestAsk)
+		}
+	}
+
+	aggregated := types.MarketData{
+		Volume: volume,
+	}
+	if len(bids) > 0 {
+		// The median may alias a provider's best bid / ask, so it is copied before being returned.
+		aggregated.BestBid = new(big.Float).Copy(math.CalculateMedian(bids))
+		aggregated.BestAsk = new(big.Float).Copy(math.CalculateMedian(asks))
+	}
+
+	return aggregated
+}
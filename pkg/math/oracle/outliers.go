@@ -0,0 +1,135 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/pkg/math"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// OutlierFilterMethod is the method used to reject outlying converted prices of a market before
+// they are aggregated.
+type OutlierFilterMethod string
+
+const (
+	// NoOutlierFilter does not reject any converted prices. This is the default method.
+	NoOutlierFilter OutlierFilterMethod = "none"
+	// MADOutlierFilter rejects converted prices whose absolute deviation from the median of the
+	// converted prices is greater than threshold * MAD, where MAD is the median absolute deviation
+	// of the converted prices. This requires at least MinMADPrices converted prices.
+	MADOutlierFilter OutlierFilterMethod = "mad"
+	// DeviationOutlierFilter rejects converted prices that deviate from the median of the
+	// converted prices by more than the threshold, expressed as a fraction of the median (i.e.
+	// 0.05 is 5%). Prices are compared against each other rather than the previous index price,
+	// such that a genuine market move does not reject every provider.
+	DeviationOutlierFilter OutlierFilterMethod = "deviation"
+)
+
+// MinMADPrices is the minimum number of converted prices required to reject outliers using the
+// median absolute deviation.
+const MinMADPrices = 3
+
+// OutlierFilterConfig configures how outlying converted prices of a market are rejected before
+// aggregation.
+type OutlierFilterConfig struct {
+	// Method is the outlier filter method used for the market. If empty, no prices are rejected.
+	Method OutlierFilterMethod `json:"method,omitempty"`
+	// Threshold is the rejection threshold. For the MAD method, this is the number of median
+	// absolute deviations a price may deviate from the median. For the deviation method, this
+	// is the maximum fractional deviation from the median of the converted prices.
+	Threshold float64 `json:"threshold,omitempty"`
+}
+
+// ValidateBasic performs basic validation on the outlier filter configuration.
+func (c OutlierFilterConfig) ValidateBasic() error {
+	switch c.GetMethod() {
+	case NoOutlierFilter:
+		return nil
+	case MADOutlierFilter, DeviationOutlierFilter:
+	default:
+		return fmt.Errorf("unknown outlier filter method: %s", c.Method)
+	}
+
+	if c.Threshold <= 0 {
+		return fmt.Errorf("outlier filter threshold must be positive; got %f", c.Threshold)
+	}
+
+	return nil
+}
+
+// GetMethod returns the configured outlier filter method, defaulting to no filtering.
+func (c OutlierFilterConfig) GetMethod() OutlierFilterMethod {
+	if c.Method == "" {
+		return NoOutlierFilter
+	}
+
+	return c.Method
+}
+
+// filterOutliers returns the converted prices of a market that are not outliers, as determined by
+// the given outlier filter configuration. Each rejected price is logged and recorded in metrics.
+func (m *IndexPriceAggregator) filterOutliers(
+	market mmtypes.Market,
+	cfg OutlierFilterConfig,
+	convertedPrices []convertedPrice,
+) []convertedPrice {
+	var (
+		reference *big.Float
+		maxDiff   *big.Float
+	)
+
+	threshold := big.NewFloat(cfg.Threshold)
+	switch cfg.GetMethod() {
+	case MADOutlierFilter:
+		if len(convertedPrices) < MinMADPrices {
+			return convertedPrices
+		}
+
+		prices := pricesOf(convertedPrices)
+
+		// If the MAD is zero, the majority of prices are identical and there is no dispersion
+		// to measure outliers against.
+		mad := math.CalculateMedianAbsoluteDeviation(prices)
+		if mad.Sign() == 0 {
+			return convertedPrices
+		}
+
+		reference = math.CalculateMedian(prices)
+		maxDiff = new(big.Float).Mul(mad, threshold)
+	case DeviationOutlierFilter:
+		if len(convertedPrices) == 0 {
+			return convertedPrices
+		}
+
+		reference = math.CalculateMedian(pricesOf(convertedPrices))
+		maxDiff = new(big.Float).Mul(reference, threshold)
+		maxDiff.Abs(maxDiff)
+	default:
+		return convertedPrices
+	}
+
+	filtered := make([]convertedPrice, 0, len(convertedPrices))
+	for _, cp := range convertedPrices {
+		diff := new(big.Float).Sub(cp.Price, reference)
+		if diff.Abs(diff).Cmp(maxDiff) <= 0 {
+			filtered = append(filtered, cp)
+			continue
+		}
+
+		m.logger.Debug(
+			"rejected outlier price",
+			zap.String("target_ticker", market.Ticker.String()),
+			zap.String("provider", cp.Provider),
+			zap.String("off_chain_ticker", cp.OffChainTicker),
+			zap.String("method", string(cfg.GetMethod())),
+			zap.String("price", cp.Price.String()),
+			zap.String("reference_price", reference.String()),
+		)
+		m.metrics.AddProviderOutlier(cp.Provider, market.Ticker.String())
+	}
+
+	return filtered
+}
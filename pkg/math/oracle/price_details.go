@@ -0,0 +1,114 @@
+package oracle
+
+import (
+	"maps"
+	"math/big"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// SetProviderTimestamps updates the data aggregator with the time at which the given provider
+// last reported a price for each off-chain ticker. This should include prices that were too old
+// to be set via SetProviderPrices, such that they can be reported as stale.
+func (m *IndexPriceAggregator) SetProviderTimestamps(provider string, timestamps types.ProviderTimestamps) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if timestamps == nil {
+		timestamps = make(types.ProviderTimestamps)
+	}
+
+	m.providerTimestamps[provider] = timestamps
+}
+
+// GetPriceDetails returns the breakdown of the most recent index price calculation for each
+// enabled market, i.e. the contribution of each provider and the resulting index price.
+func (m *IndexPriceAggregator) GetPriceDetails() types.TickerPriceDetails {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.TickerPriceDetails)
+	maps.Copy(cpy, m.priceDetails)
+
+	return cpy
+}
+
+// marketPriceDetails returns the breakdown of the index price calculation of the given market.
+// candidatePrices are the converted prices of the market before outlier filtering, while
+// convertedPrices are the prices that remained after filtering; the latter are assigned the
+// given status. Both are expected to be ordered by the market's provider configs.
+func (m *IndexPriceAggregator) marketPriceDetails(
+	market mmtypes.Market,
+	candidatePrices []convertedPrice,
+	convertedPrices []convertedPrice,
+	status types.ProviderPriceStatus,
+	price *big.Float,
+) types.MarketPriceDetails {
+	details := types.MarketPriceDetails{
+		Providers: make([]types.ProviderPriceDetails, 0, len(market.ProviderConfigs)),
+	}
+
+	var candidateIdx, convertedIdx int
+	for _, cfg := range market.ProviderConfigs {
+		detail := types.ProviderPriceDetails{
+			Provider:       cfg.Name,
+			OffChainTicker: cfg.OffChainTicker,
+			Timestamp:      m.providerTimestamps[cfg.Name][cfg.OffChainTicker],
+		}
+
+		switch {
+		case m.providerPrices[cfg.Name][cfg.OffChainTicker] == nil:
+			// The oracle only sets prices that are within the maximum price age, so a price that
+			// was reported but not set is stale.
+			detail.Status = types.ProviderPriceMissing
+			if !detail.Timestamp.IsZero() {
+				detail.Status = types.ProviderPriceStale
+			}
+		case candidateIdx >= len(candidatePrices) || !sameProviderConfig(candidatePrices[candidateIdx].cfg, cfg):
+			// The provider reported a price, but it could not be converted.
+			detail.RawPrice = new(big.Float).Set(m.providerPrices[cfg.Name][cfg.OffChainTicker])
+			detail.Status = types.ProviderPriceConversionFailed
+			if cfg.NormalizeByPair != nil {
+				if _, err := m.GetIndexPrice(*cfg.NormalizeByPair); err != nil {
+					detail.Status = types.ProviderPriceMissingIndexPrice
+				}
+			}
+		default:
+			detail.RawPrice = new(big.Float).Set(m.providerPrices[cfg.Name][cfg.OffChainTicker])
+			detail.ConvertedPrice = candidatePrices[candidateIdx].Price
+			candidateIdx++
+
+			detail.Status = types.ProviderPriceOutlier
+			if convertedIdx < len(convertedPrices) && sameProviderConfig(convertedPrices[convertedIdx].cfg, cfg) {
+				detail.Status = status
+				convertedIdx++
+			}
+		}
+
+		details.Providers = append(details.Providers, detail)
+	}
+
+	if price != nil {
+		prices := pricesOf(convertedPrices)
+
+		details.MedianPrice = new(big.Float).Copy(math.CalculateMedian(prices))
+		details.AggregatedPrice = new(big.Float).Copy(price)
+	}
+
+	return details
+}
+
+// sameProviderConfig returns true if the given provider configs resolve the same price.
+func sameProviderConfig(a, b mmtypes.ProviderConfig) bool {
+	if a.Name != b.Name || a.OffChainTicker != b.OffChainTicker || a.Invert != b.Invert {
+		return false
+	}
+
+	if a.NormalizeByPair == nil || b.NormalizeByPair == nil {
+		return a.NormalizeByPair == b.NormalizeByPair
+	}
+
+	return a.NormalizeByPair.Equal(*b.NormalizeByPair)
+}
@@ -0,0 +1,293 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/metrics"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+)
+
+func TestGetPriceDetails(t *testing.T) {
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name            string
+		malleate        func(aggregator *oracle.IndexPriceAggregator)
+		ticker          string
+		expectedDetails types.MarketPriceDetails
+	}{
+		{
+			name:     "no data",
+			malleate: func(*oracle.IndexPriceAggregator) {},
+			ticker:   BTC_USD.String(),
+			expectedDetails: types.MarketPriceDetails{
+				Providers: []types.ProviderPriceDetails{
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "BTC-USD",
+						Status:         types.ProviderPriceMissing,
+					},
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "BTC-USDT",
+						Status:         types.ProviderPriceMissing,
+					},
+					{
+						Provider:       binance.Name,
+						OffChainTicker: "BTCUSDT",
+						Status:         types.ProviderPriceMissing,
+					},
+				},
+			},
+		},
+		{
+			name: "coinbase direct feed, coinbase adjusted feed without index price, stale binance feed for BTC/USD - insufficient providers",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"BTC-USD":  big.NewFloat(70_000),
+					"BTC-USDT": big.NewFloat(70_000),
+				})
+				aggregator.SetProviderTimestamps(coinbase.Name, types.ProviderTimestamps{
+					"BTC-USD":  now,
+					"BTC-USDT": now,
+				})
+				aggregator.SetProviderTimestamps(binance.Name, types.ProviderTimestamps{
+					"BTCUSDT": now.Add(-time.Minute),
+				})
+			},
+			ticker: BTC_USD.String(),
+			expectedDetails: types.MarketPriceDetails{
+				Providers: []types.ProviderPriceDetails{
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "BTC-USD",
+						RawPrice:       big.NewFloat(70_000),
+						ConvertedPrice: big.NewFloat(70_000),
+						Timestamp:      now,
+						Status:         types.ProviderPriceInsufficientProviders,
+					},
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "BTC-USDT",
+						RawPrice:       big.NewFloat(70_000),
+						Timestamp:      now,
+						Status:         types.ProviderPriceMissingIndexPrice,
+					},
+					{
+						Provider:       binance.Name,
+						OffChainTicker: "BTCUSDT",
+						Timestamp:      now.Add(-time.Minute),
+						Status:         types.ProviderPriceStale,
+					},
+				},
+			},
+		},
+		{
+			name: "coinbase direct feed, coinbase adjusted feed, binance adjusted feed for BTC/USD with index prices - success",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"BTC-USD":  big.NewFloat(70_000),
+					"BTC-USDT": big.NewFloat(70_000),
+				})
+				aggregator.SetProviderPrices(binance.Name, types.Prices{
+					"BTCUSDT": big.NewFloat(69_000),
+				})
+				aggregator.SetIndexPrices(types.Prices{
+					usdtusdCP.String(): big.NewFloat(1.1),
+				})
+			},
+			ticker: BTC_USD.String(),
+			expectedDetails: types.MarketPriceDetails{
+				Providers: []types.ProviderPriceDetails{
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "BTC-USD",
+						RawPrice:       big.NewFloat(70_000),
+						ConvertedPrice: big.NewFloat(70_000),
+						Status:         types.ProviderPriceUsed,
+					},
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "BTC-USDT",
+						RawPrice:       big.NewFloat(70_000),
+						ConvertedPrice: big.NewFloat(77_000),
+						Status:         types.ProviderPriceUsed,
+					},
+					{
+						Provider:       binance.Name,
+						OffChainTicker: "BTCUSDT",
+						RawPrice:       big.NewFloat(69_000),
+						ConvertedPrice: big.NewFloat(75_900),
+						Status:         types.ProviderPriceUsed,
+					},
+				},
+				MedianPrice:     big.NewFloat(75_900),
+				AggregatedPrice: big.NewFloat(75_900),
+			},
+		},
+		{
+			name: "coinbase USDT direct, binance USDT/USD direct feeds for USDT/USD, kucoin missing - success",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"USDT-USD": big.NewFloat(1.1),
+				})
+				aggregator.SetProviderPrices(binance.Name, types.Prices{
+					"USDTUSD": big.NewFloat(1.2),
+				})
+			},
+			ticker: USDT_USD.String(),
+			expectedDetails: types.MarketPriceDetails{
+				Providers: []types.ProviderPriceDetails{
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "USDT-USD",
+						RawPrice:       big.NewFloat(1.1),
+						ConvertedPrice: big.NewFloat(1.1),
+						Status:         types.ProviderPriceUsed,
+					},
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "USDC-USDT",
+						Status:         types.ProviderPriceMissing,
+					},
+					{
+						Provider:       binance.Name,
+						OffChainTicker: "USDTUSD",
+						RawPrice:       big.NewFloat(1.2),
+						ConvertedPrice: big.NewFloat(1.2),
+						Status:         types.ProviderPriceUsed,
+					},
+					{
+						Provider:       kucoin.Name,
+						OffChainTicker: "BTC-USDT",
+						Status:         types.ProviderPriceMissing,
+					},
+				},
+				MedianPrice:     big.NewFloat(1.15),
+				AggregatedPrice: big.NewFloat(1.15),
+			},
+		},
+		{
+			name: "coinbase inverted feed with a zero price for USDT/USD - conversion failed",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"USDT-USD":  big.NewFloat(1.1),
+					"USDC-USDT": big.NewFloat(0),
+				})
+				aggregator.SetProviderPrices(binance.Name, types.Prices{
+					"USDTUSD": big.NewFloat(1.2),
+				})
+				aggregator.SetProviderPrices(kucoin.Name, types.Prices{
+					"BTC-USDT": big.NewFloat(70_000),
+				})
+			},
+			ticker: USDT_USD.String(),
+			expectedDetails: types.MarketPriceDetails{
+				Providers: []types.ProviderPriceDetails{
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "USDT-USD",
+						RawPrice:       big.NewFloat(1.1),
+						ConvertedPrice: big.NewFloat(1.1),
+						Status:         types.ProviderPriceUsed,
+					},
+					{
+						Provider:       coinbase.Name,
+						OffChainTicker: "USDC-USDT",
+						RawPrice:       big.NewFloat(0),
+						Status:         types.ProviderPriceConversionFailed,
+					},
+					{
+						Provider:       binance.Name,
+						OffChainTicker: "USDTUSD",
+						RawPrice:       big.NewFloat(1.2),
+						ConvertedPrice: big.NewFloat(1.2),
+						Status:         types.ProviderPriceUsed,
+					},
+					{
+						Provider:       kucoin.Name,
+						OffChainTicker: "BTC-USDT",
+						RawPrice:       big.NewFloat(70_000),
+						Status:         types.ProviderPriceMissingIndexPrice,
+					},
+				},
+				MedianPrice:     big.NewFloat(1.15),
+				AggregatedPrice: big.NewFloat(1.15),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			// Update the price aggregator with relevant data.
+			tc.malleate(m)
+
+			// Aggregate the data.
+			m.AggregatePrices()
+
+			// Ensure that the price details are as expected.
+			details, ok := m.GetPriceDetails()[tc.ticker]
+			require.True(t, ok)
+			require.Len(t, details.Providers, len(tc.expectedDetails.Providers))
+			for i, expected := range tc.expectedDetails.Providers {
+				actual := details.Providers[i]
+				require.Equal(t, expected.Provider, actual.Provider)
+				require.Equal(t, expected.OffChainTicker, actual.OffChainTicker)
+				require.Equal(t, expected.Timestamp, actual.Timestamp)
+				require.Equal(t, expected.Status, actual.Status)
+				requireFloatEqual(t, expected.RawPrice, actual.RawPrice)
+				requireFloatEqual(t, expected.ConvertedPrice, actual.ConvertedPrice)
+			}
+
+			requireFloatEqual(t, tc.expectedDetails.MedianPrice, details.MedianPrice)
+			requireFloatEqual(t, tc.expectedDetails.AggregatedPrice, details.AggregatedPrice)
+		})
+	}
+}
+
+func TestGetPriceDetailsDoesNotShareProviderPrices(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	price := big.NewFloat(1.1)
+	m.SetProviderPrices(coinbase.Name, types.Prices{"USDT-USD": price})
+	m.SetProviderPrices(binance.Name, types.Prices{"USDTUSD": big.NewFloat(1.2)})
+	m.AggregatePrices()
+
+	details, ok := m.GetPriceDetails()[USDT_USD.String()]
+	require.True(t, ok)
+
+	provider := details.Providers[0]
+	require.Equal(t, types.ProviderPriceUsed, provider.Status)
+	require.NotSame(t, price, provider.RawPrice)
+	require.NotSame(t, price, provider.ConvertedPrice)
+	require.NotSame(t, provider.RawPrice, provider.ConvertedPrice)
+
+	// Changing the converted price must not change the raw or provider price.
+	provider.ConvertedPrice.SetFloat64(2)
+	requireFloatEqual(t, big.NewFloat(1.1), provider.RawPrice)
+	requireFloatEqual(t, big.NewFloat(1.1), price)
+}
+
+func requireFloatEqual(t *testing.T, expected, actual *big.Float) {
+	t.Helper()
+
+	if expected == nil {
+		require.Nil(t, actual)
+		return
+	}
+
+	require.NotNil(t, actual)
+	require.Equal(t, expected.SetPrec(36), new(big.Float).Copy(actual).SetPrec(36))
+}
@@ -30,10 +30,15 @@ func (m *IndexPriceAggregator) GetProviderPrice(
 	}
 
 	if cfg.Invert {
+		if price.Sign() == 0 {
+			return nil, fmt.Errorf("cannot invert zero %s price for ticker: %s", cfg.Name, cfg.OffChainTicker)
+		}
+
 		return new(big.Float).Quo(big.NewFloat(1), price), nil
 	}
 
-	return price, nil
+	// Copy the price such that converting it does not modify the provider's price.
+	return new(big.Float).Set(price), nil
 }
 
 // GetIndexPrice returns the relevant index price. Note that the aggregator's
@@ -74,12 +79,21 @@ func (m *IndexPriceAggregator) GetIndexPrices() types.Prices {
 	return cpy
 }
 
-// UpdateMarketMap updates the market map for the oracle.
-func (m *IndexPriceAggregator) UpdateMarketMap(marketMap mmtypes.MarketMap) {
+// UpdateMarketMap updates the market map for the oracle. The market map is rejected, and the
+// current market map retained, if the aggregation configuration of any market is invalid.
+func (m *IndexPriceAggregator) UpdateMarketMap(marketMap mmtypes.MarketMap) error {
+	metadata, err := parseMarketMapMetadata(marketMap)
+	if err != nil {
+		return err
+	}
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.cfg = marketMap
+	m.metadata = metadata
+
+	return nil
 }
 
 // GetMarketMap returns the market map for the oracle.
@@ -108,6 +122,8 @@ func (m *IndexPriceAggregator) Reset() {
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerMarketData = make(map[string]types.TickerMarketData)
+	m.providerTimestamps = make(map[string]types.ProviderTimestamps)
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -122,3 +138,16 @@ func (m *IndexPriceAggregator) GetPrices() types.Prices {
 
 	return cpy
 }
+
+// GetPriceDispersion returns the dispersion of the prices used to calculate each index price, i.e. how
+// much the providers disagreed. Specifically, this is the standard deviation of the converted prices,
+// scaled by the respective ticker's decimals such that it is comparable to the price.
+func (m *IndexPriceAggregator) GetPriceDispersion() types.Prices {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.Prices)
+	maps.Copy(cpy, m.dispersion)
+
+	return cpy
+}
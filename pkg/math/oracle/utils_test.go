@@ -78,6 +78,24 @@ func TestGetProviderPrice(t *testing.T) {
 		require.Equal(t, big.NewFloat(0.01).SetPrec(18), price.SetPrec(18))
 	})
 
+	t.Run("provider price is zero, invert is true", func(t *testing.T) {
+		agg, err := oracle.NewIndexPriceAggregator(logger, marketmap, nil)
+		require.NoError(t, err)
+
+		cfg := mmtypes.ProviderConfig{
+			Name:           "test",
+			OffChainTicker: "BTC/USD",
+			Invert:         true,
+		}
+		prices := types.Prices{
+			"BTC/USD": big.NewFloat(0),
+		}
+		agg.SetProviderPrices("test", prices)
+
+		_, err = agg.GetProviderPrice(cfg)
+		require.Error(t, err)
+	})
+
 	t.Run("provider price is nil", func(t *testing.T) {
 		agg, err := oracle.NewIndexPriceAggregator(logger, marketmap, nil)
 		require.NoError(t, err)
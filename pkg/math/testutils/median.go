@@ -1,6 +1,7 @@
 package testutils
 
 import (
+	"maps"
 	"math/big"
 	"sync"
 
@@ -39,7 +40,15 @@ func (m *MedianAggregator) SetProviderPrices(provider string, data types.Prices)
 	m.providerPrices[provider] = data
 }
 
-func (m *MedianAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {}
+// SetProviderMarketData is a no-op since the median aggregator does not aggregate market data.
+func (m *MedianAggregator) SetProviderMarketData(_ string, _ types.TickerMarketData) {}
+
+// SetProviderTimestamps is a no-op since the median aggregator does not report price details.
+func (m *MedianAggregator) SetProviderTimestamps(_ string, _ types.ProviderTimestamps) {}
+
+func (m *MedianAggregator) UpdateMarketMap(_ mmtypes.MarketMap) error {
+	return nil
+}
 
 // AggregatePrices inputs the aggregated prices from all providers and computes
 // the median price for each asset.
@@ -85,6 +94,44 @@ func (m *MedianAggregator) GetPrices() types.Prices {
 	return m.finalPrices
 }
 
+// GetPriceDispersion returns an empty set of price dispersion since the median aggregator does not
+// calculate the dispersion of prices.
+func (m *MedianAggregator) GetPriceDispersion() types.Prices {
+	return make(types.Prices)
+}
+
+// GetMarketData returns an empty set of market data since the median aggregator does not
+// aggregate market data.
+func (m *MedianAggregator) GetMarketData() types.TickerMarketData {
+	return make(types.TickerMarketData)
+}
+
+// GetPriceDetails returns an empty set of price details since the median aggregator does not
+// report price details.
+func (m *MedianAggregator) GetPriceDetails() types.TickerPriceDetails {
+	return make(types.TickerPriceDetails)
+}
+
+// SetIndexPrices sets the aggregated prices. The median aggregator does not distinguish between
+// index prices and aggregated prices.
+func (m *MedianAggregator) SetIndexPrices(prices types.Prices) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.finalPrices = prices
+}
+
+// GetIndexPrices returns a copy of the aggregated prices.
+func (m *MedianAggregator) GetIndexPrices() types.Prices {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.Prices, len(m.finalPrices))
+	maps.Copy(cpy, m.finalPrices)
+
+	return cpy
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *MedianAggregator) Reset() {
 	m.mtx.Lock()
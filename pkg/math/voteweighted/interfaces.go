@@ -19,6 +19,15 @@ type ValidatorStore interface {
 	TotalBondedTokens(ctx context.Context) (math.Int, error)
 }
 
+// PowerThresholdStore defines the interface contract required for retrieving the total voting
+// power % that must be submitted for the price of a given currency pair to be written to state.
+// False is returned if no threshold is configured for the currency pair.
+//
+//go:generate mockery --name PowerThresholdStore --filename mock_power_threshold_store.go
+type PowerThresholdStore interface {
+	GetPowerThreshold(ctx sdk.Context, tickerStr string) (math.LegacyDec, bool, error)
+}
+
 // CCValidatorStore defines the interface contract required for the cross chain validator consumer store.
 //
 //go:generate mockery --name CCValidatorStore --filename mock_cc_validator_store.go
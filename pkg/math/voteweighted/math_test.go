@@ -2,6 +2,7 @@ package voteweighted_test
 
 import (
 	"crypto"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -290,9 +291,9 @@ func (s *MathTestSuite) TestMedian() {
 			ccvConsumerCompatKeeper := s.createMockCCVConsumerCompatKeeper(tc.validators)
 
 			// Compute the stake weighted median for both staking keeper based and ICS based val stores.
-			defaultAggregateFn := voteweighted.Median(s.ctx, log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold)
+			defaultAggregateFn := voteweighted.Median(s.ctx, log.NewTestLogger(s.T()), mockValidatorStore, nil, voteweighted.DefaultPowerThreshold)
 			defaultResult := defaultAggregateFn(tc.providerPrices)
-			ccvAggregateFn := voteweighted.Median(s.ctx, log.NewTestLogger(s.T()), ccvConsumerCompatKeeper, voteweighted.DefaultPowerThreshold)
+			ccvAggregateFn := voteweighted.Median(s.ctx, log.NewTestLogger(s.T()), ccvConsumerCompatKeeper, nil, voteweighted.DefaultPowerThreshold)
 			ccvResult := ccvAggregateFn(tc.providerPrices)
 
 			// Verify the results.
@@ -306,6 +307,117 @@ func (s *MathTestSuite) TestMedian() {
 	}
 }
 
+func (s *MathTestSuite) TestMedianWithPowerThresholds() {
+	btcusd := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethusd := slinkytypes.NewCurrencyPair("ETH", "USD")
+	solusd := slinkytypes.NewCurrencyPair("SOL", "USD")
+
+	providerPrices := aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validator1.String(): map[slinkytypes.CurrencyPair]*big.Int{
+			btcusd: big.NewInt(100),
+			ethusd: big.NewInt(200),
+			solusd: big.NewInt(300),
+		},
+		validator2.String(): map[slinkytypes.CurrencyPair]*big.Int{
+			btcusd: big.NewInt(100),
+			ethusd: big.NewInt(200),
+		},
+		validator3.String(): map[slinkytypes.CurrencyPair]*big.Int{
+			btcusd: big.NewInt(100),
+		},
+	}
+
+	validators := []validator{
+		{
+			stake:    sdkmath.NewInt(33),
+			consAddr: validator1,
+		},
+		{
+			stake:    sdkmath.NewInt(33),
+			consAddr: validator2,
+		},
+		{
+			stake:    sdkmath.NewInt(33),
+			consAddr: validator3,
+		},
+	}
+
+	// BTC/USD does not configure a threshold so the default threshold applies, ETH/USD lowers the
+	// threshold so that 2/3 of the voting power is sufficient, and SOL/USD has no market so the
+	// default threshold applies.
+	thresholdStore := mocks.NewPowerThresholdStore(s.T())
+	thresholdStore.On("GetPowerThreshold", s.ctx, btcusd.String()).Return(sdkmath.LegacyDec{}, false, nil)
+	thresholdStore.On("GetPowerThreshold", s.ctx, ethusd.String()).Return(sdkmath.LegacyNewDecWithPrec(5, 1), true, nil)
+	thresholdStore.On("GetPowerThreshold", s.ctx, solusd.String()).Return(sdkmath.LegacyDec{}, false, fmt.Errorf("market not found"))
+
+	aggregateFn := voteweighted.Median(
+		s.ctx,
+		log.NewTestLogger(s.T()),
+		s.createMockValidatorStore(validators, sdkmath.NewInt(99)),
+		thresholdStore,
+		voteweighted.DefaultPowerThreshold,
+	)
+
+	expectedPrices := map[slinkytypes.CurrencyPair]*big.Int{
+		btcusd: big.NewInt(100),
+		ethusd: big.NewInt(200),
+	}
+	s.Require().Equal(expectedPrices, aggregateFn(providerPrices))
+}
+
+func (s *MathTestSuite) TestMedianWithPowerThresholdsUsesConfiguredDefault() {
+	btcusd := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethusd := slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	providerPrices := aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validator1.String(): map[slinkytypes.CurrencyPair]*big.Int{
+			btcusd: big.NewInt(100),
+			ethusd: big.NewInt(200),
+		},
+		validator2.String(): map[slinkytypes.CurrencyPair]*big.Int{
+			btcusd: big.NewInt(100),
+			ethusd: big.NewInt(200),
+		},
+		validator3.String(): map[slinkytypes.CurrencyPair]*big.Int{
+			btcusd: big.NewInt(100),
+		},
+	}
+
+	validators := []validator{
+		{
+			stake:    sdkmath.NewInt(33),
+			consAddr: validator1,
+		},
+		{
+			stake:    sdkmath.NewInt(33),
+			consAddr: validator2,
+		},
+		{
+			stake:    sdkmath.NewInt(33),
+			consAddr: validator3,
+		},
+	}
+
+	// Neither market configures a threshold, so the application's default threshold of 90%
+	// applies rather than the x/marketmap default. ETH/USD only has 2/3 of the voting power.
+	thresholdStore := mocks.NewPowerThresholdStore(s.T())
+	thresholdStore.On("GetPowerThreshold", s.ctx, btcusd.String()).Return(sdkmath.LegacyDec{}, false, nil)
+	thresholdStore.On("GetPowerThreshold", s.ctx, ethusd.String()).Return(sdkmath.LegacyDec{}, false, nil)
+
+	aggregateFn := voteweighted.Median(
+		s.ctx,
+		log.NewTestLogger(s.T()),
+		s.createMockValidatorStore(validators, sdkmath.NewInt(99)),
+		thresholdStore,
+		sdkmath.LegacyNewDecWithPrec(9, 1),
+	)
+
+	expectedPrices := map[slinkytypes.CurrencyPair]*big.Int{
+		btcusd: big.NewInt(100),
+	}
+	s.Require().Equal(expectedPrices, aggregateFn(providerPrices))
+}
+
 func (s *MathTestSuite) TestComputeMedian() {
 	cases := []struct {
 		name      string
@@ -10,13 +10,14 @@ import (
 
 	"github.com/skip-mev/slinky/aggregator"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	marketmaptypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
 
 // DefaultPowerThreshold defines the total voting power % that must be
 // submitted in order for a currency pair to be considered for the
 // final oracle price. We provide a default supermajority threshold
-// of 2/3+.
-var DefaultPowerThreshold = math.LegacyNewDecWithPrec(667, 3)
+// of 2/3+, which matches the default threshold of markets in x/marketmap.
+var DefaultPowerThreshold = marketmaptypes.DefaultPowerThreshold
 
 type (
 	// VoteWeightPriceInfo tracks the stake weight(s) + price(s) for a given currency pair.
@@ -33,14 +34,18 @@ type (
 )
 
 // MedianFromContext returns a new Median aggregate function that is parametrized by the
-// latest state of the application.
+// latest state of the application. The power threshold of each currency pair is read from
+// the threshold store (i.e. x/marketmap). The default threshold is used for currency pairs
+// that do not configure a threshold or that the threshold store does not know about, or for
+// all currency pairs if the threshold store is nil.
 func MedianFromContext(
 	logger log.Logger,
 	validatorStore ValidatorStore,
-	threshold math.LegacyDec,
+	thresholdStore PowerThresholdStore,
+	defaultThreshold math.LegacyDec,
 ) aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int] {
 	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
-		return Median(ctx, logger, validatorStore, threshold)
+		return Median(ctx, logger, validatorStore, thresholdStore, defaultThreshold)
 	}
 }
 
@@ -51,7 +56,8 @@ func MedianFromContext(
 //  1. Price updates for a given currency pair will only be written to state if the power % threshold
 //     is met. The threshold is determined by the total voting power of all validators that
 //     submitted a price update for a given currency pair divided by the total network voting power. The threshold
-//     to meet is configurable by developers.
+//     to meet is configurable per currency pair via the threshold store, and defaults to the threshold configured
+//     by developers.
 //  2. In the case where there are not enough price updates for a given currency pair, the
 //     price will not be included in the final set of oracle prices.
 //  3. Given the threshold is met, the final oracle price for a given currency pair is the
@@ -60,7 +66,8 @@ func Median(
 	ctx sdk.Context,
 	logger log.Logger,
 	validatorStore ValidatorStore,
-	threshold math.LegacyDec,
+	thresholdStore PowerThresholdStore,
+	defaultThreshold math.LegacyDec,
 ) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
 	return func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
 		priceInfo := make(map[slinkytypes.CurrencyPair]PriceInfo)
@@ -134,6 +141,8 @@ func Median(
 		}
 
 		for currencyPair, info := range priceInfo {
+			threshold := getPowerThreshold(ctx, logger, thresholdStore, currencyPair, defaultThreshold)
+
 			// The total voting power % that submitted a price update for the given currency pair must be
 			// greater than the threshold to be included in the final oracle price.
 			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
@@ -162,6 +171,39 @@ func Median(
 	}
 }
 
+// getPowerThreshold returns the power threshold of the given currency pair. The default threshold
+// is returned if the threshold store is nil, or if the currency pair does not configure a threshold
+// or the threshold store cannot return it.
+func getPowerThreshold(
+	ctx sdk.Context,
+	logger log.Logger,
+	thresholdStore PowerThresholdStore,
+	currencyPair slinkytypes.CurrencyPair,
+	defaultThreshold math.LegacyDec,
+) math.LegacyDec {
+	if thresholdStore == nil {
+		return defaultThreshold
+	}
+
+	threshold, found, err := thresholdStore.GetPowerThreshold(ctx, currencyPair.String())
+	if err != nil {
+		logger.Debug(
+			"failed to retrieve power threshold for currency pair; using default threshold",
+			"currency_pair", currencyPair.String(),
+			"default_threshold", defaultThreshold.String(),
+			"err", err,
+		)
+
+		return defaultThreshold
+	}
+
+	if !found {
+		return defaultThreshold
+	}
+
+	return threshold
+}
+
 // ComputeMedian computes the stake-weighted median price for a given asset.
 func ComputeMedian(priceInfo PriceInfo) *big.Int {
 	// Sort the prices by price.
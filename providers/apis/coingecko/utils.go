@@ -55,6 +55,10 @@ var DefaultAPIConfig = config.APIConfig{
 	ReconnectTimeout: 2000 * time.Millisecond,
 	MaxQueries:       1,
 	Endpoints:        []config.Endpoint{{URL: URL}},
+	// The public CoinGecko API allows for roughly 30 requests per minute.
+	RateLimit: config.RateLimitConfig{
+		RequestsPerSecond: 0.5,
+	},
 }
 
 type (
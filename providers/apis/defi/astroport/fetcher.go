@@ -0,0 +1,264 @@
+package astroport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
+
+// PriceFetcher is the Astroport price fetcher. This fetcher is responsible for querying the assets
+// held by Astroport pair contracts via cosmwasm smart queries over REST. The pair to
+// query for each ticker is configured in the ticker's metadata JSON.
+//
+// If multiple endpoints are configured, each query is sent to all of them and the response served
+// at the highest block height is used.
+type PriceFetcher struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// client is the cosmos REST client used to query the chain the Astroport pairs are deployed on.
+	client cosmosclient.CosmosRESTClient
+
+	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	poolCache map[types.ProviderTicker]PoolConfig
+	mtx       sync.Mutex
+}
+
+// NewPriceFetcher returns a new Astroport price fetcher.
+func NewPriceFetcher(
+	logger *zap.Logger,
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+) (*PriceFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if api.Name != Name {
+		return nil, fmt.Errorf("invalid api name; expected %s, got %s", Name, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	var (
+		client cosmosclient.CosmosRESTClient
+		err    error
+	)
+	switch {
+	case len(api.Endpoints) > 1:
+		client, err = cosmosclient.NewMultiRESTClientFromEndpoints(logger, api, apiMetrics)
+	case len(api.Endpoints) == 1:
+		client, err = cosmosclient.NewRESTClientImpl(apiMetrics, api, 0)
+	default:
+		err = fmt.Errorf("no endpoints were provided")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceFetcherWithClient(logger, api, client)
+}
+
+// NewPriceFetcherWithClient returns a new PriceFetcher.
+// It requires a pre-validated config, and initialized client.
+func NewPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client cosmosclient.CosmosRESTClient,
+) (*PriceFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+
+	return &PriceFetcher{
+		logger:    logger.With(zap.String("fetcher", api.Name)),
+		api:       api,
+		client:    client,
+		poolCache: make(map[types.ProviderTicker]PoolConfig),
+	}, nil
+}
+
+// Fetch returns the price of a given set of tickers. The pool of each ticker's pair contract is
+// queried concurrently.
+func (pf *PriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []types.ProviderTicker,
+) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	pools := make([]PoolConfig, len(tickers))
+	for i, ticker := range tickers {
+		pool, err := pf.GetPool(ticker)
+		if err != nil {
+			pf.logger.Debug(
+				"failed to get pool for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get pool: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			)
+		}
+		pools[i] = pool
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pf.api.Timeout)
+	defer cancel()
+
+	prices := make([]*big.Float, len(tickers))
+	errs := make([]providertypes.ErrorWithCode, len(tickers))
+
+	wg := new(sync.WaitGroup)
+	for i := range tickers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			prices[i], errs[i] = pf.fetchPrice(ctx, pools[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for i, ticker := range tickers {
+		if prices[i] == nil {
+			pf.logger.Debug(
+				"failed to fetch price for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(errs[i]),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: errs[i],
+			}
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(prices[i], time.Now().UTC())
+	}
+
+	return types.NewPriceResponse(resolved, unResolved)
+}
+
+// fetchPrice queries the pool of the given pair contract and derives the price of the base asset
+// from it.
+func (pf *PriceFetcher) fetchPrice(
+	ctx context.Context,
+	pool PoolConfig,
+) (*big.Float, providertypes.ErrorWithCode) {
+	resp, err := pf.client.Get(ctx, pool.PoolQueryPath())
+	if err != nil {
+		return nil, providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral)
+	}
+
+	price, err := ParsePoolPrice(pool, resp.Body)
+	if err != nil {
+		return nil, providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice)
+	}
+
+	return price, providertypes.ErrorWithCode{}
+}
+
+// GetPool returns the Astroport pair for the given ticker. This will unmarshal the metadata
+// and validate the pool config which contains all required information to query the chain.
+func (pf *PriceFetcher) GetPool(
+	ticker types.ProviderTicker,
+) (PoolConfig, error) {
+	pf.mtx.Lock()
+	defer pf.mtx.Unlock()
+
+	if pool, ok := pf.poolCache[ticker]; ok {
+		return pool, nil
+	}
+
+	var cfg PoolConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal pool config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker pool config: %w", err)
+	}
+
+	pf.poolCache[ticker] = cfg
+	return cfg, nil
+}
+
+// ParsePoolPrice parses the assets held by a pair from the body of a pool query response and
+// returns the price of the base asset in terms of the quote asset. The price is the ratio of the
+// pair's reserves, normalized by the decimals of the assets. This is equivalent to:
+//
+// price = (quoteAmount / 10^quoteDecimals) / (baseAmount / 10^baseDecimals).
+//
+// Note that this is the marginal price of constant-product (xyk) pairs. For stableswap or
+// concentrated liquidity pairs the reserve ratio only approximates the price.
+func ParsePoolPrice(pool PoolConfig, body []byte) (*big.Float, error) {
+	var resp PoolResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pool response: %w", err)
+	}
+
+	var baseAmount, quoteAmount *big.Int
+	for _, asset := range resp.Data.Assets {
+		amount, ok := new(big.Int).SetString(asset.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse amount %s of asset %s", asset.Amount, asset.Identifier())
+		}
+
+		switch asset.Identifier() {
+		case pool.BaseAsset:
+			baseAmount = amount
+		case pool.QuoteAsset:
+			quoteAmount = amount
+		}
+	}
+
+	if baseAmount == nil || quoteAmount == nil {
+		return nil, fmt.Errorf("pair does not hold both %s and %s", pool.BaseAsset, pool.QuoteAsset)
+	}
+
+	if baseAmount.Sign() <= 0 || quoteAmount.Sign() <= 0 {
+		return nil, fmt.Errorf("pair reserves must be positive, got %s and %s", baseAmount, quoteAmount)
+	}
+
+	price := new(big.Float).Quo(
+		new(big.Float).SetInt(quoteAmount),
+		new(big.Float).SetInt(baseAmount),
+	)
+
+	return new(big.Float).Mul(price, math.GetScalingFactor(pool.BaseDecimals, pool.QuoteDecimals)), nil
+}
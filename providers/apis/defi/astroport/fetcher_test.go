@@ -0,0 +1,175 @@
+package astroport_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/astroport"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient/mocks"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+var (
+	// ntrnusdcCfg is the pool config of a NTRN/USDC pair holding two native tokens.
+	ntrnusdcCfg = astroport.PoolConfig{
+		PairAddress:   "neutron1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqwxjww5",
+		BaseAsset:     "untrn",
+		QuoteAsset:    "ibc/B559A80D62249C8AA07A380E2A2BEA6E5CA9A6F079C912C3A9E9B494105E4F81",
+		BaseDecimals:  6,
+		QuoteDecimals: 6,
+	}
+	ntrnusdcTicker = types.NewProviderTicker("NTRN/USDC", ntrnusdcCfg.MustToJSON())
+
+	// astrontrnCfg is the pool config of an ASTRO/NTRN pair holding a cw20 token.
+	astrontrnCfg = astroport.PoolConfig{
+		PairAddress:   "neutron1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsm86km8",
+		BaseAsset:     "neutron1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqwxjww5",
+		QuoteAsset:    "untrn",
+		BaseDecimals:  18,
+		QuoteDecimals: 6,
+	}
+	astrontrnTicker = types.NewProviderTicker("ASTRO/NTRN", astrontrnCfg.MustToJSON())
+
+	// ntrnusdcPool is a pool response of the NTRN/USDC pair holding 1,000,000 USDC and 2,000,000 NTRN.
+	ntrnusdcPool = `{"data":{"assets":[
+		{"info":{"native_token":{"denom":"ibc/B559A80D62249C8AA07A380E2A2BEA6E5CA9A6F079C912C3A9E9B494105E4F81"}},"amount":"1000000000000"},
+		{"info":{"native_token":{"denom":"untrn"}},"amount":"2000000000000"}
+	],"total_share":"1414213562373"}}`
+
+	// astrontrnPool is a pool response of the ASTRO/NTRN pair holding 4,000 ASTRO and 1,000 NTRN.
+	astrontrnPool = `{"data":{"assets":[
+		{"info":{"token":{"contract_addr":"neutron1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqwxjww5"}},"amount":"4000000000000000000000"},
+		{"info":{"native_token":{"denom":"untrn"}},"amount":"1000000000"}
+	],"total_share":"2000000000"}}`
+)
+
+func TestNewPriceFetcher(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		_, err := astroport.NewPriceFetcher(zap.NewNop(), metrics.NewNopAPIMetrics(), astroport.DefaultAPIConfig)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		cfg := astroport.DefaultAPIConfig
+		cfg.Name = "invalid"
+
+		_, err := astroport.NewPriceFetcher(zap.NewNop(), metrics.NewNopAPIMetrics(), cfg)
+		require.Error(t, err)
+	})
+}
+
+func TestFetch(t *testing.T) {
+	testCases := []struct {
+		name               string
+		tickers            []types.ProviderTicker
+		client             func() cosmosclient.CosmosRESTClient
+		expected           map[types.ProviderTicker]*big.Float
+		expectedUnresolved []types.ProviderTicker
+	}{
+		{
+			name: "ticker without a pool config",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("NTRN/USDC", ""),
+			},
+			client: func() cosmosclient.CosmosRESTClient {
+				return mocks.NewCosmosRESTClient(t)
+			},
+			expectedUnresolved: []types.ProviderTicker{types.NewProviderTicker("NTRN/USDC", "")},
+		},
+		{
+			name:    "query fails",
+			tickers: []types.ProviderTicker{ntrnusdcTicker},
+			client: func() cosmosclient.CosmosRESTClient {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, ntrnusdcCfg.PoolQueryPath()).Return(nil, fmt.Errorf("query failed"))
+				return c
+			},
+			expectedUnresolved: []types.ProviderTicker{ntrnusdcTicker},
+		},
+		{
+			name:    "pair does not hold the quote asset",
+			tickers: []types.ProviderTicker{ntrnusdcTicker},
+			client: func() cosmosclient.CosmosRESTClient {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, ntrnusdcCfg.PoolQueryPath()).Return(
+					&cosmosclient.Response{Body: []byte(astrontrnPool)}, nil,
+				)
+				return c
+			},
+			expectedUnresolved: []types.ProviderTicker{ntrnusdcTicker},
+		},
+		{
+			name:    "native and cw20 pairs",
+			tickers: []types.ProviderTicker{ntrnusdcTicker, astrontrnTicker},
+			client: func() cosmosclient.CosmosRESTClient {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, ntrnusdcCfg.PoolQueryPath()).Return(
+					&cosmosclient.Response{Height: 10, Body: []byte(ntrnusdcPool)}, nil,
+				)
+				c.On("Get", mock.Anything, astrontrnCfg.PoolQueryPath()).Return(
+					&cosmosclient.Response{Height: 10, Body: []byte(astrontrnPool)}, nil,
+				)
+				return c
+			},
+			expected: map[types.ProviderTicker]*big.Float{
+				ntrnusdcTicker:  big.NewFloat(0.5),
+				astrontrnTicker: big.NewFloat(0.25),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher, err := astroport.NewPriceFetcherWithClient(zap.NewNop(), astroport.DefaultAPIConfig, tc.client())
+			require.NoError(t, err)
+
+			resp := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Len(t, resp.Resolved, len(tc.expected))
+			require.Len(t, resp.UnResolved, len(tc.expectedUnresolved))
+
+			for ticker, price := range tc.expected {
+				require.Contains(t, resp.Resolved, ticker)
+				require.Equal(t, price.SetPrec(18), resp.Resolved[ticker].Value.SetPrec(18))
+			}
+
+			for _, ticker := range tc.expectedUnresolved {
+				require.Contains(t, resp.UnResolved, ticker)
+				require.Error(t, resp.UnResolved[ticker])
+			}
+		})
+	}
+}
+
+func TestPoolConfig(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		require.NoError(t, ntrnusdcCfg.ValidateBasic())
+	})
+
+	t.Run("invalid pair address", func(t *testing.T) {
+		cfg := ntrnusdcCfg
+		cfg.PairAddress = "0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("missing base asset", func(t *testing.T) {
+		cfg := ntrnusdcCfg
+		cfg.BaseAsset = ""
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("query path encodes the pool query", func(t *testing.T) {
+		require.Equal(
+			t,
+			"/cosmwasm/wasm/v1/contract/neutron1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqwxjww5/smart/eyJwb29sIjp7fX0=",
+			ntrnusdcCfg.PoolQueryPath(),
+		)
+	})
+}
@@ -0,0 +1,139 @@
+package astroport
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+const (
+	// Name is the name of the Astroport API.
+	Name = "astroport_api"
+
+	// URL is the default REST (LCD) endpoint for the Astroport API. This uses a free public
+	// endpoint on Neutron mainnet.
+	URL = "https://rest-kralum.neutron-1.neutron.org"
+
+	// SmartQueryPath is the path of a cosmwasm smart contract query. The contract address and the
+	// base64 encoded query must be filled in, in that order.
+	SmartQueryPath = "/cosmwasm/wasm/v1/contract/%s/smart/%s"
+
+	// PoolQuery is the Astroport pair contract query that returns the assets held by the pair.
+	PoolQuery = `{"pool":{}}`
+)
+
+// PoolConfig is the configuration for an Astroport pair. This is specific to each pair of assets
+// and is expected to be set as the metadata JSON of the ticker.
+type PoolConfig struct {
+	// PairAddress is the address of the Astroport pair contract.
+	PairAddress string `json:"pair_address"`
+	// BaseAsset is the identifier of the base asset. This is either the denom of a native token or
+	// the contract address of a cw20 token.
+	BaseAsset string `json:"base_asset"`
+	// QuoteAsset is the identifier of the quote asset. This is either the denom of a native token
+	// or the contract address of a cw20 token.
+	QuoteAsset string `json:"quote_asset"`
+	// BaseDecimals is the number of decimals of the base asset.
+	BaseDecimals int64 `json:"base_decimals"`
+	// QuoteDecimals is the number of decimals of the quote asset.
+	QuoteDecimals int64 `json:"quote_decimals"`
+}
+
+// ValidateBasic validates the pool configuration.
+func (pc *PoolConfig) ValidateBasic() error {
+	if _, _, err := bech32.DecodeAndConvert(pc.PairAddress); err != nil {
+		return fmt.Errorf("pair address is not a valid bech32 address: %w", err)
+	}
+
+	if len(strings.TrimSpace(pc.BaseAsset)) == 0 {
+		return fmt.Errorf("base asset must be set")
+	}
+
+	if len(strings.TrimSpace(pc.QuoteAsset)) == 0 {
+		return fmt.Errorf("quote asset must be set")
+	}
+
+	if pc.BaseAsset == pc.QuoteAsset {
+		return fmt.Errorf("base and quote asset must be different")
+	}
+
+	if pc.BaseDecimals < 0 {
+		return fmt.Errorf("base decimals must be non-negative")
+	}
+
+	if pc.QuoteDecimals < 0 {
+		return fmt.Errorf("quote decimals must be non-negative")
+	}
+
+	return nil
+}
+
+// MustToJSON converts the pool configuration to JSON.
+func (pc PoolConfig) MustToJSON() string {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// PoolQueryPath returns the path of the pool query for the pair contract.
+func (pc PoolConfig) PoolQueryPath() string {
+	return fmt.Sprintf(
+		SmartQueryPath,
+		pc.PairAddress,
+		base64.StdEncoding.EncodeToString([]byte(PoolQuery)),
+	)
+}
+
+// PoolResponse is the response of a smart query of the pool of an Astroport pair contract.
+type PoolResponse struct {
+	Data struct {
+		// Assets are the assets held by the pair.
+		Assets []Asset `json:"assets"`
+	} `json:"data"`
+}
+
+// Asset is an amount of a native or cw20 token held by an Astroport pair.
+type Asset struct {
+	Info struct {
+		NativeToken *struct {
+			Denom string `json:"denom"`
+		} `json:"native_token,omitempty"`
+		Token *struct {
+			ContractAddr string `json:"contract_addr"`
+		} `json:"token,omitempty"`
+	} `json:"info"`
+	Amount string `json:"amount"`
+}
+
+// Identifier returns the denom of a native token or the contract address of a cw20 token.
+func (a Asset) Identifier() string {
+	switch {
+	case a.Info.NativeToken != nil:
+		return a.Info.NativeToken.Denom
+	case a.Info.Token != nil:
+		return a.Info.Token.ContractAddr
+	default:
+		return ""
+	}
+}
+
+// DefaultAPIConfig is the default configuration for the Astroport API.
+var DefaultAPIConfig = config.APIConfig{
+	Name:             Name,
+	Atomic:           false,
+	Enabled:          true,
+	Timeout:          2000 * time.Millisecond,
+	Interval:         1000 * time.Millisecond,
+	ReconnectTimeout: 2000 * time.Millisecond,
+	MaxQueries:       1,
+	BatchSize:        10,
+	Endpoints:        []config.Endpoint{{URL: URL}},
+}
@@ -0,0 +1,429 @@
+package chainlink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink/aggregator"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
+
+// PriceFetcher is the Chainlink price fetcher. This fetcher is responsible for querying Chainlink
+// AggregatorV3 contracts (price feeds) and returning the price of a given ticker. The price is the
+// answer of the latest round of the feed, scaled to the decimals of the feed.
+//
+// To read more about Chainlink price feeds, see the Chainlink documentation
+// https://docs.chain.link/data-feeds/api-reference.
+//
+// Like the Uniswap price fetchers, we utilize the eth client's BatchCallContext to batch the
+// calls to the ethereum network.
+type PriceFetcher struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// client is the EVM client implementation. This is used to interact with the ethereum network.
+	client ethmulticlient.EVMClient
+	// abi is the aggregator v3 abi. This is used to pack the calls to the feed contract and parse
+	// the results.
+	abi *abi.ABI
+	// payload is the packed latestRoundData call to the feed contract. Since the payload is the
+	// same for all feeds, we can reuse this payload for all feeds.
+	payload []byte
+	// decimalsPayload is the packed decimals call to the feed contract.
+	decimalsPayload []byte
+	// feedCache is a cache of the tickers to feed configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	feedCache map[types.ProviderTicker]FeedConfig
+	// decimalsCache is a cache of the feed addresses to the decimals of the feed. The decimals of
+	// a feed never change, so they are only queried once per feed.
+	decimalsCache map[string]uint8
+}
+
+// NewPriceFetcher returns a new Chainlink price fetcher.
+func NewPriceFetcher(
+	ctx context.Context,
+	logger *zap.Logger,
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+) (*PriceFetcher, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("context cannot be nil")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("invalid api config name %s", api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	var (
+		client ethmulticlient.EVMClient
+		err    error
+	)
+	switch {
+	case len(api.Endpoints) > 1:
+		client, err = ethmulticlient.NewMultiRPCClientFromEndpoints(
+			ctx,
+			logger,
+			api,
+			apiMetrics,
+		)
+	case len(api.Endpoints) == 1:
+		client, err = ethmulticlient.NewGoEthereumClientImpl(
+			ctx,
+			apiMetrics,
+			api,
+			0,
+		)
+	default:
+		err = fmt.Errorf("no endpoints were provided")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceFetcherWithClient(
+		logger,
+		api,
+		client,
+	)
+}
+
+// NewPriceFetcherWithClient returns a new PriceFetcher.
+// It requires a pre-validated config, and initialized client.
+func NewPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client ethmulticlient.EVMClient,
+) (*PriceFetcher, error) {
+	abi, err := aggregator.AggregatorV3MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get aggregator v3 abi: %w", err)
+	}
+
+	payload, err := abi.Pack(ContractMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack latestRoundData: %w", err)
+	}
+
+	decimalsPayload, err := abi.Pack(DecimalsContractMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack decimals: %w", err)
+	}
+
+	return &PriceFetcher{
+		logger:          logger.With(zap.String("fetcher", api.Name)),
+		api:             api,
+		client:          client,
+		abi:             abi,
+		payload:         payload,
+		decimalsPayload: decimalsPayload,
+		feedCache:       make(map[types.ProviderTicker]FeedConfig),
+		decimalsCache:   make(map[string]uint8),
+	}, nil
+}
+
+// Fetch returns the price of a given set of tickers. This fetch utilizes the batch call to lower
+// overhead of making individual RPC calls for each ticker. The fetcher will query the latest round
+// of each feed contract, along with the decimals of feeds that have not been queried before.
+// Rounds that are older than the heartbeat of the feed are rejected as stale, such that a feed
+// that stopped updating is not reported with a fresh timestamp.
+func (f *PriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []types.ProviderTicker,
+) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	// Create a batch element for the latest round of each ticker and feed, and a batch element
+	// for the decimals of each feed whose decimals are not cached yet.
+	batchElems := make([]rpc.BatchElem, len(tickers), 2*len(tickers))
+	decimalsElems := make(map[string]int)
+	feeds := make([]FeedConfig, len(tickers))
+	for i, ticker := range tickers {
+		feed, err := f.GetFeed(ticker)
+		if err != nil {
+			f.logger.Debug(
+				"failed to get feed for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get feed: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			)
+		}
+
+		batchElems[i] = f.callElem(feed.Address, f.payload) // latestRoundData call to the feed contract.
+		feeds[i] = feed
+
+		address := feedKey(feed.Address)
+		if _, ok := f.decimalsCache[address]; ok {
+			continue
+		}
+		if _, ok := decimalsElems[address]; !ok {
+			decimalsElems[address] = len(batchElems)
+			batchElems = append(batchElems, f.callElem(feed.Address, f.decimalsPayload))
+		}
+	}
+
+	// Batch call to the EVM.
+	if err := f.client.BatchCallContext(ctx, batchElems); err != nil {
+		f.logger.Debug(
+			"failed to batch call to ethereum network for all tickers",
+			zap.Error(err),
+		)
+
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral),
+		)
+	}
+
+	// Cache the decimals of the feeds that were queried.
+	for address, index := range decimalsElems {
+		result := batchElems[index]
+		if result.Error != nil {
+			f.logger.Debug(
+				"failed to batch call to ethereum network for feed decimals",
+				zap.String("feed", address),
+				zap.Error(result.Error),
+			)
+
+			continue
+		}
+
+		decimals, err := f.ParseDecimals(result.Result)
+		if err != nil {
+			f.logger.Debug(
+				"failed to parse feed decimals",
+				zap.String("feed", address),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		f.decimalsCache[address] = decimals
+	}
+
+	// Parse the result from the batch call for each ticker.
+	now := time.Now().UTC()
+	for i, ticker := range tickers {
+		result := batchElems[i]
+		if result.Error != nil {
+			f.logger.Debug(
+				"failed to batch call to ethereum network for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(result.Error),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					result.Error,
+					providertypes.ErrorUnknown,
+				),
+			}
+
+			continue
+		}
+
+		decimals, ok := f.decimalsCache[feedKey(feeds[i].Address)]
+		if !ok {
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get decimals of feed %s", feeds[i].Address),
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+
+			continue
+		}
+
+		// Parse the latest round from the result.
+		round, err := f.ParseRoundData(result.Result)
+		if err != nil {
+			f.logger.Debug(
+				"failed to parse latest round",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+
+			continue
+		}
+
+		// Calculate the price from the round, rejecting stale rounds.
+		price, err := CalculatePrice(round, decimals, feeds[i].GetHeartbeat(), now)
+		if err != nil {
+			f.logger.Debug(
+				"failed to calculate price from latest round",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			code := providertypes.ErrorFailedToParsePrice
+			if errors.As(err, &StaleRoundError{}) {
+				code = providertypes.ErrorStalePrice
+			}
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, code),
+			}
+
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, now)
+	}
+
+	// Add the price to the resolved prices.
+	return types.NewPriceResponse(resolved, unResolved)
+}
+
+// GetFeed returns the Chainlink feed for the given ticker. This will unmarshal the metadata
+// and validate the feed config which contains all required information to query the EVM.
+func (f *PriceFetcher) GetFeed(
+	ticker types.ProviderTicker,
+) (FeedConfig, error) {
+	if feed, ok := f.feedCache[ticker]; ok {
+		return feed, nil
+	}
+
+	var cfg FeedConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal feed config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker feed config: %w", err)
+	}
+
+	f.feedCache[ticker] = cfg
+	return cfg, nil
+}
+
+// ParseRoundData parses the latest round from the result of the batch call.
+func (f *PriceFetcher) ParseRoundData(
+	result interface{},
+) (RoundData, error) {
+	bz, err := decodeResult(result)
+	if err != nil {
+		return RoundData{}, err
+	}
+
+	out, err := f.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return RoundData{}, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	return RoundData{
+		RoundID:         *abi.ConvertType(out[0], new(*big.Int)).(**big.Int),
+		Answer:          *abi.ConvertType(out[1], new(*big.Int)).(**big.Int),
+		StartedAt:       *abi.ConvertType(out[2], new(*big.Int)).(**big.Int),
+		UpdatedAt:       *abi.ConvertType(out[3], new(*big.Int)).(**big.Int),
+		AnsweredInRound: *abi.ConvertType(out[4], new(*big.Int)).(**big.Int),
+	}, nil
+}
+
+// ParseDecimals parses the decimals of a feed from the result of the batch call.
+func (f *PriceFetcher) ParseDecimals(
+	result interface{},
+) (uint8, error) {
+	bz, err := decodeResult(result)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := f.abi.Methods[DecimalsContractMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	return *abi.ConvertType(out[0], new(uint8)).(*uint8), nil
+}
+
+// callElem returns a batch element that calls the feed contract with the given payload.
+func (f *PriceFetcher) callElem(address string, payload []byte) rpc.BatchElem {
+	var result string
+	return rpc.BatchElem{
+		Method: "eth_call",
+		Args: []interface{}{
+			map[string]interface{}{
+				"to":   common.HexToAddress(address),
+				"data": hexutil.Bytes(payload),
+			},
+			"latest", // latest signifies the latest block.
+		},
+		Result: &result,
+	}
+}
+
+// feedKey returns the key of a feed address in the decimals cache.
+func feedKey(address string) string {
+	return strings.ToLower(address)
+}
+
+// decodeResult decodes the hex encoded result of a batch call.
+func decodeResult(
+	result interface{},
+) ([]byte, error) {
+	r, ok := result.(*string)
+	if !ok {
+		return nil, fmt.Errorf("expected result to be a string, got %T", result)
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("result is nil")
+	}
+
+	bz, err := hexutil.Decode(*r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex result: %w", err)
+	}
+
+	return bz, nil
+}
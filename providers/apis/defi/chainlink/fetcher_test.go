@@ -0,0 +1,408 @@
+package chainlink_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient/mocks"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+func TestFetch(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		tickers  []types.ProviderTicker
+		client   func() ethmulticlient.EVMClient
+		expected types.PriceResponse
+		codes    map[types.ProviderTicker]providertypes.ErrorCode
+	}{
+		{
+			name:    "no tickers",
+			tickers: []types.ProviderTicker{},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				c.On("BatchCallContext", context.Background(), []rpc.BatchElem{}).Return(nil)
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved:   map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+		{
+			name: "fails to retrieve feed for an empty ticker",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("ETH/USD", ""),
+			},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					types.NewProviderTicker("ETH/USD", ""): {},
+				},
+			},
+		},
+		{
+			name: "fails to make a batch call",
+			tickers: []types.ProviderTicker{
+				ethusdTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, fmt.Errorf("failed to make a batch call"), nil, nil)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					ethusdTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request has an error for the latest round",
+			tickers: []types.ProviderTicker{
+				ethusdTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{"", decimalsResult(t, 8)},
+					[]error{fmt.Errorf("request for ticker did not return a result"), nil},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					ethusdTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request has an error for the decimals",
+			tickers: []types.ProviderTicker{
+				ethusdTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{roundDataResult(t, 300000000000, now), ""},
+					[]error{nil, fmt.Errorf("request for decimals did not return a result")},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					ethusdTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request returns a result that cannot be parsed",
+			tickers: []types.ProviderTicker{
+				ethusdTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{"not a valid result", decimalsResult(t, 8)},
+					[]error{nil, nil},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					ethusdTicker: {},
+				},
+			},
+		},
+		{
+			name: "feed reports a non-positive answer",
+			tickers: []types.ProviderTicker{
+				ethusdTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{roundDataResult(t, -1, now), decimalsResult(t, 8)},
+					[]error{nil, nil},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					ethusdTicker: {},
+				},
+			},
+			codes: map[types.ProviderTicker]providertypes.ErrorCode{
+				ethusdTicker: providertypes.ErrorFailedToParsePrice,
+			},
+		},
+		{
+			name: "round is older than the heartbeat",
+			tickers: []types.ProviderTicker{
+				ethusdTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{roundDataResult(t, 300000000000, now.Add(-2*time.Hour)), decimalsResult(t, 8)},
+					[]error{nil, nil},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					ethusdTicker: {},
+				},
+			},
+			codes: map[types.ProviderTicker]providertypes.ErrorCode{
+				ethusdTicker: providertypes.ErrorStalePrice,
+			},
+		},
+		{
+			name: "eth/usd result",
+			tickers: []types.ProviderTicker{
+				ethusdTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{roundDataResult(t, 300000000000, now.Add(-30*time.Minute)), decimalsResult(t, 8)},
+					[]error{nil, nil},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					ethusdTicker: {
+						Value: big.NewFloat(3000),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := createPriceFetcherWithClient(t, tc.client())
+
+			response := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Equal(t, len(tc.expected.Resolved), len(response.Resolved))
+			require.Equal(t, len(tc.expected.UnResolved), len(response.UnResolved))
+
+			for ticker, result := range tc.expected.Resolved {
+				require.Contains(t, response.Resolved, ticker)
+				require.Equal(t, result.Value.SetPrec(40), response.Resolved[ticker].Value.SetPrec(40))
+			}
+
+			for ticker := range tc.expected.UnResolved {
+				require.Contains(t, response.UnResolved, ticker)
+			}
+
+			for ticker, code := range tc.codes {
+				require.Equal(t, code, response.UnResolved[ticker].Code())
+			}
+		})
+	}
+}
+
+func TestFetchCachesDecimals(t *testing.T) {
+	now := time.Now()
+	round := roundDataResult(t, 300000000000, now)
+	decimals := decimalsResult(t, 8)
+
+	// The first fetch queries the latest round and the decimals of the feed, subsequent fetches
+	// only query the latest round.
+	client := mocks.NewEVMClient(t)
+	client.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		require.Len(t, elems, 2)
+		*elems[0].Result.(*string) = round
+		*elems[1].Result.(*string) = decimals
+	}).Once()
+	client.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		require.Len(t, elems, 1)
+		*elems[0].Result.(*string) = round
+	}).Once()
+
+	fetcher := createPriceFetcherWithClient(t, client)
+	for i := 0; i < 2; i++ {
+		response := fetcher.Fetch(context.Background(), []types.ProviderTicker{ethusdTicker})
+		require.Contains(t, response.Resolved, ethusdTicker)
+		require.Equal(t, big.NewFloat(3000).SetPrec(40), response.Resolved[ethusdTicker].Value.SetPrec(40))
+	}
+}
+
+func TestGetFeed(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("ticker is empty", func(t *testing.T) {
+		ticker := types.NewProviderTicker("", "")
+		_, err := fetcher.GetFeed(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker does not have valid metadata", func(t *testing.T) {
+		expected := chainlink.FeedConfig{
+			Address: "0x1234",
+		}
+		ticker := types.NewProviderTicker("ETH/USD", expected.MustToJSON())
+		_, err := fetcher.GetFeed(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker is not json formatted", func(t *testing.T) {
+		ticker := types.NewProviderTicker("ETH/USD", "not json, something else")
+		_, err := fetcher.GetFeed(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker has valid metadata", func(t *testing.T) {
+		feed, err := fetcher.GetFeed(ethusdTicker)
+		require.NoError(t, err)
+		require.Equal(t, ethusdCfg, feed)
+	})
+}
+
+func TestParseRoundData(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("result does not map to a string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseRoundData(42)
+		require.Error(t, err)
+	})
+
+	t.Run("result is a nil string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseRoundData((*string)(nil))
+		require.Error(t, err)
+	})
+
+	t.Run("result cannot be unpacked by the aggregator abi", func(t *testing.T) {
+		result := new(string)
+		*result = "0x1234"
+		_, err := fetcher.ParseRoundData(result)
+		require.Error(t, err)
+	})
+
+	t.Run("result for ETH/USD", func(t *testing.T) {
+		updatedAt := time.Unix(1700000000, 0)
+		result := roundDataResult(t, 300000000000, updatedAt)
+		round, err := fetcher.ParseRoundData(&result)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(300000000000), round.Answer)
+		require.Equal(t, big.NewInt(updatedAt.Unix()), round.UpdatedAt)
+	})
+}
+
+func TestParseDecimals(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("result cannot be unpacked by the aggregator abi", func(t *testing.T) {
+		result := new(string)
+		*result = "0x1234"
+		_, err := fetcher.ParseDecimals(result)
+		require.Error(t, err)
+	})
+
+	t.Run("result for 8 decimals", func(t *testing.T) {
+		result := decimalsResult(t, 8)
+		decimals, err := fetcher.ParseDecimals(&result)
+		require.NoError(t, err)
+		require.Equal(t, uint8(8), decimals)
+	})
+}
+
+func TestNewPriceFetcher(t *testing.T) {
+	ctx := context.TODO()
+
+	testcases := []struct {
+		name   string
+		logger *zap.Logger
+		api    config.APIConfig
+		err    error
+	}{
+		{
+			name:   "no logger errors",
+			logger: nil,
+			err:    fmt.Errorf("logger cannot be nil"),
+		},
+		{
+			name:   "invalid api config errors",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled: true,
+			},
+			err: fmt.Errorf("invalid api config: "),
+		},
+		{
+			name:   "invalid provider name errors",
+			logger: logger,
+			api: config.APIConfig{
+				Name: "chainlink_api-foobar",
+			},
+			err: fmt.Errorf("invalid api config name chainlink_api-foobar"),
+		},
+		{
+			name:   "disabled api config errors",
+			logger: logger,
+			api: config.APIConfig{
+				Name: "chainlink_api-ethereum",
+			},
+			err: fmt.Errorf("api config for chainlink_api-ethereum is not enabled"),
+		},
+		{
+			name:   "url success",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled:          true,
+				Timeout:          1,
+				ReconnectTimeout: 1,
+				Interval:         1,
+				MaxQueries:       1,
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:0"}},
+				Name:             "chainlink_api-ethereum",
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			pf, err := chainlink.NewPriceFetcher(
+				ctx,
+				tc.logger,
+				metrics.NewNopAPIMetrics(),
+				tc.api,
+			)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, pf)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package chainlink_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink"
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink/aggregator"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient/mocks"
+)
+
+var (
+	logger, _ = zap.NewDevelopment()
+
+	// FeedConfigs used for testing.
+	ethusdCfg = chainlink.FeedConfig{
+		Address:   "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419",
+		Heartbeat: 3600,
+	}
+
+	// Tickers used for testing.
+	ethusdTicker = types.NewProviderTicker("ETH/USD", ethusdCfg.MustToJSON())
+)
+
+func createPriceFetcher(
+	t *testing.T,
+) *chainlink.PriceFetcher {
+	t.Helper()
+
+	client := mocks.NewEVMClient(t)
+	return createPriceFetcherWithClient(t, client)
+}
+
+func createPriceFetcherWithClient(
+	t *testing.T,
+	client ethmulticlient.EVMClient,
+) *chainlink.PriceFetcher {
+	t.Helper()
+
+	fetcher, err := chainlink.NewPriceFetcherWithClient(
+		logger,
+		chainlink.DefaultETHAPIConfig,
+		client,
+	)
+	require.NoError(t, err)
+
+	return fetcher
+}
+
+func createEVMClientWithResponse(
+	t *testing.T,
+	failedRequestErr error,
+	responses []string,
+	errs []error,
+) ethmulticlient.EVMClient {
+	t.Helper()
+
+	c := mocks.NewEVMClient(t)
+	if failedRequestErr != nil {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(failedRequestErr)
+	} else {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			elems, ok := args.Get(1).([]rpc.BatchElem)
+			require.True(t, ok)
+			require.Equal(t, len(elems), len(responses))
+			require.Equal(t, len(elems), len(errs))
+
+			for i, elem := range elems {
+				elem.Result = &responses[i]
+				elem.Error = errs[i]
+				elems[i] = elem
+			}
+		})
+	}
+
+	return c
+}
+
+// roundDataResult returns the encoded result of a latestRoundData call.
+func roundDataResult(t *testing.T, answer int64, updatedAt time.Time) string {
+	t.Helper()
+
+	abi, err := aggregator.AggregatorV3MetaData.GetAbi()
+	require.NoError(t, err)
+
+	bz, err := abi.Methods[chainlink.ContractMethod].Outputs.Pack(
+		big.NewInt(1),
+		big.NewInt(answer),
+		big.NewInt(updatedAt.Unix()),
+		big.NewInt(updatedAt.Unix()),
+		big.NewInt(1),
+	)
+	require.NoError(t, err)
+
+	return hexutil.Encode(bz)
+}
+
+// decimalsResult returns the encoded result of a decimals call.
+func decimalsResult(t *testing.T, decimals uint8) string {
+	t.Helper()
+
+	abi, err := aggregator.AggregatorV3MetaData.GetAbi()
+	require.NoError(t, err)
+
+	bz, err := abi.Methods[chainlink.DecimalsContractMethod].Outputs.Pack(decimals)
+	require.NoError(t, err)
+
+	return hexutil.Encode(bz)
+}
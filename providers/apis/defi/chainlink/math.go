@@ -0,0 +1,71 @@
+package chainlink
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+// RoundData is the latest round of a Chainlink feed, as returned by latestRoundData.
+type RoundData struct {
+	// RoundID is the id of the round.
+	RoundID *big.Int
+	// Answer is the raw, unscaled answer of the round.
+	Answer *big.Int
+	// StartedAt is the unix timestamp at which the round started.
+	StartedAt *big.Int
+	// UpdatedAt is the unix timestamp at which the round was last updated.
+	UpdatedAt *big.Int
+	// AnsweredInRound is the id of the round in which the answer was computed.
+	AnsweredInRound *big.Int
+}
+
+// CalculatePrice validates the round of a feed and returns the answer of the round, scaled to
+// the decimals of the feed:
+//
+// price = answer / 10^decimals.
+//
+// The round is rejected if the answer is not positive, or if the round was last updated longer
+// than the heartbeat ago (relative to now).
+func CalculatePrice(
+	round RoundData,
+	decimals uint8,
+	heartbeat time.Duration,
+	now time.Time,
+) (*big.Float, error) {
+	if round.Answer == nil || round.Answer.Sign() <= 0 {
+		return nil, fmt.Errorf("answer must be positive, got %v", round.Answer)
+	}
+
+	if round.UpdatedAt == nil || round.UpdatedAt.Sign() <= 0 || !round.UpdatedAt.IsInt64() {
+		return nil, fmt.Errorf("round has an invalid update time %v", round.UpdatedAt)
+	}
+
+	updatedAt := time.Unix(round.UpdatedAt.Int64(), 0)
+	if age := now.Sub(updatedAt); age > heartbeat {
+		return nil, StaleRoundError{
+			UpdatedAt: updatedAt.UTC(),
+			Heartbeat: heartbeat,
+		}
+	}
+
+	return new(big.Float).Mul(
+		new(big.Float).SetInt(round.Answer),
+		math.GetScalingFactor(0, int64(decimals)),
+	), nil
+}
+
+// StaleRoundError is returned when the latest round of a feed is older than the feed's heartbeat.
+type StaleRoundError struct {
+	// UpdatedAt is the time at which the round was last updated.
+	UpdatedAt time.Time
+	// Heartbeat is the heartbeat of the feed.
+	Heartbeat time.Duration
+}
+
+// Error returns the error message.
+func (e StaleRoundError) Error() string {
+	return fmt.Sprintf("round was last updated at %s, which is older than the heartbeat of %s", e.UpdatedAt, e.Heartbeat)
+}
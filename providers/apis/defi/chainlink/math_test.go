@@ -0,0 +1,96 @@
+package chainlink_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink"
+)
+
+func TestCalculatePrice(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	round := func(answer int64, updatedAt time.Time) chainlink.RoundData {
+		return chainlink.RoundData{
+			RoundID:         big.NewInt(1),
+			Answer:          big.NewInt(answer),
+			StartedAt:       big.NewInt(updatedAt.Unix()),
+			UpdatedAt:       big.NewInt(updatedAt.Unix()),
+			AnsweredInRound: big.NewInt(1),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		round    chainlink.RoundData
+		decimals uint8
+		expected *big.Float
+		stale    bool
+		err      bool
+	}{
+		{
+			name:     "eth/usd with 8 decimals",
+			round:    round(300000000000, now),
+			decimals: 8,
+			expected: big.NewFloat(3000),
+		},
+		{
+			name:     "eth/btc with 18 decimals",
+			round:    round(50000000000000000, now),
+			decimals: 18,
+			expected: big.NewFloat(0.05),
+		},
+		{
+			name:     "round updated exactly a heartbeat ago",
+			round:    round(100000000, now.Add(-time.Hour)),
+			decimals: 8,
+			expected: big.NewFloat(1),
+		},
+		{
+			name:     "round older than the heartbeat",
+			round:    round(100000000, now.Add(-time.Hour-time.Second)),
+			decimals: 8,
+			stale:    true,
+			err:      true,
+		},
+		{
+			name:     "zero answer",
+			round:    round(0, now),
+			decimals: 8,
+			err:      true,
+		},
+		{
+			name:     "negative answer",
+			round:    round(-1, now),
+			decimals: 8,
+			err:      true,
+		},
+		{
+			name: "round was never updated",
+			round: chainlink.RoundData{
+				Answer:    big.NewInt(100000000),
+				UpdatedAt: big.NewInt(0),
+			},
+			decimals: 8,
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := chainlink.CalculatePrice(tc.round, tc.decimals, time.Hour, now)
+			if tc.err {
+				require.Error(t, err)
+				require.Equal(t, tc.stale, errors.As(err, &chainlink.StaleRoundError{}))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.SetPrec(40), price.SetPrec(40))
+		})
+	}
+}
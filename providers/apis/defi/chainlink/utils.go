@@ -0,0 +1,119 @@
+package chainlink
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/constants"
+)
+
+const (
+	// BaseName is the name of the Chainlink API.
+	BaseName = "chainlink_api"
+
+	// NameSeparator is the character used to separate elements of dynamic naming for the provider.
+	NameSeparator = "-"
+
+	// ContractMethod is the contract method to call for the latest round of a feed.
+	ContractMethod = "latestRoundData"
+
+	// DecimalsContractMethod is the contract method to call for the number of decimals of a feed.
+	DecimalsContractMethod = "decimals"
+
+	// DefaultHeartbeat is the heartbeat of a feed that does not configure one. Rounds that were
+	// last updated longer than the heartbeat ago are rejected as stale.
+	DefaultHeartbeat = time.Hour
+
+	// ETH_URL is the URL for the Chainlink API. This uses a free public RPC provider on Ethereum Mainnet.
+	ETH_URL = "https://eth.public-rpc.com/"
+
+	// BASE_URL is the URL for the Chainlink API. This uses a free public RPC provider on Base Mainnet.
+	BASE_URL = "https://mainnet.base.org"
+)
+
+// ProviderNames is the set of all supported "dynamic" names mapped by chain.
+var ProviderNames = map[string]string{
+	constants.ETHEREUM: strings.Join([]string{BaseName, constants.ETHEREUM}, NameSeparator),
+	constants.BASE:     strings.Join([]string{BaseName, constants.BASE}, NameSeparator),
+}
+
+// IsValidProviderName returns a bool based on the validity of the passed in name.
+// Dynamic provider naming is supported via `BaseName“NameSeparator“SupportedChain`.
+func IsValidProviderName(name string) bool {
+	for _, providerName := range ProviderNames {
+		if name == providerName {
+			return true
+		}
+	}
+	return false
+}
+
+// FeedConfig is the configuration for a Chainlink price feed. This is specific to each ticker.
+type FeedConfig struct {
+	// Address is the address of the feed's AggregatorV3 (proxy) contract.
+	Address string `json:"address"`
+	// Heartbeat is the (optional) heartbeat of the feed, in seconds. Rounds that were last
+	// updated longer than the heartbeat ago are rejected as stale. If this is zero, the
+	// DefaultHeartbeat is used.
+	Heartbeat uint32 `json:"heartbeat,omitempty"`
+}
+
+// ValidateBasic validates the feed configuration.
+func (fc *FeedConfig) ValidateBasic() error {
+	if !common.IsHexAddress(fc.Address) {
+		return fmt.Errorf("feed address is not a valid ethereum address")
+	}
+
+	return nil
+}
+
+// GetHeartbeat returns the heartbeat of the feed.
+func (fc FeedConfig) GetHeartbeat() time.Duration {
+	if fc.Heartbeat == 0 {
+		return DefaultHeartbeat
+	}
+
+	return time.Duration(fc.Heartbeat) * time.Second
+}
+
+// MustToJSON converts the feed configuration to JSON.
+func (fc FeedConfig) MustToJSON() string {
+	b, err := json.Marshal(fc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+var (
+	// DefaultETHAPIConfig is the default configuration for the Chainlink API. Specifically this is
+	// for Ethereum mainnet.
+	DefaultETHAPIConfig = config.APIConfig{
+		Name:             fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.ETHEREUM),
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          1000 * time.Millisecond,
+		Interval:         2000 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: ETH_URL}},
+	}
+
+	// DefaultBaseAPIConfig is the default configuration for the Chainlink API. Specifically this is
+	// for Base mainnet.
+	DefaultBaseAPIConfig = config.APIConfig{
+		Name:             fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.BASE),
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          1000 * time.Millisecond,
+		Interval:         2000 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: BASE_URL}},
+	}
+)
@@ -0,0 +1,83 @@
+package chainlink_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/constants"
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink"
+)
+
+func TestFeedConfig(t *testing.T) {
+	t.Run("empty config", func(t *testing.T) {
+		cfg := chainlink.FeedConfig{}
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		cfg := chainlink.FeedConfig{
+			Address: "invalid",
+		}
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("valid config with the default heartbeat", func(t *testing.T) {
+		cfg := chainlink.FeedConfig{
+			Address: "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419",
+		}
+		require.NoError(t, cfg.ValidateBasic())
+		require.Equal(t, chainlink.DefaultHeartbeat, cfg.GetHeartbeat())
+	})
+
+	t.Run("valid config with a heartbeat", func(t *testing.T) {
+		cfg := chainlink.FeedConfig{
+			Address:   "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419",
+			Heartbeat: 86400,
+		}
+		require.NoError(t, cfg.ValidateBasic())
+		require.Equal(t, 24*time.Hour, cfg.GetHeartbeat())
+	})
+}
+
+func TestIsValidProviderName(t *testing.T) {
+	type testcase struct {
+		testName     string
+		providerName string
+		valid        bool
+	}
+	testcases := []testcase{
+		{
+			testName:     "empty",
+			providerName: "",
+			valid:        false,
+		},
+		{
+			testName:     "valid base, invalid chain",
+			providerName: fmt.Sprintf("%s%s%s", chainlink.BaseName, chainlink.NameSeparator, "arbitrum"),
+			valid:        false,
+		},
+		{
+			testName:     "invalid base",
+			providerName: fmt.Sprintf("%s%s%s", "uniswapv3_api", chainlink.NameSeparator, constants.ETHEREUM),
+			valid:        false,
+		},
+		{
+			testName:     "valid provider eth",
+			providerName: fmt.Sprintf("%s%s%s", chainlink.BaseName, chainlink.NameSeparator, constants.ETHEREUM),
+			valid:        true,
+		},
+		{
+			testName:     "valid provider base",
+			providerName: fmt.Sprintf("%s%s%s", chainlink.BaseName, chainlink.NameSeparator, constants.BASE),
+			valid:        true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.testName, func(t *testing.T) {
+			require.Equal(t, tc.valid, chainlink.IsValidProviderName(tc.providerName))
+		})
+	}
+}
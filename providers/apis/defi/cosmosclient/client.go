@@ -0,0 +1,146 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	slinkyhttp "github.com/skip-mev/slinky/pkg/http"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+// BlockHeightHeader is the header set by the cosmos-sdk gRPC gateway that contains the height
+// at which a query was served.
+const BlockHeightHeader = "x-cosmos-block-height"
+
+// Response is the response of a query made to a cosmos REST (LCD) endpoint.
+type Response struct {
+	// Height is the block height at which the query was served. This is zero if the endpoint
+	// did not report a height.
+	Height uint64
+
+	// Body is the raw JSON body of the response.
+	Body []byte
+}
+
+// CosmosRESTClient is an interface that abstracts a client used to query the REST (gRPC gateway)
+// endpoints of a cosmos-sdk chain.
+//
+//go:generate mockery --name CosmosRESTClient
+type CosmosRESTClient interface {
+	// Get queries the given path (including any query parameters) and returns the response.
+	Get(ctx context.Context, path string) (*Response, error)
+}
+
+var _ CosmosRESTClient = (*RESTClientImpl)(nil)
+
+// RESTClientImpl is a CosmosRESTClient implementation that queries a single REST endpoint.
+type RESTClientImpl struct {
+	apiMetrics  metrics.APIMetrics
+	api         config.APIConfig
+	redactedURL string
+
+	// url is the base url of the endpoint.
+	url string
+
+	// client is the underlying http client.
+	client *http.Client
+}
+
+// NewRESTClientImpl creates a CosmosRESTClient via the config.Endpoint at the given index. This
+// includes optional authentication via a specified http header key and value.
+func NewRESTClientImpl(
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+	index int,
+) (CosmosRESTClient, error) {
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if index < 0 || index >= len(api.Endpoints) {
+		return nil, fmt.Errorf("expected endpoint at index %d, got %d endpoints", index, len(api.Endpoints))
+	}
+
+	endpoint := api.Endpoints[index] // pin
+	opts := []slinkyhttp.HeaderOption{
+		slinkyhttp.WithSlinkyVersionUserAgent(),
+	}
+	if endpoint.Authentication.Enabled() {
+		opts = append(opts, slinkyhttp.WithAuthentication(endpoint.Authentication.APIKeyHeader, endpoint.Authentication.APIKey))
+	}
+
+	return &RESTClientImpl{
+		apiMetrics:  apiMetrics,
+		api:         api,
+		redactedURL: metrics.RedactedEndpointURL(index),
+		url:         strings.TrimSuffix(endpoint.URL, "/"),
+		client: &http.Client{
+			Transport: slinkyhttp.NewRoundTripperWithHeaders(http.DefaultTransport, opts...),
+			Timeout:   api.Timeout,
+		},
+	}, nil
+}
+
+// Get queries the given path on the endpoint. An error is returned if the request fails or the
+// endpoint responds with a non-200 status code.
+func (c *RESTClientImpl) Get(ctx context.Context, path string) (resp *Response, err error) {
+	start := time.Now()
+	defer func() {
+		c.apiMetrics.ObserveProviderResponseLatency(c.api.Name, c.redactedURL, time.Since(start))
+		if err != nil {
+			c.apiMetrics.AddRPCStatusCode(c.api.Name, c.redactedURL, metrics.RPCCodeError)
+			return
+		}
+		c.apiMetrics.AddRPCStatusCode(c.api.Name, c.redactedURL, metrics.RPCCodeOK)
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query endpoint: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return &Response{
+		Height: ParseHeight(httpResp.Header),
+		Body:   body,
+	}, nil
+}
+
+// ParseHeight returns the block height reported in the given headers, or zero if the height is
+// not reported or invalid.
+func ParseHeight(header http.Header) uint64 {
+	height, err := strconv.ParseUint(header.Get(BlockHeightHeader), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return height
+}
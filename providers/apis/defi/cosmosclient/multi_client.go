@@ -0,0 +1,140 @@
+package cosmosclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+// MultiRESTClient implements the CosmosRESTClient interface by querying multiple underlying
+// clients and choosing the best response. Specifically, it chooses the successful response that
+// was served at the highest block height. This allows a provider to fail over between endpoints
+// without serving stale data from an endpoint that has fallen behind.
+type MultiRESTClient struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// underlying clients
+	clients []CosmosRESTClient
+}
+
+// NewMultiRESTClient returns a new MultiRESTClient.
+func NewMultiRESTClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	clients []CosmosRESTClient,
+) CosmosRESTClient {
+	return &MultiRESTClient{
+		logger:  logger,
+		api:     api,
+		clients: clients,
+	}
+}
+
+// NewMultiRESTClientFromEndpoints creates a MultiRESTClient from config endpoints.
+func NewMultiRESTClientFromEndpoints(
+	logger *zap.Logger,
+	api config.APIConfig,
+	apiMetrics metrics.APIMetrics,
+) (CosmosRESTClient, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if len(api.Endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints provided")
+	}
+
+	clients := make([]CosmosRESTClient, len(api.Endpoints))
+	for i, endpoint := range api.Endpoints {
+		var err error
+		clients[i], err = NewRESTClientImpl(apiMetrics, api, i)
+		if err != nil {
+			logger.Error(
+				"endpoint failed to construct client",
+				zap.String("endpoint.URL", endpoint.URL),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to create cosmos client from endpoint: %w", err)
+		}
+	}
+
+	return &MultiRESTClient{
+		logger:  logger.With(zap.String("multi_client", api.Name)),
+		api:     api,
+		clients: clients,
+	}, nil
+}
+
+// Get queries the given path on all underlying clients concurrently and returns the successful
+// response with the greatest height. An error is returned only if every client failed.
+func (m *MultiRESTClient) Get(ctx context.Context, path string) (*Response, error) {
+	if len(m.clients) == 0 {
+		return nil, fmt.Errorf("no clients to query")
+	}
+
+	responses := make([]*Response, len(m.clients))
+	errs := make([]error, len(m.clients))
+
+	wg := new(sync.WaitGroup)
+	for clientIdx, client := range m.clients {
+		wg.Add(1)
+		go func(i int, client CosmosRESTClient) {
+			defer wg.Done()
+
+			resp, err := client.Get(ctx, path)
+			if err != nil {
+				errs[i] = fmt.Errorf("endpoint request failed: %w", err)
+				m.logger.Debug(
+					"endpoint request failed",
+					zap.Int("endpoint", i),
+					zap.Error(err),
+				)
+				return
+			}
+
+			responses[i] = resp
+		}(clientIdx, client)
+	}
+	wg.Wait()
+
+	return filterResponses(responses, errs)
+}
+
+// filterResponses chooses the non-nil response with the highest height. Responses that do not
+// report a height are only chosen if no other response does.
+func filterResponses(responses []*Response, errs []error) (*Response, error) {
+	var maxResp *Response
+	for _, resp := range responses {
+		if resp == nil {
+			continue
+		}
+
+		if maxResp == nil || resp.Height > maxResp.Height {
+			maxResp = resp
+		}
+	}
+
+	if maxResp == nil {
+		if err := errors.Join(errs...); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("no errors were encountered, however no client returned a response")
+	}
+
+	return maxResp, nil
+}
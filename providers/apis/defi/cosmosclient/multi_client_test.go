@@ -0,0 +1,162 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient/mocks"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+var testAPIConfig = config.APIConfig{
+	Name:             "cosmos_api",
+	Atomic:           false,
+	Enabled:          true,
+	Timeout:          time.Second,
+	Interval:         time.Second,
+	ReconnectTimeout: time.Second,
+	MaxQueries:       1,
+	BatchSize:        1,
+	Endpoints: []config.Endpoint{
+		{URL: "http://localhost:1317"},
+		{URL: "http://localhost:1318"},
+	},
+}
+
+func TestMultiRESTClient(t *testing.T) {
+	testCases := []struct {
+		name      string
+		responses []*cosmosclient.Response
+		errs      []error
+		expected  *cosmosclient.Response
+		expectErr bool
+	}{
+		{
+			name:      "all clients fail",
+			responses: []*cosmosclient.Response{nil, nil},
+			errs:      []error{fmt.Errorf("first failed"), fmt.Errorf("second failed")},
+			expectErr: true,
+		},
+		{
+			name:      "one client fails",
+			responses: []*cosmosclient.Response{nil, {Height: 10, Body: []byte("second")}},
+			errs:      []error{fmt.Errorf("first failed"), nil},
+			expected:  &cosmosclient.Response{Height: 10, Body: []byte("second")},
+		},
+		{
+			name: "response with the highest height is chosen",
+			responses: []*cosmosclient.Response{
+				{Height: 12, Body: []byte("first")},
+				{Height: 10, Body: []byte("second")},
+			},
+			errs:     []error{nil, nil},
+			expected: &cosmosclient.Response{Height: 12, Body: []byte("first")},
+		},
+		{
+			name: "responses without a height are used as a fallback",
+			responses: []*cosmosclient.Response{
+				{Body: []byte("first")},
+				{Body: []byte("second")},
+			},
+			errs:     []error{nil, nil},
+			expected: &cosmosclient.Response{Body: []byte("first")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clients := make([]cosmosclient.CosmosRESTClient, len(tc.responses))
+			for i := range tc.responses {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, "/path").Return(tc.responses[i], tc.errs[i]).Once()
+				clients[i] = c
+			}
+
+			client := cosmosclient.NewMultiRESTClient(zap.NewNop(), testAPIConfig, clients)
+			resp, err := client.Get(context.Background(), "/path")
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp)
+		})
+	}
+}
+
+func TestNewMultiRESTClientFromEndpoints(t *testing.T) {
+	t.Run("no endpoints", func(t *testing.T) {
+		cfg := testAPIConfig
+		cfg.Endpoints = nil
+
+		_, err := cosmosclient.NewMultiRESTClientFromEndpoints(zap.NewNop(), cfg, metrics.NewNopAPIMetrics())
+		require.Error(t, err)
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		_, err := cosmosclient.NewMultiRESTClientFromEndpoints(nil, testAPIConfig, metrics.NewNopAPIMetrics())
+		require.Error(t, err)
+	})
+
+	t.Run("valid config", func(t *testing.T) {
+		_, err := cosmosclient.NewMultiRESTClientFromEndpoints(zap.NewNop(), testAPIConfig, metrics.NewNopAPIMetrics())
+		require.NoError(t, err)
+	})
+}
+
+func TestRESTClientImpl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+
+		switch r.URL.Path {
+		case "/ok":
+			w.Header().Set(cosmosclient.BlockHeightHeader, "1234")
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer server.Close()
+
+	cfg := testAPIConfig
+	cfg.Endpoints = []config.Endpoint{
+		{
+			URL: server.URL + "/",
+			Authentication: config.Authentication{
+				APIKey:       "secret",
+				APIKeyHeader: "X-Api-Key",
+			},
+		},
+	}
+
+	client, err := cosmosclient.NewRESTClientImpl(metrics.NewNopAPIMetrics(), cfg, 0)
+	require.NoError(t, err)
+
+	t.Run("successful query", func(t *testing.T) {
+		resp, err := client.Get(context.Background(), "/ok")
+		require.NoError(t, err)
+		require.Equal(t, uint64(1234), resp.Height)
+		require.Equal(t, `{"ok":true}`, string(resp.Body))
+	})
+
+	t.Run("non-200 status code", func(t *testing.T) {
+		_, err := client.Get(context.Background(), "/unknown")
+		require.Error(t, err)
+	})
+
+	t.Run("invalid endpoint index", func(t *testing.T) {
+		_, err := cosmosclient.NewRESTClientImpl(metrics.NewNopAPIMetrics(), cfg, 1)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,240 @@
+package osmosis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
+
+// PriceFetcher is the Osmosis price fetcher. This fetcher is responsible for querying the spot
+// price of Osmosis pools via the poolmanager module's REST (gRPC gateway) endpoints. The pool to
+// query for each ticker is configured in the ticker's metadata JSON.
+//
+// If multiple endpoints are configured, each query is sent to all of them and the response served
+// at the highest block height is used.
+type PriceFetcher struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// client is the cosmos REST client used to query the Osmosis chain.
+	client cosmosclient.CosmosRESTClient
+
+	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	poolCache map[types.ProviderTicker]PoolConfig
+	mtx       sync.Mutex
+}
+
+// NewPriceFetcher returns a new Osmosis price fetcher.
+func NewPriceFetcher(
+	logger *zap.Logger,
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+) (*PriceFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if api.Name != Name {
+		return nil, fmt.Errorf("invalid api name; expected %s, got %s", Name, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	var (
+		client cosmosclient.CosmosRESTClient
+		err    error
+	)
+	switch {
+	case len(api.Endpoints) > 1:
+		client, err = cosmosclient.NewMultiRESTClientFromEndpoints(logger, api, apiMetrics)
+	case len(api.Endpoints) == 1:
+		client, err = cosmosclient.NewRESTClientImpl(apiMetrics, api, 0)
+	default:
+		err = fmt.Errorf("no endpoints were provided")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceFetcherWithClient(logger, api, client)
+}
+
+// NewPriceFetcherWithClient returns a new PriceFetcher.
+// It requires a pre-validated config, and initialized client.
+func NewPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client cosmosclient.CosmosRESTClient,
+) (*PriceFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+
+	return &PriceFetcher{
+		logger:    logger.With(zap.String("fetcher", api.Name)),
+		api:       api,
+		client:    client,
+		poolCache: make(map[types.ProviderTicker]PoolConfig),
+	}, nil
+}
+
+// Fetch returns the price of a given set of tickers. The spot price of each ticker's pool is
+// queried concurrently.
+func (pf *PriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []types.ProviderTicker,
+) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	pools := make([]PoolConfig, len(tickers))
+	for i, ticker := range tickers {
+		pool, err := pf.GetPool(ticker)
+		if err != nil {
+			pf.logger.Debug(
+				"failed to get pool for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get pool: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			)
+		}
+		pools[i] = pool
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pf.api.Timeout)
+	defer cancel()
+
+	prices := make([]*big.Float, len(tickers))
+	errs := make([]providertypes.ErrorWithCode, len(tickers))
+
+	wg := new(sync.WaitGroup)
+	for i := range tickers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			prices[i], errs[i] = pf.fetchPrice(ctx, pools[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for i, ticker := range tickers {
+		if prices[i] == nil {
+			pf.logger.Debug(
+				"failed to fetch price for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(errs[i]),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: errs[i],
+			}
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(prices[i], time.Now().UTC())
+	}
+
+	return types.NewPriceResponse(resolved, unResolved)
+}
+
+// fetchPrice queries the spot price of the given pool and scales it to the decimals of the
+// assets.
+func (pf *PriceFetcher) fetchPrice(
+	ctx context.Context,
+	pool PoolConfig,
+) (*big.Float, providertypes.ErrorWithCode) {
+	resp, err := pf.client.Get(ctx, pool.SpotPriceQueryPath())
+	if err != nil {
+		return nil, providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral)
+	}
+
+	price, err := ParseSpotPrice(pool, resp.Body)
+	if err != nil {
+		return nil, providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice)
+	}
+
+	return price, providertypes.ErrorWithCode{}
+}
+
+// GetPool returns the Osmosis pool for the given ticker. This will unmarshal the metadata
+// and validate the pool config which contains all required information to query the chain.
+func (pf *PriceFetcher) GetPool(
+	ticker types.ProviderTicker,
+) (PoolConfig, error) {
+	pf.mtx.Lock()
+	defer pf.mtx.Unlock()
+
+	if pool, ok := pf.poolCache[ticker]; ok {
+		return pool, nil
+	}
+
+	var cfg PoolConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal pool config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker pool config: %w", err)
+	}
+
+	pf.poolCache[ticker] = cfg
+	return cfg, nil
+}
+
+// ParseSpotPrice parses the spot price from the body of a spot price query response. The spot
+// price is returned in base units of the assets, so it is scaled by the difference between the
+// decimals of the base and quote asset.
+func ParseSpotPrice(pool PoolConfig, body []byte) (*big.Float, error) {
+	var resp SpotPriceResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal spot price response: %w", err)
+	}
+
+	price, err := math.Float64StringToBigFloat(resp.SpotPrice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert spot price %s to big.Float: %w", resp.SpotPrice, err)
+	}
+
+	if price.Sign() <= 0 {
+		return nil, fmt.Errorf("spot price must be positive, got %s", resp.SpotPrice)
+	}
+
+	return new(big.Float).Mul(price, math.GetScalingFactor(pool.BaseDecimals, pool.QuoteDecimals)), nil
+}
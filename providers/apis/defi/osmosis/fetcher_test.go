@@ -0,0 +1,180 @@
+package osmosis_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient"
+	"github.com/skip-mev/slinky/providers/apis/defi/cosmosclient/mocks"
+	"github.com/skip-mev/slinky/providers/apis/defi/osmosis"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+var (
+	// atomosmoCfg is the pool config of the ATOM/OSMO pool.
+	atomosmoCfg = osmosis.PoolConfig{
+		PoolID:        1,
+		BaseDenom:     "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		QuoteDenom:    "uosmo",
+		BaseDecimals:  6,
+		QuoteDecimals: 6,
+	}
+	atomosmoTicker = types.NewProviderTicker("ATOM/OSMO", atomosmoCfg.MustToJSON())
+
+	// wethosmoCfg is the pool config of an 18 decimal asset quoted in OSMO.
+	wethosmoCfg = osmosis.PoolConfig{
+		PoolID:        704,
+		BaseDenom:     "ibc/EA1D43981D5C9A1C4AAEA9C23BB1D4FA126BA9BC7020A25E0AE4AA841EA25DC5",
+		QuoteDenom:    "uosmo",
+		BaseDecimals:  18,
+		QuoteDecimals: 6,
+	}
+	wethosmoTicker = types.NewProviderTicker("WETH/OSMO", wethosmoCfg.MustToJSON())
+)
+
+func TestNewPriceFetcher(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		_, err := osmosis.NewPriceFetcher(zap.NewNop(), metrics.NewNopAPIMetrics(), osmosis.DefaultAPIConfig)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		cfg := osmosis.DefaultAPIConfig
+		cfg.Name = "invalid"
+
+		_, err := osmosis.NewPriceFetcher(zap.NewNop(), metrics.NewNopAPIMetrics(), cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("nil metrics", func(t *testing.T) {
+		_, err := osmosis.NewPriceFetcher(zap.NewNop(), nil, osmosis.DefaultAPIConfig)
+		require.Error(t, err)
+	})
+}
+
+func TestFetch(t *testing.T) {
+	testCases := []struct {
+		name               string
+		tickers            []types.ProviderTicker
+		client             func() cosmosclient.CosmosRESTClient
+		expected           map[types.ProviderTicker]*big.Float
+		expectedUnresolved []types.ProviderTicker
+	}{
+		{
+			name: "ticker without a pool config",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("ATOM/OSMO", ""),
+			},
+			client: func() cosmosclient.CosmosRESTClient {
+				return mocks.NewCosmosRESTClient(t)
+			},
+			expectedUnresolved: []types.ProviderTicker{types.NewProviderTicker("ATOM/OSMO", "")},
+		},
+		{
+			name:    "query fails",
+			tickers: []types.ProviderTicker{atomosmoTicker},
+			client: func() cosmosclient.CosmosRESTClient {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, atomosmoCfg.SpotPriceQueryPath()).Return(nil, fmt.Errorf("query failed"))
+				return c
+			},
+			expectedUnresolved: []types.ProviderTicker{atomosmoTicker},
+		},
+		{
+			name:    "invalid response",
+			tickers: []types.ProviderTicker{atomosmoTicker},
+			client: func() cosmosclient.CosmosRESTClient {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, atomosmoCfg.SpotPriceQueryPath()).Return(
+					&cosmosclient.Response{Body: []byte(`{"spot_price":"not a number"}`)}, nil,
+				)
+				return c
+			},
+			expectedUnresolved: []types.ProviderTicker{atomosmoTicker},
+		},
+		{
+			name:    "multiple tickers with one failure",
+			tickers: []types.ProviderTicker{atomosmoTicker, wethosmoTicker},
+			client: func() cosmosclient.CosmosRESTClient {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, atomosmoCfg.SpotPriceQueryPath()).Return(
+					&cosmosclient.Response{Height: 10, Body: []byte(`{"spot_price":"12.500000000000000000"}`)}, nil,
+				)
+				c.On("Get", mock.Anything, wethosmoCfg.SpotPriceQueryPath()).Return(nil, fmt.Errorf("query failed"))
+				return c
+			},
+			expected: map[types.ProviderTicker]*big.Float{
+				atomosmoTicker: big.NewFloat(12.5),
+			},
+			expectedUnresolved: []types.ProviderTicker{wethosmoTicker},
+		},
+		{
+			name:    "spot price is scaled by the asset decimals",
+			tickers: []types.ProviderTicker{wethosmoTicker},
+			client: func() cosmosclient.CosmosRESTClient {
+				c := mocks.NewCosmosRESTClient(t)
+				c.On("Get", mock.Anything, wethosmoCfg.SpotPriceQueryPath()).Return(
+					&cosmosclient.Response{Height: 10, Body: []byte(`{"spot_price":"0.000000005000000000"}`)}, nil,
+				)
+				return c
+			},
+			expected: map[types.ProviderTicker]*big.Float{
+				wethosmoTicker: big.NewFloat(5000),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher, err := osmosis.NewPriceFetcherWithClient(zap.NewNop(), osmosis.DefaultAPIConfig, tc.client())
+			require.NoError(t, err)
+
+			resp := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Len(t, resp.Resolved, len(tc.expected))
+			require.Len(t, resp.UnResolved, len(tc.expectedUnresolved))
+
+			for ticker, price := range tc.expected {
+				require.Contains(t, resp.Resolved, ticker)
+				require.Equal(t, price.SetPrec(18), resp.Resolved[ticker].Value.SetPrec(18))
+			}
+
+			for _, ticker := range tc.expectedUnresolved {
+				require.Contains(t, resp.UnResolved, ticker)
+				require.Error(t, resp.UnResolved[ticker])
+			}
+		})
+	}
+}
+
+func TestPoolConfig(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		require.NoError(t, atomosmoCfg.ValidateBasic())
+	})
+
+	t.Run("missing pool id", func(t *testing.T) {
+		cfg := atomosmoCfg
+		cfg.PoolID = 0
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("same base and quote denom", func(t *testing.T) {
+		cfg := atomosmoCfg
+		cfg.QuoteDenom = cfg.BaseDenom
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("query path escapes denoms", func(t *testing.T) {
+		require.Equal(
+			t,
+			"/osmosis/poolmanager/v1beta1/pools/1/prices?base_asset_denom=ibc%2F27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2&quote_asset_denom=uosmo",
+			atomosmoCfg.SpotPriceQueryPath(),
+		)
+	})
+}
@@ -0,0 +1,106 @@
+package osmosis
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+const (
+	// Name is the name of the Osmosis API.
+	Name = "osmosis_api"
+
+	// URL is the default REST (LCD) endpoint for the Osmosis API. This uses a free public endpoint
+	// on Osmosis mainnet.
+	URL = "https://rest.osmosis.zone"
+
+	// SpotPricePath is the path of the poolmanager spot price query. The pool id, base asset denom
+	// and quote asset denom must be filled in, in that order.
+	SpotPricePath = "/osmosis/poolmanager/v1beta1/pools/%d/prices?base_asset_denom=%s&quote_asset_denom=%s"
+)
+
+// PoolConfig is the configuration for an Osmosis pool. This is specific to each pair of assets
+// and is expected to be set as the metadata JSON of the ticker.
+type PoolConfig struct {
+	// PoolID is the id of the Osmosis pool.
+	PoolID uint64 `json:"pool_id"`
+	// BaseDenom is the denom of the base asset, e.g. an ibc/... denom.
+	BaseDenom string `json:"base_denom"`
+	// QuoteDenom is the denom of the quote asset.
+	QuoteDenom string `json:"quote_denom"`
+	// BaseDecimals is the number of decimals of the base asset.
+	BaseDecimals int64 `json:"base_decimals"`
+	// QuoteDecimals is the number of decimals of the quote asset.
+	QuoteDecimals int64 `json:"quote_decimals"`
+}
+
+// ValidateBasic validates the pool configuration.
+func (pc *PoolConfig) ValidateBasic() error {
+	if pc.PoolID == 0 {
+		return fmt.Errorf("pool id must be set")
+	}
+
+	if len(strings.TrimSpace(pc.BaseDenom)) == 0 {
+		return fmt.Errorf("base denom must be set")
+	}
+
+	if len(strings.TrimSpace(pc.QuoteDenom)) == 0 {
+		return fmt.Errorf("quote denom must be set")
+	}
+
+	if pc.BaseDenom == pc.QuoteDenom {
+		return fmt.Errorf("base and quote denom must be different")
+	}
+
+	if pc.BaseDecimals < 0 {
+		return fmt.Errorf("base decimals must be non-negative")
+	}
+
+	if pc.QuoteDecimals < 0 {
+		return fmt.Errorf("quote decimals must be non-negative")
+	}
+
+	return nil
+}
+
+// MustToJSON converts the pool configuration to JSON.
+func (pc PoolConfig) MustToJSON() string {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// SpotPriceQueryPath returns the path of the spot price query for the pool.
+func (pc PoolConfig) SpotPriceQueryPath() string {
+	return fmt.Sprintf(
+		SpotPricePath,
+		pc.PoolID,
+		url.QueryEscape(pc.BaseDenom),
+		url.QueryEscape(pc.QuoteDenom),
+	)
+}
+
+// SpotPriceResponse is the response of the poolmanager spot price query.
+type SpotPriceResponse struct {
+	// SpotPrice is the price of one base unit of the base asset in base units of the quote asset.
+	SpotPrice string `json:"spot_price"`
+}
+
+// DefaultAPIConfig is the default configuration for the Osmosis API.
+var DefaultAPIConfig = config.APIConfig{
+	Name:             Name,
+	Atomic:           false,
+	Enabled:          true,
+	Timeout:          2000 * time.Millisecond,
+	Interval:         1000 * time.Millisecond,
+	ReconnectTimeout: 2000 * time.Millisecond,
+	MaxQueries:       1,
+	BatchSize:        10,
+	Endpoints:        []config.Endpoint{{URL: URL}},
+}
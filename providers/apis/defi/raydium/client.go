@@ -35,10 +35,6 @@ func NewJSONRPCClient(
 		return nil, fmt.Errorf("invalid api config: %w", err)
 	}
 
-	if api.Name != Name {
-		return nil, fmt.Errorf("invalid api name; expected %s, got %s", Name, api.Name)
-	}
-
 	if !api.Enabled {
 		return nil, fmt.Errorf("api is not enabled")
 	}
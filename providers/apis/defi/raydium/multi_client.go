@@ -58,10 +58,6 @@ func NewMultiJSONRPCClientFromEndpoints(
 		return nil, fmt.Errorf("api is not enabled")
 	}
 
-	if api.Name != Name {
-		return nil, fmt.Errorf("invalid api name; expected %s, got %s", Name, api.Name)
-	}
-
 	if len(api.Endpoints) == 0 {
 		return nil, fmt.Errorf("no endpoints provided")
 	}
@@ -80,7 +76,7 @@ func NewMultiJSONRPCClientFromEndpoints(
 	}
 
 	return &MultiJSONRPCClient{
-		logger:     logger.With(zap.String("multi_client", Name)),
+		logger:     logger.With(zap.String("multi_client", api.Name)),
 		api:        api,
 		apiMetrics: apiMetrics,
 		clients:    clients,
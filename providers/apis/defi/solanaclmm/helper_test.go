@@ -0,0 +1,84 @@
+package solanaclmm_test
+
+import (
+	"bytes"
+	"testing"
+
+	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm"
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm/schema"
+)
+
+var (
+	solMint  = solana.MustPublicKeyFromBase58("So11111111111111111111111111111111111111112")
+	usdcMint = solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	// sqrtPriceQuarter is the Q64.64 sqrt price of a raw price of 0.25, i.e. 0.5 * 2^64.
+	sqrtPriceQuarter = ag_binary.Uint128{Lo: 1 << 63}
+	// sqrtPriceFour is the Q64.64 sqrt price of a raw price of 4, i.e. 2 * 2^64.
+	sqrtPriceFour = ag_binary.Uint128{Hi: 2}
+
+	// Pool configs used for testing. Both pools price SOL at 250 USDC.
+	raydiumSOLUSDCCfg = solanaclmm.PoolConfig{
+		PoolAddress:    "2QdhepnKRTLjjSqPL1PtKNwqrUkoLee5Gqs8bvZhRdMv",
+		BaseTokenMint:  solMint.String(),
+		QuoteTokenMint: usdcMint.String(),
+		BaseDecimals:   9,
+		QuoteDecimals:  6,
+	}
+	orcaSOLUSDCCfg = solanaclmm.PoolConfig{
+		PoolAddress:    "Czfq3xZZDmsdGdUyrNLtRhGc47cXcZtLG4crryfu44zE",
+		BaseTokenMint:  solMint.String(),
+		QuoteTokenMint: usdcMint.String(),
+		BaseDecimals:   9,
+		QuoteDecimals:  6,
+	}
+
+	// Tickers used for testing.
+	raydiumSOLUSDCTicker = types.NewProviderTicker("SOL/USDC", raydiumSOLUSDCCfg.MustToJSON())
+	orcaSOLUSDCTicker    = types.NewProviderTicker("SOL/USDC", orcaSOLUSDCCfg.MustToJSON())
+)
+
+// raydiumPoolAccount returns a Raydium CLMM PoolState account with the given mints and sqrt price.
+func raydiumPoolAccount(t *testing.T, mint0, mint1 solana.PublicKey, sqrtPrice ag_binary.Uint128) *rpc.Account {
+	t.Helper()
+
+	state := schema.PoolState{
+		TokenMint0:   mint0,
+		TokenMint1:   mint1,
+		SqrtPriceX64: sqrtPrice,
+	}
+	copy(state.Discriminator[:], schema.PoolStateDiscriminator)
+
+	return encodeAccount(t, state)
+}
+
+// whirlpoolAccount returns an Orca Whirlpool account with the given mints and sqrt price.
+func whirlpoolAccount(t *testing.T, mintA, mintB solana.PublicKey, sqrtPrice ag_binary.Uint128) *rpc.Account {
+	t.Helper()
+
+	whirlpool := schema.Whirlpool{
+		TokenMintA: mintA,
+		TokenMintB: mintB,
+		SqrtPrice:  sqrtPrice,
+	}
+	copy(whirlpool.Discriminator[:], schema.WhirlpoolDiscriminator)
+
+	return encodeAccount(t, whirlpool)
+}
+
+func encodeAccount(t *testing.T, obj interface{}) *rpc.Account {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, ag_binary.NewBinEncoder(buf).Encode(obj))
+
+	return &rpc.Account{
+		Data: rpc.DataBytesOrJSONFromBytes(buf.Bytes()),
+	}
+}
@@ -0,0 +1,38 @@
+package solanaclmm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+// Q64 is 2^64, the fixed point scaling of the sqrt price of concentrated liquidity pools.
+var Q64 = new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 64))
+
+// CalculatePrice calculates the price of the base token in terms of the quote token from the
+// Q64.64 sqrt price of a concentrated liquidity pool. The sqrt price encodes the price of token0
+// (token A) in terms of token1 (token B) in the token's smallest units, i.e.
+//
+// price(token0) = (sqrtPriceX64 / 2^64)^2.
+//
+// If the base token is token1, the price is inverted. The price is then normalized by the
+// difference in token decimals.
+func CalculatePrice(
+	sqrtPriceX64 *big.Int,
+	baseIsToken0 bool,
+	baseDecimals, quoteDecimals uint64,
+) (*big.Float, error) {
+	if sqrtPriceX64 == nil || sqrtPriceX64.Sign() <= 0 {
+		return nil, fmt.Errorf("sqrt price must be positive, got %v", sqrtPriceX64)
+	}
+
+	sqrtPrice := new(big.Float).Quo(new(big.Float).SetInt(sqrtPriceX64), Q64)
+	price := new(big.Float).Mul(sqrtPrice, sqrtPrice)
+	if !baseIsToken0 {
+		price = new(big.Float).Quo(big.NewFloat(1), price)
+	}
+
+	scalingFactor := math.GetScalingFactor(int64(baseDecimals), int64(quoteDecimals))
+	return new(big.Float).Mul(price, scalingFactor), nil
+}
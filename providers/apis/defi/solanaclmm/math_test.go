@@ -0,0 +1,78 @@
+package solanaclmm_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm"
+)
+
+func TestCalculatePrice(t *testing.T) {
+	testCases := []struct {
+		name          string
+		sqrtPriceX64  *big.Int
+		baseIsToken0  bool
+		baseDecimals  uint64
+		quoteDecimals uint64
+		expected      *big.Float
+		expectErr     bool
+	}{
+		{
+			name:         "nil sqrt price",
+			sqrtPriceX64: nil,
+			expectErr:    true,
+		},
+		{
+			name:         "zero sqrt price",
+			sqrtPriceX64: big.NewInt(0),
+			expectErr:    true,
+		},
+		{
+			name:          "sqrt price of 1 with equal decimals",
+			sqrtPriceX64:  new(big.Int).Lsh(big.NewInt(1), 64),
+			baseIsToken0:  true,
+			baseDecimals:  6,
+			quoteDecimals: 6,
+			expected:      big.NewFloat(1),
+		},
+		{
+			name:          "base is token0",
+			sqrtPriceX64:  sqrtPriceQuarter.BigInt(),
+			baseIsToken0:  true,
+			baseDecimals:  9,
+			quoteDecimals: 6,
+			expected:      big.NewFloat(250),
+		},
+		{
+			name:          "base is token1",
+			sqrtPriceX64:  sqrtPriceFour.BigInt(),
+			baseIsToken0:  false,
+			baseDecimals:  9,
+			quoteDecimals: 6,
+			expected:      big.NewFloat(250),
+		},
+		{
+			name:          "quote has more decimals than base",
+			sqrtPriceX64:  sqrtPriceFour.BigInt(),
+			baseIsToken0:  true,
+			baseDecimals:  6,
+			quoteDecimals: 8,
+			expected:      big.NewFloat(0.04),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := solanaclmm.CalculatePrice(tc.sqrtPriceX64, tc.baseIsToken0, tc.baseDecimals, tc.quoteDecimals)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.SetPrec(18), price.SetPrec(18))
+		})
+	}
+}
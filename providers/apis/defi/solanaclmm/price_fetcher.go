@@ -0,0 +1,317 @@
+package solanaclmm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	oracletypes "github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/raydium"
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm/schema"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ oracletypes.PriceAPIFetcher = (*APIPriceFetcher)(nil)
+
+// pool is the subset of a concentrated liquidity pool's state that is required to price it.
+type pool struct {
+	mint0        solana.PublicKey
+	mint1        solana.PublicKey
+	sqrtPriceX64 *big.Int
+}
+
+// APIPriceFetcher is responsible for querying the accounts of concentrated liquidity pools on
+// Solana, i.e. Raydium CLMM pools or Orca Whirlpools, and deriving the price of a given ticker
+// from the pool's sqrt price. The type of pool is determined by the name of the provider.
+//
+// The pool accounts of all tickers are read with a single getMultipleAccounts query, using the
+// same (multi-endpoint) JSON-RPC client as the Raydium AMM provider.
+type APIPriceFetcher struct {
+	// config is the APIConfiguration for this provider
+	api config.APIConfig
+
+	// client is the solana JSON-RPC client used to query the API.
+	client raydium.SolanaJSONRPCClient
+
+	// decodePool decodes the pool account of the configured pool type.
+	decodePool func(data []byte) (pool, error)
+
+	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	poolCache map[oracletypes.ProviderTicker]PoolConfig
+	mtx       sync.Mutex
+
+	// logger
+	logger *zap.Logger
+}
+
+// NewAPIPriceFetcher returns a new APIPriceFetcher. This method constructs the
+// default solana JSON-RPC client in accordance with the config's endpoints.
+func NewAPIPriceFetcher(
+	logger *zap.Logger,
+	api config.APIConfig,
+	apiMetrics metrics.APIMetrics,
+) (*APIPriceFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("invalid api name %s", api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api is not enabled")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("metrics cannot be nil")
+	}
+
+	// use a multi-client if multiple endpoints are provided
+	var (
+		client raydium.SolanaJSONRPCClient
+		err    error
+	)
+	if len(api.Endpoints) > 1 {
+		client, err = raydium.NewMultiJSONRPCClientFromEndpoints(
+			logger,
+			api,
+			apiMetrics,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating multi-client: %w", err)
+		}
+	} else {
+		client, err = raydium.NewJSONRPCClient(api, apiMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("error creating client: %w", err)
+		}
+	}
+
+	return NewAPIPriceFetcherWithClient(
+		logger,
+		api,
+		client,
+	)
+}
+
+// NewAPIPriceFetcherWithClient returns a new APIPriceFetcher. This method requires
+// that the given config is valid, otherwise a nil implementation + an error
+// will be returned.
+func NewAPIPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client raydium.SolanaJSONRPCClient,
+) (*APIPriceFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("config for %s is invalid: %w", api.Name, err)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("config is not enabled")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+
+	var decodePool func(data []byte) (pool, error)
+	switch api.Name {
+	case RaydiumCLMMName:
+		decodePool = decodeRaydiumCLMMPool
+	case OrcaWhirlpoolName:
+		decodePool = decodeOrcaWhirlpool
+	default:
+		return nil, fmt.Errorf("invalid api name %s", api.Name)
+	}
+
+	return &APIPriceFetcher{
+		api:        api,
+		client:     client,
+		decodePool: decodePool,
+		poolCache:  make(map[oracletypes.ProviderTicker]PoolConfig),
+		logger:     logger.With(zap.String("fetcher", api.Name)),
+	}, nil
+}
+
+// Fetch fetches prices from the solana JSON-RPC API for the given currency-pairs. Specifically
+// for each currency-pair,
+//   - Query the pool account of the ticker
+//   - Decode the sqrt price and token mints of the pool
+//   - Calculate the price of the base token in terms of the quote token, normalized by the token
+//     decimals
+func (pf *APIPriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []oracletypes.ProviderTicker,
+) oracletypes.PriceResponse {
+	accounts := make([]solana.PublicKey, len(tickers))
+	pools := make([]PoolConfig, len(tickers))
+	for i, ticker := range tickers {
+		cfg, err := pf.GetPool(ticker)
+		if err != nil {
+			return oracletypes.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get pool for ticker %s: %w", ticker.String(), err),
+					providertypes.ErrorUnknownPair,
+				),
+			)
+		}
+
+		accounts[i] = solana.MustPublicKeyFromBase58(cfg.PoolAddress)
+		pools[i] = cfg
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pf.api.Timeout)
+	defer cancel()
+
+	accountsResp, err := pf.client.GetMultipleAccountsWithOpts(ctx, accounts, &rpc.GetMultipleAccountsOpts{
+		Commitment: rpc.CommitmentFinalized,
+	})
+	if err != nil {
+		pf.logger.Error("error querying accounts", zap.Error(err))
+		return oracletypes.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(
+				raydium.SolanaJSONRPCError(err),
+				providertypes.ErrorAPIGeneral,
+			),
+		)
+	}
+
+	// expect a pool account for each ticker queried
+	if len(accountsResp.Value) != len(accounts) {
+		return oracletypes.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(
+				raydium.SolanaJSONRPCError(fmt.Errorf("expected %d accounts, got %d", len(accounts), len(accountsResp.Value))),
+				providertypes.ErrorAPIGeneral,
+			),
+		)
+	}
+
+	resolved := make(oracletypes.ResolvedPrices)
+	unresolved := make(oracletypes.UnResolvedPrices)
+	for i, ticker := range tickers {
+		price, err := pf.calculatePrice(accountsResp.Value[i], pools[i])
+		if err != nil {
+			pf.logger.Debug(
+				"error calculating price",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+			continue
+		}
+
+		resolved[ticker] = oracletypes.NewPriceResult(price, time.Now().UTC())
+	}
+
+	return oracletypes.NewPriceResponse(resolved, unresolved)
+}
+
+// GetPool returns the pool config for the given ticker. This will unmarshal the metadata
+// and validate the pool config which contains all required information to query the pool.
+func (pf *APIPriceFetcher) GetPool(
+	ticker oracletypes.ProviderTicker,
+) (PoolConfig, error) {
+	pf.mtx.Lock()
+	defer pf.mtx.Unlock()
+
+	if cfg, ok := pf.poolCache[ticker]; ok {
+		return cfg, nil
+	}
+
+	var cfg PoolConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal pool config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker pool config: %w", err)
+	}
+
+	pf.poolCache[ticker] = cfg
+	return cfg, nil
+}
+
+// calculatePrice decodes the given pool account and derives the price of the base token.
+func (pf *APIPriceFetcher) calculatePrice(
+	account *rpc.Account,
+	cfg PoolConfig,
+) (*big.Float, error) {
+	if account == nil {
+		return nil, fmt.Errorf("account is nil")
+	}
+
+	if account.Data == nil {
+		return nil, fmt.Errorf("account data is nil")
+	}
+
+	p, err := pf.decodePool(account.Data.GetBinary())
+	if err != nil {
+		return nil, fmt.Errorf("error decoding pool: %w", err)
+	}
+
+	var baseIsToken0 bool
+	switch base, quote := cfg.BaseTokenMint, cfg.QuoteTokenMint; {
+	case p.mint0.String() == base && p.mint1.String() == quote:
+		baseIsToken0 = true
+	case p.mint1.String() == base && p.mint0.String() == quote:
+		baseIsToken0 = false
+	default:
+		return nil, fmt.Errorf("pool mints %s and %s do not match the configured base and quote mints", p.mint0, p.mint1)
+	}
+
+	return CalculatePrice(p.sqrtPriceX64, baseIsToken0, cfg.BaseDecimals, cfg.QuoteDecimals)
+}
+
+// decodeRaydiumCLMMPool decodes a Raydium CLMM PoolState account.
+func decodeRaydiumCLMMPool(data []byte) (pool, error) {
+	state, err := schema.DecodePoolState(data)
+	if err != nil {
+		return pool{}, err
+	}
+
+	return pool{
+		mint0:        state.TokenMint0,
+		mint1:        state.TokenMint1,
+		sqrtPriceX64: state.SqrtPriceX64.BigInt(),
+	}, nil
+}
+
+// decodeOrcaWhirlpool decodes an Orca Whirlpool account.
+func decodeOrcaWhirlpool(data []byte) (pool, error) {
+	whirlpool, err := schema.DecodeWhirlpool(data)
+	if err != nil {
+		return pool{}, err
+	}
+
+	return pool{
+		mint0:        whirlpool.TokenMintA,
+		mint1:        whirlpool.TokenMintB,
+		sqrtPriceX64: whirlpool.SqrtPrice.BigInt(),
+	}, nil
+}
@@ -0,0 +1,204 @@
+package solanaclmm_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/raydium"
+	"github.com/skip-mev/slinky/providers/apis/defi/raydium/mocks"
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm"
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm/schema"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+func TestNewAPIPriceFetcher(t *testing.T) {
+	t.Run("raydium clmm", func(t *testing.T) {
+		_, err := solanaclmm.NewAPIPriceFetcher(zap.NewNop(), solanaclmm.DefaultRaydiumCLMMAPIConfig, metrics.NewNopAPIMetrics())
+		require.NoError(t, err)
+	})
+
+	t.Run("orca whirlpool", func(t *testing.T) {
+		_, err := solanaclmm.NewAPIPriceFetcher(zap.NewNop(), solanaclmm.DefaultOrcaWhirlpoolAPIConfig, metrics.NewNopAPIMetrics())
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		cfg := solanaclmm.DefaultRaydiumCLMMAPIConfig
+		cfg.Name = raydium.Name
+
+		_, err := solanaclmm.NewAPIPriceFetcher(zap.NewNop(), cfg, metrics.NewNopAPIMetrics())
+		require.Error(t, err)
+	})
+
+	t.Run("nil client", func(t *testing.T) {
+		_, err := solanaclmm.NewAPIPriceFetcherWithClient(zap.NewNop(), solanaclmm.DefaultOrcaWhirlpoolAPIConfig, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestFetch(t *testing.T) {
+	invalidTicker := types.NewProviderTicker("SOL/USDC", `{"pool_address": "invalid"}`)
+
+	testCases := []struct {
+		name               string
+		apiName            string
+		tickers            []types.ProviderTicker
+		accounts           []*rpc.Account
+		err                error
+		expected           map[types.ProviderTicker]*big.Float
+		expectedUnresolved []types.ProviderTicker
+	}{
+		{
+			name:               "invalid pool config",
+			apiName:            solanaclmm.RaydiumCLMMName,
+			tickers:            []types.ProviderTicker{invalidTicker},
+			expectedUnresolved: []types.ProviderTicker{invalidTicker},
+		},
+		{
+			name:               "rpc error",
+			apiName:            solanaclmm.RaydiumCLMMName,
+			tickers:            []types.ProviderTicker{raydiumSOLUSDCTicker},
+			err:                fmt.Errorf("rpc error"),
+			expectedUnresolved: []types.ProviderTicker{raydiumSOLUSDCTicker},
+		},
+		{
+			name:               "unexpected number of accounts",
+			apiName:            solanaclmm.RaydiumCLMMName,
+			tickers:            []types.ProviderTicker{raydiumSOLUSDCTicker},
+			accounts:           []*rpc.Account{},
+			expectedUnresolved: []types.ProviderTicker{raydiumSOLUSDCTicker},
+		},
+		{
+			name:    "raydium clmm pool with base as token0",
+			apiName: solanaclmm.RaydiumCLMMName,
+			tickers: []types.ProviderTicker{raydiumSOLUSDCTicker},
+			accounts: []*rpc.Account{
+				raydiumPoolAccount(t, solMint, usdcMint, sqrtPriceQuarter),
+			},
+			expected: map[types.ProviderTicker]*big.Float{
+				raydiumSOLUSDCTicker: big.NewFloat(250),
+			},
+		},
+		{
+			name:    "raydium clmm pool with base as token1",
+			apiName: solanaclmm.RaydiumCLMMName,
+			tickers: []types.ProviderTicker{raydiumSOLUSDCTicker},
+			accounts: []*rpc.Account{
+				raydiumPoolAccount(t, usdcMint, solMint, sqrtPriceFour),
+			},
+			expected: map[types.ProviderTicker]*big.Float{
+				raydiumSOLUSDCTicker: big.NewFloat(250),
+			},
+		},
+		{
+			name:    "raydium clmm pool with mismatched mints",
+			apiName: solanaclmm.RaydiumCLMMName,
+			tickers: []types.ProviderTicker{raydiumSOLUSDCTicker},
+			accounts: []*rpc.Account{
+				raydiumPoolAccount(t, solMint, solana.SystemProgramID, sqrtPriceQuarter),
+			},
+			expectedUnresolved: []types.ProviderTicker{raydiumSOLUSDCTicker},
+		},
+		{
+			name:    "orca whirlpool",
+			apiName: solanaclmm.OrcaWhirlpoolName,
+			tickers: []types.ProviderTicker{orcaSOLUSDCTicker},
+			accounts: []*rpc.Account{
+				whirlpoolAccount(t, solMint, usdcMint, sqrtPriceQuarter),
+			},
+			expected: map[types.ProviderTicker]*big.Float{
+				orcaSOLUSDCTicker: big.NewFloat(250),
+			},
+		},
+		{
+			name:    "raydium account queried by the orca provider",
+			apiName: solanaclmm.OrcaWhirlpoolName,
+			tickers: []types.ProviderTicker{orcaSOLUSDCTicker},
+			accounts: []*rpc.Account{
+				raydiumPoolAccount(t, solMint, usdcMint, sqrtPriceQuarter),
+			},
+			expectedUnresolved: []types.ProviderTicker{orcaSOLUSDCTicker},
+		},
+		{
+			name:    "nil account",
+			apiName: solanaclmm.OrcaWhirlpoolName,
+			tickers: []types.ProviderTicker{orcaSOLUSDCTicker},
+			accounts: []*rpc.Account{
+				nil,
+			},
+			expectedUnresolved: []types.ProviderTicker{orcaSOLUSDCTicker},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := solanaclmm.DefaultRaydiumCLMMAPIConfig
+			if tc.apiName == solanaclmm.OrcaWhirlpoolName {
+				cfg = solanaclmm.DefaultOrcaWhirlpoolAPIConfig
+			}
+
+			client := mocks.NewSolanaJSONRPCClient(t)
+			if tc.accounts != nil || tc.err != nil {
+				var resp *rpc.GetMultipleAccountsResult
+				if tc.err == nil {
+					resp = &rpc.GetMultipleAccountsResult{Value: tc.accounts}
+				}
+				client.On("GetMultipleAccountsWithOpts", mock.Anything, mock.Anything, mock.Anything).Return(resp, tc.err).Once()
+			}
+
+			fetcher, err := solanaclmm.NewAPIPriceFetcherWithClient(zap.NewNop(), cfg, client)
+			require.NoError(t, err)
+
+			resp := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Len(t, resp.Resolved, len(tc.expected))
+			require.Len(t, resp.UnResolved, len(tc.expectedUnresolved))
+
+			for ticker, price := range tc.expected {
+				require.Contains(t, resp.Resolved, ticker)
+				require.Equal(t, price.SetPrec(18), resp.Resolved[ticker].Value.SetPrec(18))
+			}
+
+			for _, ticker := range tc.expectedUnresolved {
+				require.Contains(t, resp.UnResolved, ticker)
+				require.Error(t, resp.UnResolved[ticker])
+			}
+		})
+	}
+}
+
+// TestAccountLayouts checks that the sqrt price of each pool account is decoded from the offset
+// documented by the respective programs.
+func TestAccountLayouts(t *testing.T) {
+	sqrtPrice := ag_binary.Uint128{Lo: 0x0102030405060708, Hi: 0x1112131415161718}
+	expected := new(bytes.Buffer)
+	require.NoError(t, ag_binary.NewBinEncoder(expected).Encode(sqrtPrice))
+
+	t.Run("raydium clmm pool state", func(t *testing.T) {
+		bz := raydiumPoolAccount(t, solMint, usdcMint, sqrtPrice).Data.GetBinary()
+		require.Equal(t, expected.Bytes(), bz[253:269])
+
+		state, err := schema.DecodePoolState(bz)
+		require.NoError(t, err)
+		require.Equal(t, sqrtPrice.BigInt(), state.SqrtPriceX64.BigInt())
+	})
+
+	t.Run("orca whirlpool", func(t *testing.T) {
+		bz := whirlpoolAccount(t, solMint, usdcMint, sqrtPrice).Data.GetBinary()
+		require.Equal(t, expected.Bytes(), bz[65:81])
+
+		whirlpool, err := schema.DecodeWhirlpool(bz)
+		require.NoError(t, err)
+		require.Equal(t, sqrtPrice.BigInt(), whirlpool.SqrtPrice.BigInt())
+	})
+}
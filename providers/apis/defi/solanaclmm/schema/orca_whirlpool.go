@@ -0,0 +1,226 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+// WhirlpoolDiscriminator is the anchor account discriminator of the Orca Whirlpool account.
+var WhirlpoolDiscriminator = ag_binary.SighashAccount("Whirlpool")
+
+// Whirlpool is the leading section of the Orca Whirlpool account. Only the fields up to and
+// including the token vaults are decoded, as these are all that is required to price the pool.
+type Whirlpool struct {
+	Discriminator    [8]uint8
+	WhirlpoolsConfig ag_solanago.PublicKey
+	WhirlpoolBump    [1]uint8
+	TickSpacing      uint16
+	TickSpacingSeed  [2]uint8
+	FeeRate          uint16
+	ProtocolFeeRate  uint16
+	Liquidity        ag_binary.Uint128
+	SqrtPrice        ag_binary.Uint128
+	TickCurrentIndex int32
+	ProtocolFeeOwedA uint64
+	ProtocolFeeOwedB uint64
+	TokenMintA       ag_solanago.PublicKey
+	TokenVaultA      ag_solanago.PublicKey
+	FeeGrowthGlobalA ag_binary.Uint128
+	TokenMintB       ag_solanago.PublicKey
+	TokenVaultB      ag_solanago.PublicKey
+}
+
+func (obj Whirlpool) MarshalWithEncoder(encoder *ag_binary.Encoder) (err error) {
+	// Serialize `Discriminator` param:
+	err = encoder.Encode(obj.Discriminator)
+	if err != nil {
+		return err
+	}
+	// Serialize `WhirlpoolsConfig` param:
+	err = encoder.Encode(obj.WhirlpoolsConfig)
+	if err != nil {
+		return err
+	}
+	// Serialize `WhirlpoolBump` param:
+	err = encoder.Encode(obj.WhirlpoolBump)
+	if err != nil {
+		return err
+	}
+	// Serialize `TickSpacing` param:
+	err = encoder.Encode(obj.TickSpacing)
+	if err != nil {
+		return err
+	}
+	// Serialize `TickSpacingSeed` param:
+	err = encoder.Encode(obj.TickSpacingSeed)
+	if err != nil {
+		return err
+	}
+	// Serialize `FeeRate` param:
+	err = encoder.Encode(obj.FeeRate)
+	if err != nil {
+		return err
+	}
+	// Serialize `ProtocolFeeRate` param:
+	err = encoder.Encode(obj.ProtocolFeeRate)
+	if err != nil {
+		return err
+	}
+	// Serialize `Liquidity` param:
+	err = encoder.Encode(obj.Liquidity)
+	if err != nil {
+		return err
+	}
+	// Serialize `SqrtPrice` param:
+	err = encoder.Encode(obj.SqrtPrice)
+	if err != nil {
+		return err
+	}
+	// Serialize `TickCurrentIndex` param:
+	err = encoder.Encode(obj.TickCurrentIndex)
+	if err != nil {
+		return err
+	}
+	// Serialize `ProtocolFeeOwedA` param:
+	err = encoder.Encode(obj.ProtocolFeeOwedA)
+	if err != nil {
+		return err
+	}
+	// Serialize `ProtocolFeeOwedB` param:
+	err = encoder.Encode(obj.ProtocolFeeOwedB)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenMintA` param:
+	err = encoder.Encode(obj.TokenMintA)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenVaultA` param:
+	err = encoder.Encode(obj.TokenVaultA)
+	if err != nil {
+		return err
+	}
+	// Serialize `FeeGrowthGlobalA` param:
+	err = encoder.Encode(obj.FeeGrowthGlobalA)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenMintB` param:
+	err = encoder.Encode(obj.TokenMintB)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenVaultB` param:
+	err = encoder.Encode(obj.TokenVaultB)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Whirlpool) UnmarshalWithDecoder(decoder *ag_binary.Decoder) (err error) {
+	// Deserialize `Discriminator`:
+	err = decoder.Decode(&obj.Discriminator)
+	if err != nil {
+		return err
+	}
+	// Deserialize `WhirlpoolsConfig`:
+	err = decoder.Decode(&obj.WhirlpoolsConfig)
+	if err != nil {
+		return err
+	}
+	// Deserialize `WhirlpoolBump`:
+	err = decoder.Decode(&obj.WhirlpoolBump)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TickSpacing`:
+	err = decoder.Decode(&obj.TickSpacing)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TickSpacingSeed`:
+	err = decoder.Decode(&obj.TickSpacingSeed)
+	if err != nil {
+		return err
+	}
+	// Deserialize `FeeRate`:
+	err = decoder.Decode(&obj.FeeRate)
+	if err != nil {
+		return err
+	}
+	// Deserialize `ProtocolFeeRate`:
+	err = decoder.Decode(&obj.ProtocolFeeRate)
+	if err != nil {
+		return err
+	}
+	// Deserialize `Liquidity`:
+	err = decoder.Decode(&obj.Liquidity)
+	if err != nil {
+		return err
+	}
+	// Deserialize `SqrtPrice`:
+	err = decoder.Decode(&obj.SqrtPrice)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TickCurrentIndex`:
+	err = decoder.Decode(&obj.TickCurrentIndex)
+	if err != nil {
+		return err
+	}
+	// Deserialize `ProtocolFeeOwedA`:
+	err = decoder.Decode(&obj.ProtocolFeeOwedA)
+	if err != nil {
+		return err
+	}
+	// Deserialize `ProtocolFeeOwedB`:
+	err = decoder.Decode(&obj.ProtocolFeeOwedB)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenMintA`:
+	err = decoder.Decode(&obj.TokenMintA)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenVaultA`:
+	err = decoder.Decode(&obj.TokenVaultA)
+	if err != nil {
+		return err
+	}
+	// Deserialize `FeeGrowthGlobalA`:
+	err = decoder.Decode(&obj.FeeGrowthGlobalA)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenMintB`:
+	err = decoder.Decode(&obj.TokenMintB)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenVaultB`:
+	err = decoder.Decode(&obj.TokenVaultB)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeWhirlpool decodes an Orca Whirlpool account, verifying its discriminator.
+func DecodeWhirlpool(data []byte) (Whirlpool, error) {
+	var obj Whirlpool
+	if err := ag_binary.NewBinDecoder(data).Decode(&obj); err != nil {
+		return Whirlpool{}, err
+	}
+
+	if !bytes.Equal(obj.Discriminator[:], WhirlpoolDiscriminator) {
+		return Whirlpool{}, fmt.Errorf("account is not an orca whirlpool")
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,204 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+// PoolStateDiscriminator is the anchor account discriminator of the Raydium CLMM PoolState account.
+var PoolStateDiscriminator = ag_binary.SighashAccount("PoolState")
+
+// PoolState is the leading section of the Raydium CLMM PoolState account. Only the fields up to
+// and including the current tick are decoded, as these are all that is required to price the pool.
+type PoolState struct {
+	Discriminator  [8]uint8
+	Bump           [1]uint8
+	AmmConfig      ag_solanago.PublicKey
+	Owner          ag_solanago.PublicKey
+	TokenMint0     ag_solanago.PublicKey
+	TokenMint1     ag_solanago.PublicKey
+	TokenVault0    ag_solanago.PublicKey
+	TokenVault1    ag_solanago.PublicKey
+	ObservationKey ag_solanago.PublicKey
+	MintDecimals0  uint8
+	MintDecimals1  uint8
+	TickSpacing    uint16
+	Liquidity      ag_binary.Uint128
+	SqrtPriceX64   ag_binary.Uint128
+	TickCurrent    int32
+}
+
+func (obj PoolState) MarshalWithEncoder(encoder *ag_binary.Encoder) (err error) {
+	// Serialize `Discriminator` param:
+	err = encoder.Encode(obj.Discriminator)
+	if err != nil {
+		return err
+	}
+	// Serialize `Bump` param:
+	err = encoder.Encode(obj.Bump)
+	if err != nil {
+		return err
+	}
+	// Serialize `AmmConfig` param:
+	err = encoder.Encode(obj.AmmConfig)
+	if err != nil {
+		return err
+	}
+	// Serialize `Owner` param:
+	err = encoder.Encode(obj.Owner)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenMint0` param:
+	err = encoder.Encode(obj.TokenMint0)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenMint1` param:
+	err = encoder.Encode(obj.TokenMint1)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenVault0` param:
+	err = encoder.Encode(obj.TokenVault0)
+	if err != nil {
+		return err
+	}
+	// Serialize `TokenVault1` param:
+	err = encoder.Encode(obj.TokenVault1)
+	if err != nil {
+		return err
+	}
+	// Serialize `ObservationKey` param:
+	err = encoder.Encode(obj.ObservationKey)
+	if err != nil {
+		return err
+	}
+	// Serialize `MintDecimals0` param:
+	err = encoder.Encode(obj.MintDecimals0)
+	if err != nil {
+		return err
+	}
+	// Serialize `MintDecimals1` param:
+	err = encoder.Encode(obj.MintDecimals1)
+	if err != nil {
+		return err
+	}
+	// Serialize `TickSpacing` param:
+	err = encoder.Encode(obj.TickSpacing)
+	if err != nil {
+		return err
+	}
+	// Serialize `Liquidity` param:
+	err = encoder.Encode(obj.Liquidity)
+	if err != nil {
+		return err
+	}
+	// Serialize `SqrtPriceX64` param:
+	err = encoder.Encode(obj.SqrtPriceX64)
+	if err != nil {
+		return err
+	}
+	// Serialize `TickCurrent` param:
+	err = encoder.Encode(obj.TickCurrent)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PoolState) UnmarshalWithDecoder(decoder *ag_binary.Decoder) (err error) {
+	// Deserialize `Discriminator`:
+	err = decoder.Decode(&obj.Discriminator)
+	if err != nil {
+		return err
+	}
+	// Deserialize `Bump`:
+	err = decoder.Decode(&obj.Bump)
+	if err != nil {
+		return err
+	}
+	// Deserialize `AmmConfig`:
+	err = decoder.Decode(&obj.AmmConfig)
+	if err != nil {
+		return err
+	}
+	// Deserialize `Owner`:
+	err = decoder.Decode(&obj.Owner)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenMint0`:
+	err = decoder.Decode(&obj.TokenMint0)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenMint1`:
+	err = decoder.Decode(&obj.TokenMint1)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenVault0`:
+	err = decoder.Decode(&obj.TokenVault0)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TokenVault1`:
+	err = decoder.Decode(&obj.TokenVault1)
+	if err != nil {
+		return err
+	}
+	// Deserialize `ObservationKey`:
+	err = decoder.Decode(&obj.ObservationKey)
+	if err != nil {
+		return err
+	}
+	// Deserialize `MintDecimals0`:
+	err = decoder.Decode(&obj.MintDecimals0)
+	if err != nil {
+		return err
+	}
+	// Deserialize `MintDecimals1`:
+	err = decoder.Decode(&obj.MintDecimals1)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TickSpacing`:
+	err = decoder.Decode(&obj.TickSpacing)
+	if err != nil {
+		return err
+	}
+	// Deserialize `Liquidity`:
+	err = decoder.Decode(&obj.Liquidity)
+	if err != nil {
+		return err
+	}
+	// Deserialize `SqrtPriceX64`:
+	err = decoder.Decode(&obj.SqrtPriceX64)
+	if err != nil {
+		return err
+	}
+	// Deserialize `TickCurrent`:
+	err = decoder.Decode(&obj.TickCurrent)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodePoolState decodes a Raydium CLMM PoolState account, verifying its discriminator.
+func DecodePoolState(data []byte) (PoolState, error) {
+	var obj PoolState
+	if err := ag_binary.NewBinDecoder(data).Decode(&obj); err != nil {
+		return PoolState{}, err
+	}
+
+	if !bytes.Equal(obj.Discriminator[:], PoolStateDiscriminator) {
+		return PoolState{}, fmt.Errorf("account is not a raydium clmm pool state")
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,102 @@
+package solanaclmm
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+const (
+	// RaydiumCLMMName is the name of the Raydium CLMM (concentrated liquidity) API.
+	RaydiumCLMMName = "raydium_clmm_api"
+
+	// OrcaWhirlpoolName is the name of the Orca Whirlpool API.
+	OrcaWhirlpoolName = "orca_whirlpool_api"
+
+	// URL is the default Solana JSON-RPC endpoint.
+	URL = "https://api.mainnet-beta.solana.com"
+)
+
+// IsValidProviderName returns true if the given name is the name of a supported concentrated
+// liquidity pool provider.
+func IsValidProviderName(name string) bool {
+	return name == RaydiumCLMMName || name == OrcaWhirlpoolName
+}
+
+// PoolConfig is the configuration for a concentrated liquidity pool. This is specific to each
+// pair of tokens and is expected to be set as the metadata JSON of the ticker.
+type PoolConfig struct {
+	// PoolAddress is the base58 encoded address of the pool account, i.e. the Raydium CLMM
+	// PoolState or the Orca Whirlpool account.
+	PoolAddress string `json:"pool_address"`
+	// BaseTokenMint is the base58 encoded mint of the base token.
+	BaseTokenMint string `json:"base_token_mint"`
+	// QuoteTokenMint is the base58 encoded mint of the quote token.
+	QuoteTokenMint string `json:"quote_token_mint"`
+	// BaseDecimals is the number of decimals of the base token.
+	BaseDecimals uint64 `json:"base_decimals"`
+	// QuoteDecimals is the number of decimals of the quote token.
+	QuoteDecimals uint64 `json:"quote_decimals"`
+}
+
+// ValidateBasic validates the pool configuration.
+func (pc *PoolConfig) ValidateBasic() error {
+	if _, err := solana.PublicKeyFromBase58(pc.PoolAddress); err != nil {
+		return fmt.Errorf("invalid pool address: %w", err)
+	}
+
+	if _, err := solana.PublicKeyFromBase58(pc.BaseTokenMint); err != nil {
+		return fmt.Errorf("invalid base token mint: %w", err)
+	}
+
+	if _, err := solana.PublicKeyFromBase58(pc.QuoteTokenMint); err != nil {
+		return fmt.Errorf("invalid quote token mint: %w", err)
+	}
+
+	if pc.BaseTokenMint == pc.QuoteTokenMint {
+		return fmt.Errorf("base and quote token mint must be different")
+	}
+
+	return nil
+}
+
+// MustToJSON converts the pool configuration to JSON.
+func (pc PoolConfig) MustToJSON() string {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+var (
+	// DefaultRaydiumCLMMAPIConfig is the default configuration for the Raydium CLMM API.
+	DefaultRaydiumCLMMAPIConfig = config.APIConfig{
+		Enabled:          true,
+		Name:             RaydiumCLMMName,
+		Timeout:          2 * time.Second,
+		Interval:         500 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       10,
+		Atomic:           false,
+		BatchSize:        50, // a single account is queried per ticker, at most 100 accounts per query
+		Endpoints:        []config.Endpoint{{URL: URL}},
+	}
+
+	// DefaultOrcaWhirlpoolAPIConfig is the default configuration for the Orca Whirlpool API.
+	DefaultOrcaWhirlpoolAPIConfig = config.APIConfig{
+		Enabled:          true,
+		Name:             OrcaWhirlpoolName,
+		Timeout:          2 * time.Second,
+		Interval:         500 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       10,
+		Atomic:           false,
+		BatchSize:        50, // a single account is queried per ticker, at most 100 accounts per query
+		Endpoints:        []config.Endpoint{{URL: URL}},
+	}
+)
@@ -0,0 +1,312 @@
+package uniswapv2_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient/mocks"
+	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv2"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+const (
+	// wethusdcReserves are the reserves of the USDC/WETH pair, i.e. 30,000,000 USDC and 10,000 WETH.
+	wethusdcReserves = "0x00000000000000000000000000000000000000000000000000001b48eb57e00000000000000000000000000000000000000000000000021e19e0c9bab2400000000000000000000000000000000000000000000000000000000000006553f100"
+
+	// emptyReserves are the reserves of a pair without liquidity.
+	emptyReserves = "0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+)
+
+func TestFetch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tickers  []types.ProviderTicker
+		client   func() ethmulticlient.EVMClient
+		expected types.PriceResponse
+	}{
+		{
+			name:    "no tickers",
+			tickers: []types.ProviderTicker{},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				c.On("BatchCallContext", context.Background(), []rpc.BatchElem{}).Return(nil)
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved:   map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+		{
+			name: "fails to retrieve pool for an empty ticker",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("WETH/USDC", ""),
+			},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					types.NewProviderTicker("WETH/USDC", ""): {},
+				},
+			},
+		},
+		{
+			name: "fails to make a batch call",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, fmt.Errorf("failed to make a batch call"), nil, nil)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request has an error for a single ticker",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{""},
+					[]error{fmt.Errorf("request for ticker did not return a result")},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request returns a result that cannot be parsed",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, nil, []string{"not a valid result"}, []error{nil})
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "pair has no liquidity",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, nil, []string{emptyReserves}, []error{nil})
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "weth/usdc result",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, nil, []string{wethusdcReserves}, []error{nil})
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					wethusdcTicker: {
+						Value: big.NewFloat(3000),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := createPriceFetcherWithClient(t, tc.client())
+
+			response := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Equal(t, len(tc.expected.Resolved), len(response.Resolved))
+			require.Equal(t, len(tc.expected.UnResolved), len(response.UnResolved))
+
+			for ticker, result := range tc.expected.Resolved {
+				require.Contains(t, response.Resolved, ticker)
+				require.Equal(t, result.Value.SetPrec(40), response.Resolved[ticker].Value.SetPrec(40))
+			}
+
+			for ticker := range tc.expected.UnResolved {
+				require.Contains(t, response.UnResolved, ticker)
+			}
+		})
+	}
+}
+
+func TestGetPool(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("ticker is empty", func(t *testing.T) {
+		ticker := types.NewProviderTicker("", "")
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker does not have valid metadata", func(t *testing.T) {
+		expected := uniswapv2.PoolConfig{
+			Address: "0x1234",
+		}
+		ticker := types.NewProviderTicker("WETH/USDC", expected.MustToJSON())
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker is not json formatted", func(t *testing.T) {
+		ticker := types.NewProviderTicker("WETH/USDC", "not json, something else")
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker has valid metadata", func(t *testing.T) {
+		ticker := types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+		pool, err := fetcher.GetPool(ticker)
+		require.NoError(t, err)
+		require.Equal(t, wethusdcCfg, pool)
+	})
+}
+
+func TestParseReserves(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("result does not map to a string pointer", func(t *testing.T) {
+		_, _, err := fetcher.ParseReserves(42)
+		require.Error(t, err)
+	})
+
+	t.Run("result is a nil string pointer", func(t *testing.T) {
+		_, _, err := fetcher.ParseReserves((*string)(nil))
+		require.Error(t, err)
+	})
+
+	t.Run("result is a empty string pointer", func(t *testing.T) {
+		_, _, err := fetcher.ParseReserves(new(string))
+		require.Error(t, err)
+	})
+
+	t.Run("result cannot be unpacked by the uniswap v2 abi", func(t *testing.T) {
+		result := new(string)
+		*result = "0x1234"
+		_, _, err := fetcher.ParseReserves(result)
+		require.Error(t, err)
+	})
+
+	t.Run("result for USDC/WETH", func(t *testing.T) {
+		result := new(string)
+		*result = wethusdcReserves
+		reserve0, reserve1, err := fetcher.ParseReserves(result)
+		require.NoError(t, err)
+
+		expectedReserve0, ok := new(big.Int).SetString("30000000000000", 10)
+		require.True(t, ok)
+		require.Equal(t, expectedReserve0, reserve0)
+
+		expectedReserve1, ok := new(big.Int).SetString("10000000000000000000000", 10)
+		require.True(t, ok)
+		require.Equal(t, expectedReserve1, reserve1)
+	})
+}
+
+func TestNewPriceFetcher(t *testing.T) {
+	ctx := context.TODO()
+
+	testcases := []struct {
+		name   string
+		logger *zap.Logger
+		api    config.APIConfig
+		err    error
+	}{
+		{
+			name:   "no logger errors",
+			logger: nil,
+			err:    fmt.Errorf("logger cannot be nil"),
+		},
+		{
+			name:   "invalid api config errors",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled: true,
+			},
+			err: fmt.Errorf("invalid api config: "),
+		},
+		{
+			name:   "invalid provider name errors",
+			logger: logger,
+			api: config.APIConfig{
+				Name: "uniswapv2_api-foobar",
+			},
+			err: fmt.Errorf("invalid api config name uniswapv2_api-foobar"),
+		},
+		{
+			name:   "disabled api config errors",
+			logger: logger,
+			api: config.APIConfig{
+				Name: "uniswapv2_api-ethereum",
+			},
+			err: fmt.Errorf("api config for uniswapv2_api-ethereum is not enabled"),
+		},
+		{
+			name:   "url success",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled:          true,
+				Timeout:          1,
+				ReconnectTimeout: 1,
+				Interval:         1,
+				MaxQueries:       1,
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:0"}},
+				Name:             "uniswapv2_api-ethereum",
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			pf, err := uniswapv2.NewPriceFetcher(
+				ctx,
+				tc.logger,
+				metrics.NewNopAPIMetrics(),
+				tc.api,
+			)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, pf)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package uniswapv2_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient/mocks"
+	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv2"
+)
+
+var (
+	logger, _ = zap.NewDevelopment()
+
+	// PoolConfigs used for testing. WETH is token1 of the USDC/WETH pair.
+	wethusdcCfg = uniswapv2.PoolConfig{
+		Address:       "0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc",
+		BaseDecimals:  18,
+		QuoteDecimals: 6,
+		Invert:        true,
+	}
+
+	// Tickers used for testing.
+	wethusdcTicker = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+)
+
+func createPriceFetcher(
+	t *testing.T,
+) *uniswapv2.PriceFetcher {
+	t.Helper()
+
+	client := mocks.NewEVMClient(t)
+	fetcher, err := uniswapv2.NewPriceFetcherWithClient(
+		logger,
+		uniswapv2.DefaultETHAPIConfig,
+		client,
+	)
+	require.NoError(t, err)
+
+	return fetcher
+}
+
+func createPriceFetcherWithClient(
+	t *testing.T,
+	client ethmulticlient.EVMClient,
+) *uniswapv2.PriceFetcher {
+	t.Helper()
+
+	fetcher, err := uniswapv2.NewPriceFetcherWithClient(
+		logger,
+		uniswapv2.DefaultETHAPIConfig,
+		client,
+	)
+	require.NoError(t, err)
+
+	return fetcher
+}
+
+func createEVMClientWithResponse(
+	t *testing.T,
+	failedRequestErr error,
+	responses []string,
+	errs []error,
+) ethmulticlient.EVMClient {
+	t.Helper()
+
+	c := mocks.NewEVMClient(t)
+	if failedRequestErr != nil {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(failedRequestErr)
+	} else {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			elems, ok := args.Get(1).([]rpc.BatchElem)
+			require.True(t, ok)
+
+			require.True(t, ok)
+			require.Equal(t, len(elems), len(responses))
+			require.Equal(t, len(elems), len(errs))
+
+			for i, elem := range elems {
+				elem.Result = &responses[i]
+				elem.Error = errs[i]
+				elems[i] = elem
+			}
+		})
+	}
+
+	return c
+}
@@ -0,0 +1,42 @@
+package uniswapv2
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+// CalculatePrice calculates the price of the base token in terms of the quote token from the
+// reserves of a constant-product pair. The price is normalized to the token decimals in the erc20
+// token contracts. This calculation is equivalent to:
+//
+// price = (quoteReserve / 10^quoteDecimals) / (baseReserve / 10^baseDecimals).
+func CalculatePrice(
+	cfg PoolConfig,
+	reserve0 *big.Int,
+	reserve1 *big.Int,
+) (*big.Float, error) {
+	// The base token is token0 of the pair unless the configuration specifies otherwise.
+	baseReserve, quoteReserve := reserve0, reserve1
+	if cfg.Invert {
+		baseReserve, quoteReserve = reserve1, reserve0
+	}
+
+	if baseReserve == nil || quoteReserve == nil || baseReserve.Sign() <= 0 || quoteReserve.Sign() <= 0 {
+		return nil, fmt.Errorf("pool reserves must be positive, got %v and %v", reserve0, reserve1)
+	}
+
+	price := new(big.Float).Quo(
+		new(big.Float).SetInt(quoteReserve),
+		new(big.Float).SetInt(baseReserve),
+	)
+
+	// Adjust the price based on the difference between the token decimals in the erc20 token contracts.
+	erc20ScalingFactor := math.GetScalingFactor(
+		cfg.BaseDecimals,
+		cfg.QuoteDecimals,
+	)
+
+	return new(big.Float).Mul(price, erc20ScalingFactor), nil
+}
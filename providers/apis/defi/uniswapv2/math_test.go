@@ -0,0 +1,97 @@
+package uniswapv2_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv2"
+)
+
+func TestCalculatePrice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      uniswapv2.PoolConfig
+		reserve0 *big.Int
+		reserve1 *big.Int
+		expected *big.Float
+		err      bool
+	}{
+		{
+			name: "base token is token0 with equal decimals",
+			cfg: uniswapv2.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 18,
+			},
+			reserve0: big.NewInt(1_000),
+			reserve1: big.NewInt(2_500),
+			expected: big.NewFloat(2.5),
+		},
+		{
+			name: "base token is token1 with equal decimals",
+			cfg: uniswapv2.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 18,
+				Invert:        true,
+			},
+			reserve0: big.NewInt(1_000),
+			reserve1: big.NewInt(2_500),
+			expected: big.NewFloat(0.4),
+		},
+		{
+			name: "base token is token0 with more decimals than the quote token",
+			cfg: uniswapv2.PoolConfig{
+				BaseDecimals:  8,
+				QuoteDecimals: 6,
+			},
+			reserve0: big.NewInt(100_000_000),    // 1 base token
+			reserve1: big.NewInt(70_000_000_000), // 70,000 quote tokens
+			expected: big.NewFloat(70_000),
+		},
+		{
+			name: "base token is token1 with more decimals than the quote token",
+			cfg: uniswapv2.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 6,
+				Invert:        true,
+			},
+			reserve0: big.NewInt(3_000_000_000),                             // 3,000 quote tokens
+			reserve1: new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil), // 1 base token
+			expected: big.NewFloat(3_000),
+		},
+		{
+			name: "base token reserve is zero",
+			cfg: uniswapv2.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 18,
+			},
+			reserve0: big.NewInt(0),
+			reserve1: big.NewInt(2_500),
+			err:      true,
+		},
+		{
+			name: "quote token reserve is zero",
+			cfg: uniswapv2.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 18,
+			},
+			reserve0: big.NewInt(1_000),
+			reserve1: big.NewInt(0),
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := uniswapv2.CalculatePrice(tc.cfg, tc.reserve0, tc.reserve1)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.SetPrec(40), price.SetPrec(40))
+		})
+	}
+}
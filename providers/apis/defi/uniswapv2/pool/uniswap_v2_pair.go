@@ -0,0 +1,11 @@
+package pool
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// UniswapV2PairMetaData contains the subset of the Uniswap V2 pair ABI that is required to price
+// a pair. Forks of Uniswap V2 (e.g. Sushiswap, Aerodrome volatile pools) expose the same methods.
+var UniswapV2PairMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"getReserves\",\"outputs\":[{\"internalType\":\"uint112\",\"name\":\"_reserve0\",\"type\":\"uint112\"},{\"internalType\":\"uint112\",\"name\":\"_reserve1\",\"type\":\"uint112\"},{\"internalType\":\"uint32\",\"name\":\"_blockTimestampLast\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"token0\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"token1\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
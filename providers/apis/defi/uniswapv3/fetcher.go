@@ -44,7 +44,7 @@ type PriceFetcher struct {
 	// and parse the result.
 	abi *abi.ABI
 	// payload is the packed slot0 call to the pool contract. Since the slot0 payload is the same
-	// for all pools, we can reuse this payload for all pools.
+	// for all pools, we can reuse this payload for all pools that do not use the TWAP.
 	payload []byte
 	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
 	// the metadata for each ticker.
@@ -145,7 +145,8 @@ func NewPriceFetcherWithClient(
 // Fetch returns the price of a given set of tickers. This fetch utilizes the batch call to lower
 // overhead of making individual RPC calls for each ticker. The fetcher will query the Uniswap V3
 // pool contract for the price of the pool. The price is derived from the slot 0 data of the pool
-// contract, specifically the sqrtPriceX96 value.
+// contract, specifically the sqrtPriceX96 value, unless the pool is configured to use the
+// time-weighted average price, in which case it is derived from the arithmetic-mean tick.
 func (u *PriceFetcher) Fetch(
 	ctx context.Context,
 	tickers []types.ProviderTicker,
@@ -176,6 +177,24 @@ func (u *PriceFetcher) Fetch(
 			)
 		}
 
+		// Create the payload for the pool, i.e. either the slot0 call or the observe call.
+		payload, err := u.GetPayload(pool)
+		if err != nil {
+			u.logger.Debug(
+				"failed to create payload for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to create payload: %w", err),
+					providertypes.ErrorUnknown,
+				),
+			)
+		}
+
 		// Create a batch element for the ticker and pool.
 		var result string
 		batchElems[i] = rpc.BatchElem{
@@ -183,7 +202,7 @@ func (u *PriceFetcher) Fetch(
 			Args: []interface{}{
 				map[string]interface{}{
 					"to":   common.HexToAddress(pool.Address),
-					"data": hexutil.Bytes(u.payload), // slot0 call to the pool contract.
+					"data": hexutil.Bytes(payload),
 				},
 				"latest", // latest signifies the latest block.
 			},
@@ -225,11 +244,11 @@ func (u *PriceFetcher) Fetch(
 			continue
 		}
 
-		// Parse the sqrtPriceX96 from the result.
-		sqrtPriceX96, err := u.ParseSqrtPriceX96(result.Result)
+		// Parse the raw, unscaled price from the result.
+		price, err := u.ParsePrice(pools[i], result.Result)
 		if err != nil {
 			u.logger.Debug(
-				"failed to parse sqrt price x96",
+				"failed to parse price",
 				zap.String("ticker", ticker.String()),
 				zap.Error(err),
 			)
@@ -244,9 +263,6 @@ func (u *PriceFetcher) Fetch(
 			continue
 		}
 
-		// Convert the sqrtPriceX96 to a price. This is the raw, unscaled price.
-		price := ConvertSquareRootX96Price(sqrtPriceX96)
-
 		// Scale the price to the respective token decimals.
 		scaledPrice := ScalePrice(pools[i], price)
 		resolved[ticker] = types.NewPriceResult(scaledPrice, time.Now().UTC())
@@ -277,10 +293,85 @@ func (u *PriceFetcher) GetPool(
 	return cfg, nil
 }
 
+// GetPayload returns the packed call to the pool contract for the given pool. This is the slot0
+// call, unless the pool is configured to use the time-weighted average price, in which case this
+// is the observe call for the pool's TWAP interval.
+func (u *PriceFetcher) GetPayload(
+	pool PoolConfig,
+) ([]byte, error) {
+	if pool.TWAPInterval == 0 {
+		return u.payload, nil
+	}
+
+	return u.abi.Pack(TWAPContractMethod, []uint32{pool.TWAPInterval, 0})
+}
+
+// ParsePrice parses the raw, unscaled price of the given pool from the result of the batch call.
+func (u *PriceFetcher) ParsePrice(
+	pool PoolConfig,
+	result interface{},
+) (*big.Float, error) {
+	if pool.TWAPInterval == 0 {
+		sqrtPriceX96, err := u.ParseSqrtPriceX96(result)
+		if err != nil {
+			return nil, err
+		}
+
+		return ConvertSquareRootX96Price(sqrtPriceX96), nil
+	}
+
+	tick, err := u.ParseArithmeticMeanTick(result, pool.TWAPInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertTickToPrice(tick), nil
+}
+
+// ParseArithmeticMeanTick parses the tick cumulatives from the result of the batch call to
+// observe and returns the arithmetic-mean tick over the given interval.
+func (u *PriceFetcher) ParseArithmeticMeanTick(
+	result interface{},
+	interval uint32,
+) (int64, error) {
+	bz, err := decodeResult(result)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := u.abi.Methods[TWAPContractMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	// Parse the tick cumulatives from the result.
+	tickCumulatives := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	return CalculateArithmeticMeanTick(tickCumulatives, interval)
+}
+
 // ParseSqrtPriceX96 parses the sqrtPriceX96 from the result of the batch call.
 func (u *PriceFetcher) ParseSqrtPriceX96(
 	result interface{},
 ) (*big.Int, error) {
+	bz, err := decodeResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := u.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	// Parse the sqrtPriceX96 from the result.
+	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return sqrtPriceX96, nil
+}
+
+// decodeResult decodes the hex encoded result of a batch call.
+func decodeResult(
+	result interface{},
+) ([]byte, error) {
 	r, ok := result.(*string)
 	if !ok {
 		return nil, fmt.Errorf("expected result to be a string, got %T", result)
@@ -295,12 +386,5 @@ func (u *PriceFetcher) ParseSqrtPriceX96(
 		return nil, fmt.Errorf("failed to decode hex result: %w", err)
 	}
 
-	out, err := u.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unpack values: %w", err)
-	}
-
-	// Parse the sqrtPriceX96 from the result.
-	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
-	return sqrtPriceX96, nil
+	return bz, nil
 }
@@ -135,6 +135,31 @@ func TestFetch(t *testing.T) {
 				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
 			},
 		},
+		{
+			name: "weth/usdc twap result",
+			tickers: []types.ProviderTicker{
+				wethusdcTWAPTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				batchErrors := []error{
+					nil,
+				}
+				// The tick cumulatives increase by 1800 * 195000 + 5 over the interval, i.e. the
+				// arithmetic-mean tick is 195000.
+				responses := []string{
+					"0x000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000002dfdc1c3500000000000000000000000000000000000000000000000000000002f4c7f1fa000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002",
+				}
+				return createEVMClientWithResponse(t, nil, responses, batchErrors)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					wethusdcTWAPTicker: {
+						Value: big.NewFloat(3401.5825252970426459611256935497),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -193,6 +218,12 @@ func TestGetPool(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expected, pool)
 	})
+
+	t.Run("ticker has valid twap metadata", func(t *testing.T) {
+		pool, err := fetcher.GetPool(wethusdcTWAPTicker)
+		require.NoError(t, err)
+		require.Equal(t, wethusdcTWAPCfg, pool)
+	})
 }
 
 func TestParseSqrtPriceX96(t *testing.T) {
@@ -25,8 +25,17 @@ var (
 		Invert:        true,
 	}
 
+	wethusdcTWAPCfg = uniswapv3.PoolConfig{
+		Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+		BaseDecimals:  18,
+		QuoteDecimals: 6,
+		Invert:        true,
+		TWAPInterval:  1800,
+	}
+
 	// Tickers used for testing.
-	wethusdcTicker = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+	wethusdcTicker     = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+	wethusdcTWAPTicker = types.NewProviderTicker("WETH/USDC", wethusdcTWAPCfg.MustToJSON())
 )
 
 func createPriceFetcher(
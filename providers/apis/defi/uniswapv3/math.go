@@ -1,6 +1,7 @@
 package uniswapv3
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/skip-mev/slinky/pkg/math"
@@ -28,6 +29,67 @@ func ConvertSquareRootX96Price(
 	return new(big.Float).Mul(sqrtPriceFloat, sqrtPriceFloat)
 }
 
+// tickBase is the base of the Uniswap V3 tick math, i.e. the price at tick i is tickBase^i.
+const tickBase = "1.0001"
+
+// tickPricePrecision is the precision used when converting a tick to a price. This is high
+// enough to retain precision across the full tick range.
+const tickPricePrecision = 256
+
+// ConvertTickToPrice converts a tick to a price. Note that this price is not scaled to the token
+// decimals. This calculation is equivalent to:
+//
+// price = 1.0001 ^ tick.
+func ConvertTickToPrice(
+	tick int64,
+) *big.Float {
+	base, _ := new(big.Float).SetPrec(tickPricePrecision).SetString(tickBase)
+
+	// Exponentiation by squaring of the absolute value of the tick.
+	exp := math.Abs(tick)
+	price := new(big.Float).SetPrec(tickPricePrecision).SetInt64(1)
+	for exp > 0 {
+		if exp&1 == 1 {
+			price.Mul(price, base)
+		}
+		base.Mul(base, base)
+		exp >>= 1
+	}
+
+	if tick < 0 {
+		return new(big.Float).SetPrec(tickPricePrecision).Quo(big.NewFloat(1), price)
+	}
+	return price
+}
+
+// CalculateArithmeticMeanTick calculates the arithmetic mean tick over the given interval (in
+// seconds) from the tick cumulatives returned by observe([interval, 0]). Like the Uniswap V3
+// OracleLibrary, the mean tick is rounded towards negative infinity.
+func CalculateArithmeticMeanTick(
+	tickCumulatives []*big.Int,
+	interval uint32,
+) (int64, error) {
+	if len(tickCumulatives) != 2 {
+		return 0, fmt.Errorf("expected 2 tick cumulatives, got %d", len(tickCumulatives))
+	}
+
+	if interval == 0 {
+		return 0, fmt.Errorf("interval must be positive")
+	}
+
+	delta := new(big.Int).Sub(tickCumulatives[1], tickCumulatives[0])
+	mean, remainder := new(big.Int).QuoRem(delta, big.NewInt(int64(interval)), new(big.Int))
+	if delta.Sign() < 0 && remainder.Sign() != 0 {
+		mean.Sub(mean, big.NewInt(1))
+	}
+
+	if !mean.IsInt64() {
+		return 0, fmt.Errorf("mean tick %s is out of range", mean)
+	}
+
+	return mean.Int64(), nil
+}
+
 // ScalePrice scales the price to the desired ticker decimals. The price is normalized to
 // the token decimals in the erc20 token contracts.
 func ScalePrice(
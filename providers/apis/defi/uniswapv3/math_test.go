@@ -110,3 +110,98 @@ func TestScalePrice(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertTickToPrice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tick     int64
+		expected *big.Float
+	}{
+		{
+			name:     "tick of 0",
+			tick:     0,
+			expected: big.NewFloat(1),
+		},
+		{
+			name:     "tick of 1",
+			tick:     1,
+			expected: big.NewFloat(1.0001),
+		},
+		{
+			name:     "tick of -1",
+			tick:     -1,
+			expected: big.NewFloat(0.99990000999900009999),
+		},
+		{
+			name:     "weth/usdc tick",
+			tick:     195000,
+			expected: big.NewFloat(293980814.09554370906207953902550),
+		},
+		{
+			name:     "negative tick",
+			tick:     -195000,
+			expected: big.NewFloat(3.4015825252970426459611256935497e-09),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := uniswapv3.ConvertTickToPrice(tc.tick)
+			require.Equal(t, tc.expected.SetPrec(40), actual.SetPrec(40))
+		})
+	}
+}
+
+func TestCalculateArithmeticMeanTick(t *testing.T) {
+	testCases := []struct {
+		name            string
+		tickCumulatives []*big.Int
+		interval        uint32
+		expected        int64
+		err             bool
+	}{
+		{
+			name:            "positive mean tick",
+			tickCumulatives: []*big.Int{big.NewInt(1_000), big.NewInt(1_000 + 1_800*195_000 + 5)},
+			interval:        1_800,
+			expected:        195_000,
+		},
+		{
+			name:            "negative mean tick is rounded towards negative infinity",
+			tickCumulatives: []*big.Int{big.NewInt(1_000), big.NewInt(1_000 - 1_800*50 - 5)},
+			interval:        1_800,
+			expected:        -51,
+		},
+		{
+			name:            "negative mean tick without remainder",
+			tickCumulatives: []*big.Int{big.NewInt(-1_000), big.NewInt(-1_000 - 1_800*50)},
+			interval:        1_800,
+			expected:        -50,
+		},
+		{
+			name:            "unexpected number of tick cumulatives",
+			tickCumulatives: []*big.Int{big.NewInt(1_000)},
+			interval:        1_800,
+			err:             true,
+		},
+		{
+			name:            "interval of 0",
+			tickCumulatives: []*big.Int{big.NewInt(1_000), big.NewInt(2_000)},
+			interval:        0,
+			err:             true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tick, err := uniswapv3.CalculateArithmeticMeanTick(tc.tickCumulatives, tc.interval)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tick)
+		})
+	}
+}
@@ -22,6 +22,10 @@ const (
 	// ContractMethod is the contract method to call for the Uniswap V3 API.
 	ContractMethod = "slot0"
 
+	// TWAPContractMethod is the contract method to call for the Uniswap V3 API if the pool is
+	// configured to use the time-weighted average price.
+	TWAPContractMethod = "observe"
+
 	// ETH_URL is the URL for the Uniswap V3 API. This uses a free public RPC provider on Ethereum Mainnet.
 	ETH_URL = "https://eth.public-rpc.com/"
 
@@ -60,6 +64,12 @@ type PoolConfig struct {
 	// pools as the price is derived based on the sorted order of the ERC20 addresses of the tokens
 	// in the pool.
 	Invert bool `json:"invert"`
+	// TWAPInterval is the (optional) lookback, in seconds, of the time-weighted average price. If
+	// set, the price is derived from the arithmetic-mean tick over the interval (via the pool's
+	// observe method) rather than the spot price in slot 0, which makes the price resistant to
+	// manipulation within a single block. The pool must have a sufficient observation cardinality
+	// to cover the interval.
+	TWAPInterval uint32 `json:"twap_interval,omitempty"`
 }
 
 // ValidateBasic validates the pool configuration.
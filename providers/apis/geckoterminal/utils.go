@@ -35,6 +35,10 @@ var DefaultETHAPIConfig = config.APIConfig{
 	ReconnectTimeout: 2000 * time.Millisecond,
 	MaxQueries:       1,
 	Endpoints:        []config.Endpoint{{URL: ETH_URL}},
+	// The GeckoTerminal API allows for 30 requests per minute.
+	RateLimit: config.RateLimitConfig{
+		RequestsPerSecond: 0.5,
+	},
 }
 
 type (
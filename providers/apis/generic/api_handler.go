@@ -0,0 +1,243 @@
+package generic
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
+
+// APIHandler implements the PriceAPIDataHandler interface for generic API providers, which can
+// be used by a base provider. Unlike the other API handlers, the URL and the format of the
+// response are entirely described by the generic API config, such that simple REST APIs can be
+// added as providers without a dedicated implementation.
+type APIHandler struct {
+	// api is the config for the API.
+	api config.APIConfig
+
+	// separator is the separator used to join the off-chain tickers in the URL.
+	separator string
+
+	// listPath, tickerPath, pricePath, volumePath, bestBidPath and bestAskPath are the paths of
+	// the generic API config.
+	listPath    path
+	tickerPath  path
+	pricePath   path
+	volumePath  path
+	bestBidPath path
+	bestAskPath path
+}
+
+// NewAPIHandler returns a new generic PriceAPIDataHandler.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if !api.Generic.Enabled() {
+		return nil, fmt.Errorf("generic api config for %s is not set", api.Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", api.Name, err)
+	}
+
+	separator := api.Generic.TickerSeparator
+	if len(separator) == 0 {
+		separator = DefaultTickerSeparator
+	}
+
+	return &APIHandler{
+		api:         api,
+		separator:   separator,
+		listPath:    path(api.Generic.ListPath),
+		tickerPath:  path(api.Generic.TickerPath),
+		pricePath:   path(api.Generic.PricePath),
+		volumePath:  path(api.Generic.VolumePath),
+		bestBidPath: path(api.Generic.BestBidPath),
+		bestAskPath: path(api.Generic.BestAskPath),
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch data from the API for the given tickers. The
+// tickers placeholder in the configured URL is replaced with the off-chain tickers joined by the
+// configured separator. Each ticker is path or query escaped, depending on where the placeholder
+// is in the URL.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	if len(tickers) == 0 {
+		return "", fmt.Errorf("no tickers provided")
+	}
+
+	rawURL := h.api.Endpoints[0].URL
+	escape := escapeTickers(rawURL)
+
+	offChainTickers := make([]string, len(tickers))
+	for i, ticker := range tickers {
+		offChainTickers[i] = escape(ticker.GetOffChainTicker())
+	}
+
+	return strings.ReplaceAll(
+		rawURL,
+		config.TickersPlaceholder,
+		strings.Join(offChainTickers, h.separator),
+	), nil
+}
+
+// ParseResponse parses the HTTP response from the API using the configured paths and returns
+// the resulting prices. Each of the tickers supplied will get a response or an error.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	if !gjson.ValidBytes(bz) {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(fmt.Errorf("invalid json response"), providertypes.ErrorFailedToDecode),
+		)
+	}
+	body := gjson.ParseBytes(bz)
+
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+	)
+
+	if len(h.tickerPath) == 0 {
+		// The response is an object from which the data of each ticker is extracted directly.
+		for _, ticker := range tickers {
+			h.parseTicker(body, ticker, resolved, unresolved)
+		}
+	} else {
+		// The response contains a list of entries, each of which is identified by its ticker.
+		list, err := h.listPath.lookup(body, "")
+		if err != nil {
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to find list of entries in response: %w", err),
+					providertypes.ErrorInvalidResponse,
+				),
+			)
+		}
+
+		if !list.IsArray() {
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("expected a list of entries in response, got %s", list.Type),
+					providertypes.ErrorInvalidResponse,
+				),
+			)
+		}
+
+		tickersByOffChainTicker := make(map[string]types.ProviderTicker, len(tickers))
+		for _, ticker := range tickers {
+			tickersByOffChainTicker[ticker.GetOffChainTicker()] = ticker
+		}
+
+		list.ForEach(func(_, entry gjson.Result) bool {
+			// Filter out the entries that are not expected.
+			offChainTicker, err := h.tickerPath.lookupString(entry, "")
+			if err != nil {
+				return true
+			}
+
+			ticker, ok := tickersByOffChainTicker[offChainTicker]
+			if !ok {
+				return true
+			}
+
+			h.parseTicker(entry, ticker, resolved, unresolved)
+			return true
+		})
+	}
+
+	// Add tickers that received no response to the unresolved map.
+	for _, ticker := range tickers {
+		_, resolvedOk := resolved[ticker]
+		_, unresolvedOk := unresolved[ticker]
+
+		if !resolvedOk && !unresolvedOk {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+			}
+		}
+	}
+
+	return types.NewPriceResponse(resolved, unresolved)
+}
+
+// parseTicker extracts the price (and optional market data) of the given ticker from the given
+// value and adds the result to either the resolved or unresolved prices.
+func (h *APIHandler) parseTicker(
+	value gjson.Result,
+	ticker types.ProviderTicker,
+	resolved types.ResolvedPrices,
+	unresolved types.UnResolvedPrices,
+) {
+	offChainTicker := ticker.GetOffChainTicker()
+
+	rawPrice, err := h.pricePath.lookupString(value, offChainTicker)
+	if err != nil {
+		unresolved[ticker] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(
+				fmt.Errorf("failed to extract price: %w", err),
+				providertypes.ErrorNoResponse,
+			),
+		}
+		return
+	}
+
+	price, err := math.Float64StringToBigFloat(rawPrice)
+	if err != nil {
+		wErr := fmt.Errorf("failed to convert price %s to big.Float: %w", rawPrice, err)
+		unresolved[ticker] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+		}
+		return
+	}
+
+	resolved[ticker] = types.WithParsedMarketData(
+		types.NewPriceResult(price, time.Now().UTC()),
+		h.lookupOptional(h.volumePath, value, offChainTicker),
+		h.lookupOptional(h.bestBidPath, value, offChainTicker),
+		h.lookupOptional(h.bestAskPath, value, offChainTicker),
+	)
+}
+
+// lookupOptional returns the value at the given optional path, or an empty string if the path
+// is not configured or the value cannot be extracted.
+func (h *APIHandler) lookupOptional(p path, value gjson.Result, offChainTicker string) string {
+	if len(p) == 0 {
+		return ""
+	}
+
+	s, err := p.lookupString(value, offChainTicker)
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
@@ -0,0 +1,410 @@
+package generic_test
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/generic"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+)
+
+var (
+	btcusd = types.DefaultProviderTicker{
+		OffChainTicker: "BTC-USD",
+	}
+	ethusd = types.DefaultProviderTicker{
+		OffChainTicker: "ETH-USD",
+	}
+
+	// keyedAPIConfig is the config of a generic provider whose response is keyed by ticker, e.g.
+	// {"data": {"BTC-USD": {"price": "70000"}}}.
+	keyedAPIConfig = config.APIConfig{
+		Name:             "generic_keyed_api",
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          500 * time.Millisecond,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: "https://api.example.com/prices?symbols={tickers}"}},
+		Generic: config.GenericAPIConfig{
+			PricePath:  "data.{ticker}.price",
+			VolumePath: "data.{ticker}.volume",
+		},
+	}
+
+	// listAPIConfig is the config of a generic provider whose response is a list of entries, e.g.
+	// {"result": [{"symbol": "BTC-USD", "last": 70000}]}.
+	listAPIConfig = config.APIConfig{
+		Name:             "generic_list_api",
+		Atomic:           false,
+		Enabled:          true,
+		Timeout:          500 * time.Millisecond,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		BatchSize:        10,
+		Endpoints:        []config.Endpoint{{URL: "https://api.example.com/tickers/{tickers}"}},
+		Generic: config.GenericAPIConfig{
+			TickerSeparator: "+",
+			ListPath:        "result",
+			TickerPath:      "symbol",
+			PricePath:       "last",
+			BestBidPath:     "book.0",
+			BestAskPath:     "book.1",
+		},
+	}
+)
+
+func TestNewAPIHandler(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		_, err := generic.NewAPIHandler(keyedAPIConfig)
+		require.NoError(t, err)
+	})
+
+	t.Run("generic config not set", func(t *testing.T) {
+		cfg := keyedAPIConfig
+		cfg.Generic = config.GenericAPIConfig{}
+
+		_, err := generic.NewAPIHandler(cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("api disabled", func(t *testing.T) {
+		cfg := keyedAPIConfig
+		cfg.Enabled = false
+
+		_, err := generic.NewAPIHandler(cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("url without tickers placeholder", func(t *testing.T) {
+		cfg := keyedAPIConfig
+		cfg.Endpoints = []config.Endpoint{{URL: "https://api.example.com/prices"}}
+
+		_, err := generic.NewAPIHandler(cfg)
+		require.Error(t, err)
+	})
+}
+
+func TestCreateURL(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         config.APIConfig
+		cps         []types.ProviderTicker
+		url         string
+		expectedErr bool
+	}{
+		{
+			name:        "empty",
+			cfg:         keyedAPIConfig,
+			cps:         []types.ProviderTicker{},
+			expectedErr: true,
+		},
+		{
+			name:        "single ticker",
+			cfg:         keyedAPIConfig,
+			cps:         []types.ProviderTicker{btcusd},
+			url:         "https://api.example.com/prices?symbols=BTC-USD",
+			expectedErr: false,
+		},
+		{
+			name:        "multiple tickers with default separator",
+			cfg:         keyedAPIConfig,
+			cps:         []types.ProviderTicker{btcusd, ethusd},
+			url:         "https://api.example.com/prices?symbols=BTC-USD,ETH-USD",
+			expectedErr: false,
+		},
+		{
+			name:        "multiple tickers with custom separator",
+			cfg:         listAPIConfig,
+			cps:         []types.ProviderTicker{btcusd, ethusd},
+			url:         "https://api.example.com/tickers/BTC-USD+ETH-USD",
+			expectedErr: false,
+		},
+		{
+			name: "tickers in the query are query escaped",
+			cfg:  keyedAPIConfig,
+			cps: []types.ProviderTicker{
+				types.DefaultProviderTicker{OffChainTicker: "BTC/USD"},
+				types.DefaultProviderTicker{OffChainTicker: "ETH&USD TEST"},
+			},
+			url:         "https://api.example.com/prices?symbols=BTC%2FUSD,ETH%26USD+TEST",
+			expectedErr: false,
+		},
+		{
+			name: "tickers in the path are path escaped",
+			cfg:  listAPIConfig,
+			cps: []types.ProviderTicker{
+				types.DefaultProviderTicker{OffChainTicker: "BTC/USD"},
+				types.DefaultProviderTicker{OffChainTicker: "ETH USD?"},
+			},
+			url:         "https://api.example.com/tickers/BTC%2FUSD+ETH%20USD%3F",
+			expectedErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewAPIHandler(tc.cfg)
+			require.NoError(t, err)
+
+			url, err := h.CreateURL(tc.cps)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.url, url)
+			}
+		})
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name               string
+		cfg                config.APIConfig
+		cps                []types.ProviderTicker
+		response           *http.Response
+		expected           types.ResolvedPrices
+		expectedUnresolved []types.ProviderTicker
+	}{
+		{
+			name: "keyed response with string and number prices",
+			cfg:  keyedAPIConfig,
+			cps:  []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"BTC-USD": {"price": "70000.5", "volume": 1.5},
+		"ETH-USD": {"price": 3000.25}
+	}
+}
+	`,
+			),
+			expected: types.ResolvedPrices{
+				btcusd: types.NewPriceResult(big.NewFloat(70000.5), time.Now()).WithVolume(big.NewFloat(1.5)),
+				ethusd: types.NewPriceResult(big.NewFloat(3000.25), time.Now()),
+			},
+		},
+		{
+			name: "keyed response with a missing ticker",
+			cfg:  keyedAPIConfig,
+			cps:  []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"BTC-USD": {"price": "70000.5"}
+	}
+}
+	`,
+			),
+			expected: types.ResolvedPrices{
+				btcusd: types.NewPriceResult(big.NewFloat(70000.5), time.Now()),
+			},
+			expectedUnresolved: []types.ProviderTicker{ethusd},
+		},
+		{
+			name: "keyed response with an invalid price",
+			cfg:  keyedAPIConfig,
+			cps:  []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"BTC-USD": {"price": "$70000.5"},
+		"ETH-USD": {"price": {"value": 3000.25}}
+	}
+}
+	`,
+			),
+			expected:           types.ResolvedPrices{},
+			expectedUnresolved: []types.ProviderTicker{btcusd, ethusd},
+		},
+		{
+			name: "list response",
+			cfg:  listAPIConfig,
+			cps:  []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"result": [
+		{"symbol": "BTC-USD", "last": "70000.5", "book": ["70000", "70001"]},
+		{"symbol": "SOL-USD", "last": "150"},
+		{"symbol": "ETH-USD", "last": 3000.25}
+	]
+}
+	`,
+			),
+			expected: types.ResolvedPrices{
+				btcusd: types.NewPriceResult(big.NewFloat(70000.5), time.Now()).WithBidAsk(big.NewFloat(70000), big.NewFloat(70001)),
+				ethusd: types.NewPriceResult(big.NewFloat(3000.25), time.Now()),
+			},
+		},
+		{
+			name: "list response with a missing ticker",
+			cfg:  listAPIConfig,
+			cps:  []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"result": [
+		{"symbol": "ETH-USD", "last": 3000.25}
+	]
+}
+	`,
+			),
+			expected: types.ResolvedPrices{
+				ethusd: types.NewPriceResult(big.NewFloat(3000.25), time.Now()),
+			},
+			expectedUnresolved: []types.ProviderTicker{btcusd},
+		},
+		{
+			name: "list response without a list",
+			cfg:  listAPIConfig,
+			cps:  []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"result": {"symbol": "ETH-USD", "last": 3000.25}
+}
+	`,
+			),
+			expected:           types.ResolvedPrices{},
+			expectedUnresolved: []types.ProviderTicker{btcusd, ethusd},
+		},
+		{
+			name: "keyed response with a ticker containing path syntax",
+			cfg:  keyedAPIConfig,
+			cps:  []types.ProviderTicker{types.DefaultProviderTicker{OffChainTicker: "BTC.USD*"}},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"BTC.USD*": {"price": "70000.5"},
+		"BTC": {"USD*": {"price": "1"}}
+	}
+}
+	`,
+			),
+			expected: types.ResolvedPrices{
+				types.DefaultProviderTicker{OffChainTicker: "BTC.USD*"}: types.NewPriceResult(big.NewFloat(70000.5), time.Now()),
+			},
+		},
+		{
+			name: "response queried with gjson syntax",
+			cfg: func() config.APIConfig {
+				cfg := keyedAPIConfig
+				cfg.Generic = config.GenericAPIConfig{
+					PricePath:  `result.#(symbol=="{ticker}").last`,
+					VolumePath: `result.#(symbol=="{ticker}").stats.volume`,
+				}
+				return cfg
+			}(),
+			cps: []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"result": [
+		{"symbol": "BTC-USD", "last": "70000.5", "stats": {"volume": 1.5}},
+		{"symbol": "ETH-USD", "last": 3000.25}
+	]
+}
+	`,
+			),
+			expected: types.ResolvedPrices{
+				btcusd: types.NewPriceResult(big.NewFloat(70000.5), time.Now()).WithVolume(big.NewFloat(1.5)),
+				ethusd: types.NewPriceResult(big.NewFloat(3000.25), time.Now()),
+			},
+		},
+		{
+			name: "response queried with gjson syntax and a ticker containing path syntax",
+			cfg: func() config.APIConfig {
+				cfg := keyedAPIConfig
+				cfg.Generic = config.GenericAPIConfig{
+					PricePath: `result.#(symbol=="{ticker}").last`,
+				}
+				return cfg
+			}(),
+			cps: []types.ProviderTicker{
+				types.DefaultProviderTicker{OffChainTicker: "BTC.USD*"},
+				types.DefaultProviderTicker{OffChainTicker: `ETH"USD`},
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"result": [
+		{"symbol": "BTC.USD*", "last": "70000.5"},
+		{"symbol": "ETH\"USD", "last": 3000.25}
+	]
+}
+	`,
+			),
+			expected: types.ResolvedPrices{
+				types.DefaultProviderTicker{OffChainTicker: "BTC.USD*"}: types.NewPriceResult(big.NewFloat(70000.5), time.Now()),
+				types.DefaultProviderTicker{OffChainTicker: `ETH"USD`}:  types.NewPriceResult(big.NewFloat(3000.25), time.Now()),
+			},
+		},
+		{
+			name: "unable to parse json",
+			cfg:  keyedAPIConfig,
+			cps:  []types.ProviderTicker{btcusd},
+			response: testutils.CreateResponseFromJSON(
+				`
+toms obvious but not minimal language
+	`,
+			),
+			expected:           types.ResolvedPrices{},
+			expectedUnresolved: []types.ProviderTicker{btcusd},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewAPIHandler(tc.cfg)
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp := h.ParseResponse(tc.cps, tc.response)
+
+			require.Len(t, resp.Resolved, len(tc.expected))
+			require.Len(t, resp.UnResolved, len(tc.expectedUnresolved))
+
+			for cp, result := range tc.expected {
+				require.Contains(t, resp.Resolved, cp)
+				r := resp.Resolved[cp]
+				require.Equal(t, result.Value.SetPrec(18), r.Value.SetPrec(18))
+				require.True(t, r.Timestamp.After(now))
+
+				requireOptionalFloatEqual(t, result.Volume, r.Volume)
+				requireOptionalFloatEqual(t, result.BestBid, r.BestBid)
+				requireOptionalFloatEqual(t, result.BestAsk, r.BestAsk)
+			}
+
+			for _, cp := range tc.expectedUnresolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Error(t, resp.UnResolved[cp])
+			}
+		})
+	}
+}
+
+func requireOptionalFloatEqual(t *testing.T, expected, actual *big.Float) {
+	t.Helper()
+
+	if expected == nil {
+		require.Nil(t, actual)
+		return
+	}
+
+	require.NotNil(t, actual)
+	require.Equal(t, expected.SetPrec(18), actual.SetPrec(18))
+}
@@ -0,0 +1,115 @@
+package generic
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// NOTE: Generic providers do not have a fixed name. Any API provider whose config sets the
+// generic API config is created as a generic provider. See config.GenericAPIConfig for the
+// configuration options.
+
+const (
+	// DefaultTickerSeparator is the separator used to join the off-chain tickers in the URL if
+	// none is configured.
+	DefaultTickerSeparator = ","
+)
+
+// path is a gjson path that is used to extract a value from a JSON response. See
+// https://github.com/tidwall/gjson/blob/master/SYNTAX.md for the syntax of paths.
+type path string
+
+// quotedEscaper escapes a value that is substituted into a quoted string of a path, e.g. the value
+// of a query such as #(symbol=="{ticker}").
+var quotedEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// lookup returns the value at the path within the given JSON value. The ticker placeholder is
+// replaced with the given off-chain ticker, which is escaped such that it is always matched
+// literally, both as a key and within a quoted string. An empty path refers to the value itself.
+func (p path) lookup(value gjson.Result, ticker string) (gjson.Result, error) {
+	if len(p) == 0 {
+		return value, nil
+	}
+
+	expanded := p.expand(ticker)
+
+	result := value.Get(expanded)
+	if !result.Exists() {
+		return gjson.Result{}, fmt.Errorf("no value found at path %s", expanded)
+	}
+
+	return result, nil
+}
+
+// expand returns the path with the ticker placeholder replaced with the given off-chain ticker.
+func (p path) expand(ticker string) string {
+	var (
+		b        strings.Builder
+		rest     = string(p)
+		inQuotes bool
+	)
+
+	for len(rest) > 0 {
+		if !strings.HasPrefix(rest, config.TickerPlaceholder) {
+			switch rest[0] {
+			case '\\':
+				// Copy escaped characters as is, such that escaped quotes are not interpreted.
+				if len(rest) > 1 {
+					b.WriteString(rest[:2])
+					rest = rest[2:]
+					continue
+				}
+			case '"':
+				inQuotes = !inQuotes
+			}
+
+			b.WriteByte(rest[0])
+			rest = rest[1:]
+			continue
+		}
+
+		if inQuotes {
+			b.WriteString(quotedEscaper.Replace(ticker))
+		} else {
+			b.WriteString(gjson.Escape(ticker))
+		}
+		rest = rest[len(config.TickerPlaceholder):]
+	}
+
+	return b.String()
+}
+
+// lookupString returns the value at the path within the given JSON value formatted as a string.
+// The value must either be a JSON number, whose precision is preserved, or a string.
+func (p path) lookupString(value gjson.Result, ticker string) (string, error) {
+	result, err := p.lookup(value, ticker)
+	if err != nil {
+		return "", err
+	}
+
+	switch result.Type {
+	case gjson.Number:
+		return result.Raw, nil
+	case gjson.String:
+		return result.Str, nil
+	default:
+		return "", fmt.Errorf("expected a number or string, got %s", result.Type)
+	}
+}
+
+// escapeTickers returns the function used to escape the off-chain tickers that replace the
+// tickers placeholder in the given URL, depending on whether the placeholder is part of the path
+// or the query string of the URL.
+func escapeTickers(rawURL string) func(string) string {
+	query := strings.Index(rawURL, "?")
+	if query >= 0 && strings.Index(rawURL, config.TickersPlaceholder) > query {
+		return url.QueryEscape
+	}
+
+	return url.PathEscape
+}
@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -24,6 +25,10 @@ var (
 
 	// ErrUnexpectedStatusCode is returned when the APIQueryHandler encounters an unexpected status code.
 	ErrUnexpectedStatusCode = errors.New("api query handler encountered an unexpected status code")
+
+	// ErrQuorumNotReached is returned when fewer endpoints than the configured quorum resolved
+	// a value for an ID.
+	ErrQuorumNotReached = errors.New("api fetcher failed to reach a quorum of endpoints")
 )
 
 // ErrCreateURLWithErr is used to create a new ErrCreateRequest with the given error.
@@ -53,3 +58,33 @@ func ErrParseResponseWithErr(err error) error {
 func ErrUnexpectedStatusCodeWithCode(code int) error {
 	return fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, code)
 }
+
+// RetryAfterError is returned when the APIQueryHandler encounters a rate limit and the provider
+// specified how long to wait before making another request.
+type RetryAfterError struct {
+	// RetryAfter is the amount of time to wait before making another request.
+	RetryAfter time.Duration
+}
+
+// Error returns the error message of the RetryAfterError.
+func (e RetryAfterError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrRateLimit, e.RetryAfter)
+}
+
+// Unwrap returns ErrRateLimit, such that a RetryAfterError is also a rate limit error.
+func (e RetryAfterError) Unwrap() error {
+	return ErrRateLimit
+}
+
+// ErrRateLimitWithRetryAfter is used to create a new RetryAfterError with the given duration.
+// Provider's that implement the APIQueryHandler interface should use this function to
+// create the error.
+func ErrRateLimitWithRetryAfter(retryAfter time.Duration) error {
+	return RetryAfterError{RetryAfter: retryAfter}
+}
+
+// ErrQuorumNotReachedWithCount is used to create a new ErrQuorumNotReached with the number of
+// endpoints that resolved a value and the required quorum.
+func ErrQuorumNotReachedWithCount(resolved, quorum int) error {
+	return fmt.Errorf("%w: %d of %d", ErrQuorumNotReached, resolved, quorum)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gomath "math"
 	"strings"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/pkg/math"
+	apierrors "github.com/skip-mev/slinky/providers/base/api/errors"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
@@ -51,6 +53,9 @@ type APIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue] inte
 // response channel. In the case where the APIQueryHandler is atomic, the handler
 // will make a single request for all IDs. If the APIQueryHandler is not
 // atomic, the handler will make a request for each ID in a separate go routine.
+//
+// All requests are subject to the rate limiter of the provider, which enforces the configured
+// request budget and is paused whenever the provider asks to retry after some time.
 type APIQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
 	logger  *zap.Logger
 	metrics metrics.APIMetrics
@@ -58,6 +63,9 @@ type APIQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseVa
 
 	// fetcher is responsible for fetching data from the API.
 	fetcher APIFetcher[K, V]
+
+	// limiter is responsible for limiting the rate of requests to the API.
+	limiter *RateLimiter
 }
 
 // NewAPIQueryHandler creates a new APIQueryHandler. It manages querying the data
@@ -79,6 +87,7 @@ func NewAPIQueryHandler[K providertypes.ResponseKey, V providertypes.ResponseVal
 		config:  cfg,
 		metrics: metrics,
 		fetcher: fetcher,
+		limiter: RateLimiterFromConfig(cfg),
 	}, nil
 }
 
@@ -114,6 +123,7 @@ func NewAPIQueryHandlerWithFetcher[K providertypes.ResponseKey, V providertypes.
 		config:  cfg,
 		metrics: metrics,
 		fetcher: fetcher,
+		limiter: RateLimiterFromConfig(cfg),
 	}, nil
 }
 
@@ -249,11 +259,45 @@ func (h *APIQueryHandlerImpl[K, V]) subTask(
 		}()
 
 		h.logger.Debug("starting subtask", zap.Any("ids", ids))
-		h.writeResponse(ctx, responseCh, h.fetcher.Fetch(ctx, ids))
+
+		// Wait until the request budget of the provider allows for another request. If the request
+		// cannot be made before the context expires, the IDs are reported as rate limited such that
+		// they are not silently dropped. Nothing is reported once the query has been stopped.
+		if err := h.limiter.Wait(ctx); err != nil {
+			h.logger.Debug("stopped waiting for rate limiter", zap.Error(err))
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			h.writeResponse(ctx, responseCh, providertypes.NewGetResponseWithErr[K, V](
+				ids,
+				providertypes.NewErrorWithCode(err, providertypes.ErrorRateLimitExceeded),
+			))
+			return nil
+		}
+
+		response := h.fetcher.Fetch(ctx, ids)
+		h.pauseIfRateLimited(response)
+		h.writeResponse(ctx, responseCh, response)
 		return nil
 	}
 }
 
+// pauseIfRateLimited pauses the rate limiter if the provider responded that the rate limit was
+// exceeded and specified how long to wait before making another request.
+func (h *APIQueryHandlerImpl[K, V]) pauseIfRateLimited(response providertypes.GetResponse[K, V]) {
+	for _, result := range response.UnResolved {
+		var retryAfterErr apierrors.RetryAfterError
+		if !errors.As(result, &retryAfterErr) {
+			continue
+		}
+
+		h.logger.Debug("pausing requests after exceeding rate limit", zap.Duration("retry_after", retryAfterErr.RetryAfter))
+		h.limiter.PauseFor(retryAfterErr.RetryAfter)
+		return
+	}
+}
+
 // writeResponse is used to write the response to the response channel.
 func (h *APIQueryHandlerImpl[K, V]) writeResponse(
 	ctx context.Context,
@@ -210,6 +210,7 @@ func TestAPIQueryHandler(t *testing.T) {
 				m.On("ObserveProviderResponseLatency", "handler1", metrics.RedactedURL, mock.Anything).Maybe()
 				m.On("AddHTTPStatusCode", "handler1", mock.Anything).Maybe()
 				m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(btcusd)), providertypes.ErrorRateLimitExceeded).Maybe()
+				m.On("AddRateLimitExceeded", "handler1").Maybe()
 				return m
 			},
 			ids:    []slinkytypes.CurrencyPair{btcusd},
@@ -488,6 +489,7 @@ func TestAPIQueryHandler(t *testing.T) {
 				m.On("AddHTTPStatusCode", "handler1", mock.Anything).Maybe()
 				m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(btcusd)), providertypes.OK).Maybe()
 				m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(ethusd)), providertypes.ErrorRateLimitExceeded).Maybe()
+				m.On("AddRateLimitExceeded", "handler1").Maybe()
 				m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(atomusd)), providertypes.OK).Maybe()
 
 				return m
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/pkg/math"
+	"github.com/skip-mev/slinky/providers/base/api/errors"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+// ResultAggregator aggregates the results that multiple endpoints resolved for a single ID
+// into a single result. The results are never empty.
+type ResultAggregator[V providertypes.ResponseValue] func(
+	results []providertypes.ResolvedResult[V],
+) providertypes.ResolvedResult[V]
+
+// FreshestResult is a ResultAggregator that returns the most recent of the results.
+func FreshestResult[V providertypes.ResponseValue](
+	results []providertypes.ResolvedResult[V],
+) providertypes.ResolvedResult[V] {
+	freshest := results[0]
+	for _, result := range results[1:] {
+		if result.Timestamp.After(freshest.Timestamp) {
+			freshest = result
+		}
+	}
+
+	return freshest
+}
+
+// MedianPriceResult is a ResultAggregator for prices that returns the median of the results.
+// The optional volume, best bid and best ask are the medians of the results that report them,
+// and the timestamp is the timestamp of the most recent result.
+func MedianPriceResult(
+	results []providertypes.ResolvedResult[*big.Float],
+) providertypes.ResolvedResult[*big.Float] {
+	var (
+		prices  = make([]*big.Float, 0, len(results))
+		volumes = make([]*big.Float, 0, len(results))
+		bids    = make([]*big.Float, 0, len(results))
+		asks    = make([]*big.Float, 0, len(results))
+	)
+	for _, result := range results {
+		prices = append(prices, result.Value)
+		if result.Volume != nil {
+			volumes = append(volumes, result.Volume)
+		}
+		if result.BestBid != nil && result.BestAsk != nil {
+			bids = append(bids, result.BestBid)
+			asks = append(asks, result.BestAsk)
+		}
+	}
+
+	median := providertypes.NewResult(
+		math.CalculateMedian(prices),
+		FreshestResult(results).Timestamp,
+	)
+	median.Volume = math.CalculateMedian(volumes)
+	median.BestBid = math.CalculateMedian(bids)
+	median.BestAsk = math.CalculateMedian(asks)
+
+	return median
+}
+
+// MultiRestAPIFetcher is an APIFetcher that fetches data from multiple endpoints of a REST API,
+// each of which is queried by a separate fetcher. The fetcher supports two modes of operation,
+// as configured by the endpoint mode of the API config:
+//
+//   - config.EndpointModeFallback: The endpoints are queried in order. Any IDs that could not be
+//     resolved by an endpoint are queried from the next endpoint.
+//   - config.EndpointModeFreshest and config.EndpointModeMedian: All endpoints are queried
+//     concurrently. An ID is resolved if at least a quorum of endpoints resolved it, in which
+//     case the results are combined using the aggregator.
+type MultiRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	// fetchers are the fetchers for each of the endpoints, in the order of the endpoints.
+	fetchers []APIFetcher[K, V]
+
+	// aggregator is used to combine the results of the endpoints when querying all endpoints.
+	aggregator ResultAggregator[V]
+
+	// config is the configuration for the API.
+	config config.APIConfig
+
+	// logger
+	logger *zap.Logger
+}
+
+// NewMultiRestAPIFetcher creates a new MultiRestAPIFetcher. The fetchers must correspond to
+// the endpoints of the API config, in order. The aggregator is only required if the endpoint
+// mode queries all endpoints.
+func NewMultiRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	fetchers []APIFetcher[K, V],
+	aggregator ResultAggregator[V],
+	cfg config.APIConfig,
+	logger *zap.Logger,
+) (*MultiRestAPIFetcher[K, V], error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if !cfg.Enabled {
+		return nil, fmt.Errorf("api is disabled")
+	}
+
+	if len(fetchers) != len(cfg.Endpoints) {
+		return nil, fmt.Errorf("expected %d fetchers, got %d", len(cfg.Endpoints), len(fetchers))
+	}
+
+	for i, fetcher := range fetchers {
+		if fetcher == nil {
+			return nil, fmt.Errorf("fetcher for endpoint %d is nil", i)
+		}
+	}
+
+	if cfg.EndpointMode != "" && cfg.EndpointMode != config.EndpointModeFallback && aggregator == nil {
+		return nil, fmt.Errorf("aggregator is nil")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger is nil")
+	}
+
+	return &MultiRestAPIFetcher[K, V]{
+		fetchers:   fetchers,
+		aggregator: aggregator,
+		config:     cfg,
+		logger:     logger.With(zap.String("fetcher", cfg.Name)),
+	}, nil
+}
+
+// Fetch is used to fetch the corresponding IDs from the endpoints of the API. This method
+// blocks until the responses are received from the endpoints and combined.
+func (f *MultiRestAPIFetcher[K, V]) Fetch(
+	ctx context.Context,
+	ids []K,
+) providertypes.GetResponse[K, V] {
+	switch f.config.EndpointMode {
+	case config.EndpointModeFreshest, config.EndpointModeMedian:
+		return f.fetchQuorum(ctx, ids)
+	default:
+		return f.fetchFallback(ctx, ids)
+	}
+}
+
+// fetchFallback queries the endpoints in order until all IDs are resolved or all endpoints
+// have been queried. Each endpoint is only queried for the IDs that are still unresolved.
+func (f *MultiRestAPIFetcher[K, V]) fetchFallback(
+	ctx context.Context,
+	ids []K,
+) providertypes.GetResponse[K, V] {
+	var (
+		resolved   = make(map[K]providertypes.ResolvedResult[V])
+		unresolved = make(map[K]providertypes.UnresolvedResult)
+		remaining  = ids
+	)
+
+	for i, fetcher := range f.fetchers {
+		if len(remaining) == 0 || ctx.Err() != nil {
+			break
+		}
+
+		if i > 0 {
+			f.logger.Debug(
+				"falling back to next endpoint",
+				zap.Int("endpoint", i),
+				zap.Int("num_ids", len(remaining)),
+			)
+		}
+
+		response := fetcher.Fetch(ctx, remaining)
+
+		next := make([]K, 0, len(remaining))
+		for _, id := range remaining {
+			if result, ok := response.Resolved[id]; ok {
+				resolved[id] = result
+				delete(unresolved, id)
+				continue
+			}
+
+			if result, ok := response.UnResolved[id]; ok {
+				unresolved[id] = result
+			} else {
+				unresolved[id] = providertypes.UnresolvedResult{
+					ErrorWithCode: providertypes.NewErrorWithCode(
+						fmt.Errorf("no response"),
+						providertypes.ErrorNoResponse,
+					),
+				}
+			}
+			next = append(next, id)
+		}
+
+		remaining = next
+	}
+
+	// Add the IDs that were never queried, i.e. if the context was cancelled, to the unresolved map.
+	for _, id := range remaining {
+		if _, ok := unresolved[id]; !ok {
+			unresolved[id] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					errors.ErrDoRequestWithErr(ctx.Err()),
+					providertypes.ErrorUnknown,
+				),
+			}
+		}
+	}
+
+	return providertypes.NewGetResponse(resolved, unresolved)
+}
+
+// fetchQuorum queries all endpoints concurrently and combines the results of the IDs that were
+// resolved by at least a quorum of endpoints.
+func (f *MultiRestAPIFetcher[K, V]) fetchQuorum(
+	ctx context.Context,
+	ids []K,
+) providertypes.GetResponse[K, V] {
+	responses := make([]providertypes.GetResponse[K, V], len(f.fetchers))
+
+	var wg sync.WaitGroup
+	wg.Add(len(f.fetchers))
+	for i, fetcher := range f.fetchers {
+		go func(i int, fetcher APIFetcher[K, V]) {
+			defer wg.Done()
+			responses[i] = fetcher.Fetch(ctx, ids)
+		}(i, fetcher)
+	}
+	wg.Wait()
+
+	var (
+		resolved   = make(map[K]providertypes.ResolvedResult[V])
+		unresolved = make(map[K]providertypes.UnresolvedResult)
+		quorum     = f.config.EffectiveQuorum()
+	)
+
+	for _, id := range ids {
+		results := make([]providertypes.ResolvedResult[V], 0, len(responses))
+		for _, response := range responses {
+			if result, ok := response.Resolved[id]; ok {
+				results = append(results, result)
+			}
+		}
+
+		if len(results) < quorum {
+			f.logger.Debug(
+				"failed to reach quorum",
+				zap.String("id", id.String()),
+				zap.Int("resolved", len(results)),
+				zap.Int("quorum", quorum),
+			)
+
+			unresolved[id] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					errors.ErrQuorumNotReachedWithCount(len(results), quorum),
+					providertypes.ErrorNoResponse,
+				),
+			}
+			continue
+		}
+
+		resolved[id] = f.aggregator(results)
+	}
+
+	return providertypes.NewGetResponse(resolved, unresolved)
+}
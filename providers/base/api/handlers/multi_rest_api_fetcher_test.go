@@ -0,0 +1,266 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/providers/base/api/errors"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+type (
+	priceFetcher = handlers.APIFetcher[slinkytypes.CurrencyPair, *big.Float]
+	priceResult  = providertypes.ResolvedResult[*big.Float]
+)
+
+var multiEndpointCfg = config.APIConfig{
+	Enabled:          true,
+	Timeout:          500 * time.Millisecond,
+	Interval:         250 * time.Millisecond,
+	ReconnectTimeout: 250 * time.Millisecond,
+	MaxQueries:       1,
+	Atomic:           true,
+	Endpoints:        []config.Endpoint{{URL: constantURL}, {URL: constantURL}, {URL: constantURL}},
+	Name:             "handler1",
+}
+
+func priceResponse(
+	resolved map[slinkytypes.CurrencyPair]priceResult,
+	unresolved ...slinkytypes.CurrencyPair,
+) providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Float] {
+	response := providertypes.NewGetResponseWithErr[slinkytypes.CurrencyPair, *big.Float](
+		unresolved,
+		providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+	)
+	for id, result := range resolved {
+		response.Resolved[id] = result
+	}
+
+	return response
+}
+
+func newMockFetchers(t *testing.T, n int) ([]priceFetcher, []*mocks.APIFetcher[slinkytypes.CurrencyPair, *big.Float]) {
+	t.Helper()
+
+	fetchers := make([]priceFetcher, n)
+	mockFetchers := make([]*mocks.APIFetcher[slinkytypes.CurrencyPair, *big.Float], n)
+	for i := range fetchers {
+		mockFetchers[i] = mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Float](t)
+		fetchers[i] = mockFetchers[i]
+	}
+
+	return fetchers, mockFetchers
+}
+
+func TestNewMultiRestAPIFetcher(t *testing.T) {
+	t.Run("mismatched number of fetchers", func(t *testing.T) {
+		fetchers, _ := newMockFetchers(t, 2)
+		_, err := handlers.NewMultiRestAPIFetcher(fetchers, nil, multiEndpointCfg, logger)
+		require.Error(t, err)
+	})
+
+	t.Run("missing aggregator when querying all endpoints", func(t *testing.T) {
+		c := multiEndpointCfg
+		c.EndpointMode = config.EndpointModeMedian
+
+		fetchers, _ := newMockFetchers(t, 3)
+		_, err := handlers.NewMultiRestAPIFetcher(fetchers, nil, c, logger)
+		require.Error(t, err)
+	})
+
+	t.Run("valid fallback fetcher", func(t *testing.T) {
+		fetchers, _ := newMockFetchers(t, 3)
+		_, err := handlers.NewMultiRestAPIFetcher(fetchers, nil, multiEndpointCfg, logger)
+		require.NoError(t, err)
+	})
+}
+
+func TestMultiRestAPIFetcherFallback(t *testing.T) {
+	now := time.Now()
+
+	t.Run("first endpoint resolves all ids", func(t *testing.T) {
+		fetchers, mockFetchers := newMockFetchers(t, 3)
+		f, err := handlers.NewMultiRestAPIFetcher(fetchers, nil, multiEndpointCfg, logger)
+		require.NoError(t, err)
+
+		mockFetchers[0].On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{btcusd, ethusd}).Return(
+			priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+				btcusd: providertypes.NewResult(big.NewFloat(100), now),
+				ethusd: providertypes.NewResult(big.NewFloat(10), now),
+			}),
+		).Once()
+
+		response := f.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd, ethusd})
+		require.Len(t, response.Resolved, 2)
+		require.Empty(t, response.UnResolved)
+	})
+
+	t.Run("falls back to the next endpoints for unresolved ids", func(t *testing.T) {
+		fetchers, mockFetchers := newMockFetchers(t, 3)
+		f, err := handlers.NewMultiRestAPIFetcher(fetchers, nil, multiEndpointCfg, logger)
+		require.NoError(t, err)
+
+		mockFetchers[0].On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{btcusd, ethusd, atomusd}).Return(
+			priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+				btcusd: providertypes.NewResult(big.NewFloat(100), now),
+			}, ethusd, atomusd),
+		).Once()
+		mockFetchers[1].On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{ethusd, atomusd}).Return(
+			priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+				ethusd: providertypes.NewResult(big.NewFloat(10), now),
+			}),
+		).Once()
+		mockFetchers[2].On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{atomusd}).Return(
+			priceResponse(nil, atomusd),
+		).Once()
+
+		response := f.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd, ethusd, atomusd})
+		require.Len(t, response.Resolved, 2)
+		require.Equal(t, big.NewFloat(100), response.Resolved[btcusd].Value)
+		require.Equal(t, big.NewFloat(10), response.Resolved[ethusd].Value)
+		require.Len(t, response.UnResolved, 1)
+		require.Contains(t, response.UnResolved, atomusd)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		fetchers, _ := newMockFetchers(t, 3)
+		f, err := handlers.NewMultiRestAPIFetcher(fetchers, nil, multiEndpointCfg, logger)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		response := f.Fetch(ctx, []slinkytypes.CurrencyPair{btcusd})
+		require.Empty(t, response.Resolved)
+		require.Contains(t, response.UnResolved, btcusd)
+	})
+}
+
+func TestMultiRestAPIFetcherQuorum(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name       string
+		mode       string
+		quorum     int
+		aggregator handlers.ResultAggregator[*big.Float]
+		responses  []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Float]
+		expected   map[slinkytypes.CurrencyPair]*big.Float
+		unresolved []slinkytypes.CurrencyPair
+	}{
+		{
+			name:       "median of all endpoints",
+			mode:       config.EndpointModeMedian,
+			aggregator: handlers.MedianPriceResult,
+			responses: []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Float]{
+				priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+					btcusd: providertypes.NewResult(big.NewFloat(100), now),
+				}),
+				priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+					btcusd: providertypes.NewResult(big.NewFloat(102), now),
+				}),
+				priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+					btcusd: providertypes.NewResult(big.NewFloat(500), now),
+				}),
+			},
+			expected: map[slinkytypes.CurrencyPair]*big.Float{
+				btcusd: big.NewFloat(102),
+			},
+			unresolved: []slinkytypes.CurrencyPair{ethusd},
+		},
+		{
+			name:       "median with the default quorum of a majority",
+			mode:       config.EndpointModeMedian,
+			aggregator: handlers.MedianPriceResult,
+			responses: []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Float]{
+				priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+					btcusd: providertypes.NewResult(big.NewFloat(100), now),
+					ethusd: providertypes.NewResult(big.NewFloat(10), now),
+				}),
+				priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+					btcusd: providertypes.NewResult(big.NewFloat(102), now),
+				}, ethusd),
+				priceResponse(nil, btcusd, ethusd),
+			},
+			expected: map[slinkytypes.CurrencyPair]*big.Float{
+				btcusd: big.NewFloat(101),
+			},
+			unresolved: []slinkytypes.CurrencyPair{ethusd},
+		},
+		{
+			name:       "freshest with a quorum of one",
+			mode:       config.EndpointModeFreshest,
+			quorum:     1,
+			aggregator: handlers.FreshestResult[*big.Float],
+			responses: []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Float]{
+				priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+					btcusd: providertypes.NewResult(big.NewFloat(100), now),
+				}),
+				priceResponse(map[slinkytypes.CurrencyPair]priceResult{
+					btcusd: providertypes.NewResult(big.NewFloat(102), now.Add(time.Second)),
+					ethusd: providertypes.NewResult(big.NewFloat(10), now),
+				}),
+				priceResponse(nil, btcusd, ethusd),
+			},
+			expected: map[slinkytypes.CurrencyPair]*big.Float{
+				btcusd: big.NewFloat(102),
+				ethusd: big.NewFloat(10),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := multiEndpointCfg
+			c.EndpointMode = tc.mode
+			c.Quorum = tc.quorum
+
+			fetchers, mockFetchers := newMockFetchers(t, len(tc.responses))
+			for i, response := range tc.responses {
+				mockFetchers[i].On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{btcusd, ethusd}).Return(response).Once()
+			}
+
+			f, err := handlers.NewMultiRestAPIFetcher(fetchers, tc.aggregator, c, logger)
+			require.NoError(t, err)
+
+			response := f.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd, ethusd})
+			require.Len(t, response.Resolved, len(tc.expected))
+			for id, price := range tc.expected {
+				require.Contains(t, response.Resolved, id)
+				require.Equal(t, price.String(), response.Resolved[id].Value.String())
+			}
+
+			require.Len(t, response.UnResolved, len(tc.unresolved))
+			for _, id := range tc.unresolved {
+				require.Contains(t, response.UnResolved, id)
+				require.ErrorContains(t, response.UnResolved[id], errors.ErrQuorumNotReached.Error())
+			}
+		})
+	}
+}
+
+func TestMedianPriceResult(t *testing.T) {
+	now := time.Now()
+
+	result := handlers.MedianPriceResult([]priceResult{
+		providertypes.NewResult(big.NewFloat(100), now).WithVolume(big.NewFloat(5)),
+		providertypes.NewResult(big.NewFloat(104), now.Add(time.Second)).WithBidAsk(big.NewFloat(103), big.NewFloat(105)),
+		providertypes.NewResult(big.NewFloat(101), now).WithVolume(big.NewFloat(7)),
+	})
+
+	require.Equal(t, big.NewFloat(101).String(), result.Value.String())
+	require.Equal(t, now.Add(time.Second), result.Timestamp)
+	require.Equal(t, big.NewFloat(6).String(), result.Volume.String())
+	require.Equal(t, big.NewFloat(103).String(), result.BestBid.String())
+	require.Equal(t, big.NewFloat(105).String(), result.BestAsk.String())
+}
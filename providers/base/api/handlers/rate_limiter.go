@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// MaxRetryAfter is the maximum amount of time the rate limiter is paused for when a provider
+// responds with a Retry-After header.
+const MaxRetryAfter = 5 * time.Minute
+
+// sharedRateLimiters are the rate limiters of the rate limit groups, which are shared by all
+// providers in the same group.
+var sharedRateLimiters = struct {
+	sync.Mutex
+	limiters map[string]*RateLimiter
+}{
+	limiters: make(map[string]*RateLimiter),
+}
+
+// RateLimiter limits the rate at which requests are made to a provider using a token bucket. In
+// addition, the rate limiter can be paused, e.g. when a provider responds with a Retry-After
+// header.
+type RateLimiter struct {
+	sync.Mutex
+
+	// limiter is the token bucket that limits the rate of requests.
+	limiter *rate.Limiter
+
+	// pausedUntil is the time until which no requests are made.
+	pausedUntil time.Time
+
+	// cfg is the rate limit config that the token bucket was last configured with.
+	cfg config.RateLimitConfig
+}
+
+// NewRateLimiter returns a new RateLimiter for the given rate limit config. If rate limiting is
+// disabled, the rate of requests is not limited but the rate limiter can still be paused.
+func NewRateLimiter(cfg config.RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rateLimit(cfg), cfg.EffectiveBurst()),
+		cfg:     cfg,
+	}
+}
+
+// rateLimit returns the rate of the token bucket for the given rate limit config.
+func rateLimit(cfg config.RateLimitConfig) rate.Limit {
+	if !cfg.Enabled() {
+		return rate.Inf
+	}
+
+	return rate.Limit(cfg.RequestsPerSecond)
+}
+
+// RateLimiterFromConfig returns the rate limiter for the given API config. Providers with the
+// same rate limit group share a single rate limiter, otherwise a new rate limiter is returned.
+func RateLimiterFromConfig(cfg config.APIConfig) *RateLimiter {
+	group := cfg.RateLimit.Group
+	if !cfg.RateLimit.Enabled() || len(group) == 0 {
+		return NewRateLimiter(cfg.RateLimit)
+	}
+
+	sharedRateLimiters.Lock()
+	defer sharedRateLimiters.Unlock()
+
+	limiter, ok := sharedRateLimiters.limiters[group]
+	if !ok {
+		limiter = NewRateLimiter(cfg.RateLimit)
+		sharedRateLimiters.limiters[group] = limiter
+	}
+
+	// The request budget of the group may have changed, e.g. if the config was reloaded.
+	limiter.update(cfg.RateLimit)
+
+	return limiter
+}
+
+// PruneRateLimiters removes the shared rate limiters of all rate limit groups that are not used by
+// any enabled API provider in the given oracle config. This should be called whenever the oracle
+// config is updated.
+func PruneRateLimiters(cfg config.OracleConfig) {
+	groups := make(map[string]struct{})
+	for _, provider := range cfg.Providers {
+		if provider.API.Enabled && provider.API.RateLimit.Enabled() && len(provider.API.RateLimit.Group) > 0 {
+			groups[provider.API.RateLimit.Group] = struct{}{}
+		}
+	}
+
+	sharedRateLimiters.Lock()
+	defer sharedRateLimiters.Unlock()
+
+	for group := range sharedRateLimiters.limiters {
+		if _, ok := groups[group]; !ok {
+			delete(sharedRateLimiters.limiters, group)
+		}
+	}
+}
+
+// update reconfigures the token bucket if the given rate limit config differs from the one it was
+// last configured with.
+func (r *RateLimiter) update(cfg config.RateLimitConfig) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.cfg == cfg {
+		return
+	}
+
+	r.limiter.SetLimit(rateLimit(cfg))
+	r.limiter.SetBurst(cfg.EffectiveBurst())
+	r.cfg = cfg
+}
+
+// Wait blocks until a request can be made or the context is cancelled.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.Lock()
+	pausedUntil := r.pausedUntil
+	r.Unlock()
+
+	if d := time.Until(pausedUntil); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return r.limiter.Wait(ctx)
+}
+
+// PauseFor pauses the rate limiter for the given duration, capped at MaxRetryAfter. Requests
+// that are already waiting are not affected.
+func (r *RateLimiter) PauseFor(d time.Duration) {
+	if d > MaxRetryAfter {
+		d = MaxRetryAfter
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if until := time.Now().Add(d); until.After(r.pausedUntil) {
+		r.pausedUntil = until
+	}
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which is either a number of seconds
+// or an HTTP date, and returns the amount of time to wait relative to now.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	if d := date.Sub(now); d > 0 {
+		return d, true
+	}
+
+	return 0, true
+}
@@ -0,0 +1,263 @@
+package handlers_test
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/providers/base/api/errors"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	mockmetrics "github.com/skip-mev/slinky/providers/base/api/metrics/mocks"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("disabled rate limiter does not block", func(t *testing.T) {
+		limiter := handlers.NewRateLimiter(config.RateLimitConfig{})
+
+		start := time.Now()
+		for i := 0; i < 100; i++ {
+			require.NoError(t, limiter.Wait(context.Background()))
+		}
+		require.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("requests beyond the burst are delayed", func(t *testing.T) {
+		limiter := handlers.NewRateLimiter(config.RateLimitConfig{
+			RequestsPerSecond: 10,
+			Burst:             2,
+		})
+
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			require.NoError(t, limiter.Wait(context.Background()))
+		}
+
+		// The first two requests are allowed immediately, the next two after 100ms each.
+		require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("paused rate limiter blocks until the pause ends", func(t *testing.T) {
+		limiter := handlers.NewRateLimiter(config.RateLimitConfig{})
+		limiter.PauseFor(200 * time.Millisecond)
+
+		start := time.Now()
+		require.NoError(t, limiter.Wait(context.Background()))
+		require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	})
+
+	t.Run("paused rate limiter respects the context", func(t *testing.T) {
+		limiter := handlers.NewRateLimiter(config.RateLimitConfig{})
+		limiter.PauseFor(time.Minute)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("providers in the same group share a rate limiter", func(t *testing.T) {
+		rateLimit := config.RateLimitConfig{
+			RequestsPerSecond: 1,
+			Group:             "rate-limiter-test",
+		}
+
+		first := cfg
+		first.Name = "first"
+		first.RateLimit = rateLimit
+
+		second := cfg
+		second.Name = "second"
+		second.RateLimit = rateLimit
+
+		third := cfg
+		third.Name = "third"
+		third.RateLimit = config.RateLimitConfig{RequestsPerSecond: 1}
+
+		require.Same(t, handlers.RateLimiterFromConfig(first), handlers.RateLimiterFromConfig(second))
+		require.NotSame(t, handlers.RateLimiterFromConfig(first), handlers.RateLimiterFromConfig(third))
+	})
+
+	t.Run("shared rate limiter is updated when the group's rate limit changes", func(t *testing.T) {
+		first := cfg
+		first.RateLimit = config.RateLimitConfig{
+			RequestsPerSecond: 0.1,
+			Group:             "rate-limiter-update-test",
+		}
+
+		limiter := handlers.RateLimiterFromConfig(first)
+		require.NoError(t, limiter.Wait(context.Background()))
+
+		// A reloaded config raises the request budget of the group.
+		second := first
+		second.RateLimit.RequestsPerSecond = 1000
+		second.RateLimit.Burst = 10
+		require.Same(t, limiter, handlers.RateLimiterFromConfig(second))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		for i := 0; i < 5; i++ {
+			require.NoError(t, limiter.Wait(ctx))
+		}
+	})
+
+	t.Run("rate limiters of unused groups are pruned", func(t *testing.T) {
+		used := cfg
+		used.Name = "used"
+		used.RateLimit = config.RateLimitConfig{
+			RequestsPerSecond: 1,
+			Group:             "rate-limiter-used-test",
+		}
+
+		unused := cfg
+		unused.Name = "unused"
+		unused.RateLimit = config.RateLimitConfig{
+			RequestsPerSecond: 1,
+			Group:             "rate-limiter-unused-test",
+		}
+
+		usedLimiter := handlers.RateLimiterFromConfig(used)
+		unusedLimiter := handlers.RateLimiterFromConfig(unused)
+
+		handlers.PruneRateLimiters(config.OracleConfig{
+			Providers: map[string]config.ProviderConfig{
+				used.Name: {Name: used.Name, API: used},
+			},
+		})
+
+		require.Same(t, usedLimiter, handlers.RateLimiterFromConfig(used))
+		require.NotSame(t, unusedLimiter, handlers.RateLimiterFromConfig(unused))
+	})
+}
+
+func TestAPIQueryHandlerRateLimited(t *testing.T) {
+	apiCfg := cfg
+	apiCfg.Interval = 10 * time.Millisecond
+	apiCfg.RateLimit = config.RateLimitConfig{RequestsPerSecond: 0.1}
+
+	fetcher := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+	fetcher.On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{btcusd}).Return(
+		providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+			map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+				btcusd: {Value: big.NewInt(100)},
+			},
+			nil,
+		),
+	).Once()
+
+	handler, err := handlers.NewAPIQueryHandlerWithFetcher[slinkytypes.CurrencyPair, *big.Int](
+		logger,
+		apiCfg,
+		fetcher,
+		metrics.NewNopAPIMetrics(),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], 1)
+	go handler.Query(ctx, []slinkytypes.CurrencyPair{btcusd}, responseCh)
+
+	// The first request is allowed, after which the request budget is exhausted for longer than
+	// the context allows for. The ID is reported as rate limited instead of being dropped.
+	resp := <-responseCh
+	require.Contains(t, resp.Resolved, btcusd)
+
+	resp = <-responseCh
+	require.Contains(t, resp.UnResolved, btcusd)
+	require.Equal(t, providertypes.ErrorRateLimitExceeded, resp.UnResolved[btcusd].Code())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "seconds",
+			value:    "120",
+			expected: 2 * time.Minute,
+			ok:       true,
+		},
+		{
+			name:     "http date",
+			value:    "Mon, 01 Jan 2024 00:00:30 GMT",
+			expected: 30 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "http date in the past",
+			value:    "Sun, 31 Dec 2023 23:59:00 GMT",
+			expected: 0,
+			ok:       true,
+		},
+		{
+			name:  "negative seconds",
+			value: "-1",
+		},
+		{
+			name:  "invalid",
+			value: "soon",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			retryAfter, ok := handlers.ParseRetryAfter(tc.value, now)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, retryAfter)
+		})
+	}
+}
+
+func TestRestAPIFetcherRetryAfter(t *testing.T) {
+	requestHandler := mocks.NewRequestHandler(t)
+	requestHandler.On("Do", mock.Anything, constantURL).Return(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"30"}},
+		Body:       io.NopCloser(strings.NewReader(`{"error": "rate limit exceeded"}`)),
+	}, nil).Once()
+
+	apiDataHandler := mocks.NewAPIDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+	apiDataHandler.On("CreateURL", []slinkytypes.CurrencyPair{btcusd}).Return(constantURL, nil).Once()
+
+	m := mockmetrics.NewAPIMetrics(t)
+	m.On("ObserveProviderResponseLatency", "handler1", metrics.RedactedURL, mock.Anything).Once()
+	m.On("AddHTTPStatusCode", "handler1", mock.Anything).Once()
+	m.On("AddRateLimitExceeded", "handler1").Once()
+
+	fetcher, err := handlers.NewRestAPIFetcher(requestHandler, apiDataHandler, m, cfg, logger)
+	require.NoError(t, err)
+
+	response := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+	require.Contains(t, response.UnResolved, btcusd)
+
+	result := response.UnResolved[btcusd]
+	require.Equal(t, providertypes.ErrorRateLimitExceeded, result.Code())
+	require.ErrorIs(t, result, errors.ErrRateLimit)
+
+	var retryAfterErr errors.RetryAfterError
+	require.True(t, stderrors.As(result, &retryAfterErr))
+	require.Equal(t, 30*time.Second, retryAfterErr.RetryAfter)
+}
@@ -134,10 +134,18 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 	var response providertypes.GetResponse[K, V]
 	switch {
 	case resp.StatusCode == http.StatusTooManyRequests:
+		pf.metrics.AddRateLimitExceeded(pf.config.Name)
+
+		// Surface how long the provider asked to wait, such that the rate limiter can be paused.
+		err := errors.ErrRateLimit
+		if retryAfter, ok := ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			err = errors.ErrRateLimitWithRetryAfter(retryAfter)
+		}
+
 		response = providertypes.NewGetResponseWithErr[K, V](
 			ids,
 			providertypes.NewErrorWithCode(
-				errors.ErrRateLimit,
+				err,
 				providertypes.ErrorRateLimitExceeded,
 			),
 		)
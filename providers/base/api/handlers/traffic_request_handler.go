@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+var (
+	_ RequestHandler = (*RecordingRequestHandler)(nil)
+	_ RequestHandler = (*ReplayRequestHandler)(nil)
+)
+
+// RecordingRequestHandler is a RequestHandler that records the raw responses of the provider
+// before returning them.
+type RecordingRequestHandler struct {
+	// handler is the underlying request handler.
+	handler RequestHandler
+
+	// recorder records the responses.
+	recorder *recorder.Recorder
+
+	// provider is the name of the provider.
+	provider string
+}
+
+// NewRecordingRequestHandler returns a new RecordingRequestHandler that records the responses
+// of the given request handler.
+func NewRecordingRequestHandler(
+	handler RequestHandler,
+	rec *recorder.Recorder,
+	provider string,
+) (RequestHandler, error) {
+	if handler == nil {
+		return nil, fmt.Errorf("request handler cannot be nil")
+	}
+
+	if rec == nil {
+		return nil, fmt.Errorf("recorder cannot be nil")
+	}
+
+	return &RecordingRequestHandler{
+		handler:  handler,
+		recorder: rec,
+		provider: provider,
+	}, nil
+}
+
+// Do sends the request using the underlying request handler and records the response. The
+// body of the returned response can be read as usual.
+func (r *RecordingRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	resp, err := r.handler.Do(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	err = r.recorder.Record(recorder.Record{
+		Provider:   r.provider,
+		Type:       recorder.HTTPRecord,
+		Timestamp:  time.Now().UTC(),
+		URL:        url,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record response: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Type returns the HTTP method of the underlying request handler.
+func (r *RecordingRequestHandler) Type() string {
+	return r.handler.Type()
+}
+
+// ReplayRequestHandler is a RequestHandler that replays the recorded responses of the provider
+// instead of sending requests over the network.
+type ReplayRequestHandler struct {
+	// recording contains the recorded responses.
+	recording *recorder.Recording
+
+	// provider is the name of the provider.
+	provider string
+
+	// method is the HTTP method of the replayed requests.
+	method string
+}
+
+// NewReplayRequestHandler returns a new ReplayRequestHandler that replays the responses of the
+// provider from the given recording.
+func NewReplayRequestHandler(
+	recording *recorder.Recording,
+	provider string,
+	method string,
+) (RequestHandler, error) {
+	if recording == nil {
+		return nil, fmt.Errorf("recording cannot be nil")
+	}
+
+	if method == "" {
+		return nil, fmt.Errorf("http request method cannot be empty")
+	}
+
+	return &ReplayRequestHandler{
+		recording: recording,
+		provider:  provider,
+		method:    method,
+	}, nil
+}
+
+// Do returns the next recorded response to a request with the given URL. This blocks until the
+// response is due, relative to the start of the replay.
+func (r *ReplayRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	record, err := r.recording.NextResponse(ctx, r.provider, url)
+	if err != nil {
+		return nil, err
+	}
+
+	header := record.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", record.StatusCode, http.StatusText(record.StatusCode)),
+		StatusCode: record.StatusCode,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(record.Body)),
+	}, nil
+}
+
+// Type returns the HTTP method of the replayed requests.
+func (r *ReplayRequestHandler) Type() string {
+	return r.method
+}
@@ -0,0 +1,66 @@
+package handlers_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+func TestRecordAndReplayRequestHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traffic.jsonl")
+	rec, err := recorder.New(recorder.Config{Path: path})
+	require.NoError(t, err)
+
+	requestHandler := mocks.NewRequestHandler(t)
+	requestHandler.On("Do", mock.Anything, constantURL).Return(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"30"}},
+		Body:       io.NopCloser(strings.NewReader(`{"error": "rate limit exceeded"}`)),
+	}, nil).Once()
+	requestHandler.On("Type").Return(http.MethodGet)
+
+	recordingHandler, err := handlers.NewRecordingRequestHandler(requestHandler, rec, "handler1")
+	require.NoError(t, err)
+	require.Equal(t, http.MethodGet, recordingHandler.Type())
+
+	// The body of the recorded response can still be read.
+	resp, err := recordingHandler.Do(context.Background(), constantURL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, `{"error": "rate limit exceeded"}`, string(body))
+	require.NoError(t, rec.Close())
+
+	recording, err := recorder.Load(path)
+	require.NoError(t, err)
+
+	replayHandler, err := handlers.NewReplayRequestHandler(recording, "handler1", http.MethodGet)
+	require.NoError(t, err)
+	require.Equal(t, http.MethodGet, replayHandler.Type())
+
+	resp, err = replayHandler.Do(context.Background(), constantURL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "30", resp.Header.Get("Retry-After"))
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, `{"error": "rate limit exceeded"}`, string(body))
+
+	// Requests that were not recorded fail.
+	_, err = replayHandler.Do(context.Background(), "http://other.org")
+	require.ErrorIs(t, err, recorder.ErrNotRecorded)
+
+	// The recording is exhausted.
+	_, err = replayHandler.Do(context.Background(), constantURL)
+	require.ErrorIs(t, err, recorder.ErrExhausted)
+}
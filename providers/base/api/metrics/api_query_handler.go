@@ -36,6 +36,10 @@ type APIMetrics interface {
 	// within a single interval. Note that if the provider is not atomic, this will be the
 	// time it took for all the requests to complete.
 	ObserveProviderResponseLatency(providerName, endpoint string, duration time.Duration)
+
+	// AddRateLimitExceeded increments the number of responses by provider that indicated that
+	// the rate limit of the provider was exceeded, i.e. HTTP 429 responses.
+	AddRateLimitExceeded(providerName string)
 }
 
 // APIMetricsImpl contains metrics exposed by this package.
@@ -51,6 +55,9 @@ type APIMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	apiResponseTimePerProvider *prometheus.HistogramVec
+
+	// Number of provider responses that indicated that the rate limit was exceeded.
+	apiRateLimitExceededPerProvider *prometheus.CounterVec
 }
 
 // NewAPIMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -85,6 +92,11 @@ func NewAPIMetrics() APIMetrics {
 			Help:      "Response time per API provider. URL may be redacted but will correspond to indices in the oracle config.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
+		apiRateLimitExceededPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_rate_limit_exceeded",
+			Help:      "Number of API provider responses that indicated that the rate limit of the provider was exceeded.",
+		}, []string{providermetrics.ProviderLabel}),
 	}
 
 	// register the above metrics
@@ -92,6 +104,7 @@ func NewAPIMetrics() APIMetrics {
 	prometheus.MustRegister(m.apiHTTPStatusCodePerProvider)
 	prometheus.MustRegister(m.apiRPCStatusCodePerProvider)
 	prometheus.MustRegister(m.apiResponseTimePerProvider)
+	prometheus.MustRegister(m.apiRateLimitExceededPerProvider)
 
 	return m
 }
@@ -107,6 +120,7 @@ func (m *noOpAPIMetricsImpl) AddProviderResponse(_ string, _ string, _ providert
 func (m *noOpAPIMetricsImpl) AddHTTPStatusCode(_ string, _ *http.Response)                      {}
 func (m *noOpAPIMetricsImpl) AddRPCStatusCode(_, _ string, _ RPCCode)                           {}
 func (m *noOpAPIMetricsImpl) ObserveProviderResponseLatency(_, _ string, _ time.Duration)       {}
+func (m *noOpAPIMetricsImpl) AddRateLimitExceeded(_ string)                                     {}
 
 // AddProviderResponse increments the number of requests by provider and status.
 func (m *APIMetricsImpl) AddProviderResponse(providerName string, id string, err providertypes.ErrorCode) {
@@ -172,3 +186,10 @@ func (m *APIMetricsImpl) ObserveProviderResponseLatency(providerName, endpoint s
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// AddRateLimitExceeded increments the number of rate limited responses by provider.
+func (m *APIMetricsImpl) AddRateLimitExceeded(providerName string) {
+	m.apiRateLimitExceededPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+	}).Add(1)
+}
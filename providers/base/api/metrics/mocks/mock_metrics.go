@@ -34,6 +34,11 @@ func (_m *APIMetrics) AddRPCStatusCode(providerName string, endpoint string, cod
 	_m.Called(providerName, endpoint, code)
 }
 
+// AddRateLimitExceeded provides a mock function with given fields: providerName
+func (_m *APIMetrics) AddRateLimitExceeded(providerName string) {
+	_m.Called(providerName)
+}
+
 // ObserveProviderResponseLatency provides a mock function with given fields: providerName, endpoint, duration
 func (_m *APIMetrics) ObserveProviderResponseLatency(providerName string, endpoint string, duration time.Duration) {
 	_m.Called(providerName, endpoint, duration)
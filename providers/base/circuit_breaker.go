@@ -0,0 +1,171 @@
+package base
+
+import (
+	"sync"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+// CircuitBreakerTransitionFn is called every time the state of a circuit breaker changes, with
+// the previous state and the new status of the circuit breaker.
+type CircuitBreakerTransitionFn func(from providertypes.CircuitBreakerState, to providertypes.CircuitBreakerStatus)
+
+// CircuitBreaker quarantines a provider after repeated failures or outlier prices. The circuit
+// breaker starts closed. It trips (opens) once the number of consecutive failures or outliers
+// reaches the configured threshold, and half-opens once the cool-down has elapsed. While
+// half-open, the provider's data is used to probe whether the provider has recovered:
+//
+//   - If the circuit breaker tripped due to failures, it closes after the next successful
+//     response and opens again after the next failed response.
+//   - If the circuit breaker tripped due to outliers, it closes after the next price update
+//     without outliers and opens again after the next price update with outliers.
+//
+// A circuit breaker with a zero config never trips.
+type CircuitBreaker struct {
+	mu sync.Mutex
+
+	// cfg is the circuit breaker configuration.
+	cfg config.CircuitBreakerConfig
+
+	// status is the current status of the circuit breaker.
+	status providertypes.CircuitBreakerStatus
+
+	// onTransition is the (optional) callback that is called on every state change.
+	onTransition CircuitBreakerTransitionFn
+}
+
+// NewCircuitBreaker returns a new, closed circuit breaker for the given config. The transition
+// callback is optional.
+func NewCircuitBreaker(cfg config.CircuitBreakerConfig, onTransition CircuitBreakerTransitionFn) *CircuitBreaker {
+	return &CircuitBreaker{
+		cfg: cfg,
+		status: providertypes.CircuitBreakerStatus{
+			State: providertypes.CircuitBreakerClosed,
+		},
+		onTransition: onTransition,
+	}
+}
+
+// Status returns the current status of the circuit breaker.
+func (cb *CircuitBreaker) Status() providertypes.CircuitBreakerStatus {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.halfOpenIfCooledDown()
+	return cb.status
+}
+
+// Allow returns true if the provider's data may be used, i.e. the circuit breaker is not open.
+func (cb *CircuitBreaker) Allow() bool {
+	return cb.Status().State != providertypes.CircuitBreakerOpen
+}
+
+// RecordSuccess records a successful response from the provider.
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.halfOpenIfCooledDown()
+	switch cb.status.State {
+	case providertypes.CircuitBreakerOpen:
+		return
+	case providertypes.CircuitBreakerHalfOpen:
+		if cb.status.Reason == providertypes.CircuitBreakerFailures {
+			cb.close()
+			return
+		}
+	}
+
+	cb.status.ConsecutiveFailures = 0
+}
+
+// RecordFailure records a failed response from the provider.
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.halfOpenIfCooledDown()
+	switch cb.status.State {
+	case providertypes.CircuitBreakerOpen:
+		return
+	case providertypes.CircuitBreakerHalfOpen:
+		cb.trip(providertypes.CircuitBreakerFailures)
+		return
+	}
+
+	cb.status.ConsecutiveFailures++
+	if limit := cb.cfg.MaxConsecutiveFailures; limit > 0 && cb.status.ConsecutiveFailures >= limit {
+		cb.trip(providertypes.CircuitBreakerFailures)
+	}
+}
+
+// RecordOutliers records the number of the provider's prices that were rejected as outliers in
+// a price update.
+func (cb *CircuitBreaker) RecordOutliers(rejected int) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.halfOpenIfCooledDown()
+	if cb.status.State == providertypes.CircuitBreakerOpen {
+		return
+	}
+
+	if rejected == 0 {
+		if cb.status.State == providertypes.CircuitBreakerHalfOpen &&
+			cb.status.Reason == providertypes.CircuitBreakerOutliers {
+			cb.close()
+			return
+		}
+
+		cb.status.ConsecutiveOutliers = 0
+		return
+	}
+
+	if cb.status.State == providertypes.CircuitBreakerHalfOpen {
+		cb.trip(providertypes.CircuitBreakerOutliers)
+		return
+	}
+
+	cb.status.ConsecutiveOutliers++
+	if limit := cb.cfg.MaxConsecutiveOutliers; limit > 0 && cb.status.ConsecutiveOutliers >= limit {
+		cb.trip(providertypes.CircuitBreakerOutliers)
+	}
+}
+
+// halfOpenIfCooledDown half-opens the circuit breaker if it is open and the cool-down has
+// elapsed. This must be called with the lock held.
+func (cb *CircuitBreaker) halfOpenIfCooledDown() {
+	if cb.status.State == providertypes.CircuitBreakerOpen && !time.Now().Before(cb.status.OpenUntil) {
+		cb.transition(providertypes.CircuitBreakerHalfOpen)
+	}
+}
+
+// trip opens the circuit breaker for the configured cool-down. This must be called with the
+// lock held.
+func (cb *CircuitBreaker) trip(reason providertypes.CircuitBreakerReason) {
+	cb.status.Reason = reason
+	cb.status.ConsecutiveFailures = 0
+	cb.status.ConsecutiveOutliers = 0
+	cb.status.OpenUntil = time.Now().Add(cb.cfg.CoolDown)
+	cb.transition(providertypes.CircuitBreakerOpen)
+}
+
+// close closes the circuit breaker. This must be called with the lock held.
+func (cb *CircuitBreaker) close() {
+	cb.status.ConsecutiveFailures = 0
+	cb.status.ConsecutiveOutliers = 0
+	cb.transition(providertypes.CircuitBreakerClosed)
+}
+
+// transition sets the state of the circuit breaker and calls the transition callback. This must
+// be called with the lock held.
+func (cb *CircuitBreaker) transition(state providertypes.CircuitBreakerState) {
+	from := cb.status.State
+	cb.status.State = state
+
+	if cb.onTransition != nil {
+		cb.onTransition(from, cb.status)
+	}
+}
@@ -0,0 +1,145 @@
+package base_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var breakerCfg = config.CircuitBreakerConfig{
+	MaxConsecutiveFailures: 3,
+	MaxConsecutiveOutliers: 2,
+	CoolDown:               100 * time.Millisecond,
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("disabled circuit breaker never trips", func(t *testing.T) {
+		cb := base.NewCircuitBreaker(config.CircuitBreakerConfig{}, nil)
+		for i := 0; i < 100; i++ {
+			cb.RecordFailure()
+			cb.RecordOutliers(1)
+		}
+
+		require.True(t, cb.Allow())
+		require.Equal(t, providertypes.CircuitBreakerClosed, cb.Status().State)
+	})
+
+	t.Run("trips after consecutive failures", func(t *testing.T) {
+		cb := base.NewCircuitBreaker(breakerCfg, nil)
+
+		cb.RecordFailure()
+		cb.RecordFailure()
+		require.True(t, cb.Allow())
+		require.Equal(t, 2, cb.Status().ConsecutiveFailures)
+
+		cb.RecordFailure()
+		require.False(t, cb.Allow())
+
+		status := cb.Status()
+		require.Equal(t, providertypes.CircuitBreakerOpen, status.State)
+		require.Equal(t, providertypes.CircuitBreakerFailures, status.Reason)
+		require.True(t, status.OpenUntil.After(time.Now()))
+	})
+
+	t.Run("a success resets the consecutive failures", func(t *testing.T) {
+		cb := base.NewCircuitBreaker(breakerCfg, nil)
+
+		cb.RecordFailure()
+		cb.RecordFailure()
+		cb.RecordSuccess()
+		cb.RecordFailure()
+		cb.RecordFailure()
+		require.True(t, cb.Allow())
+		require.Equal(t, 2, cb.Status().ConsecutiveFailures)
+	})
+
+	t.Run("trips after consecutive price updates with outliers", func(t *testing.T) {
+		cb := base.NewCircuitBreaker(breakerCfg, nil)
+
+		cb.RecordOutliers(1)
+		cb.RecordOutliers(0)
+		cb.RecordOutliers(3)
+		require.True(t, cb.Allow())
+
+		// Successful responses do not reset the consecutive outliers.
+		cb.RecordSuccess()
+		cb.RecordOutliers(1)
+		require.False(t, cb.Allow())
+		require.Equal(t, providertypes.CircuitBreakerOutliers, cb.Status().Reason)
+	})
+
+	t.Run("half-opens after the cool-down and closes after a successful probe", func(t *testing.T) {
+		cb := base.NewCircuitBreaker(breakerCfg, nil)
+		for i := 0; i < breakerCfg.MaxConsecutiveFailures; i++ {
+			cb.RecordFailure()
+		}
+		require.False(t, cb.Allow())
+
+		// Responses are ignored while the circuit breaker is open.
+		cb.RecordSuccess()
+		require.False(t, cb.Allow())
+
+		time.Sleep(breakerCfg.CoolDown)
+		require.True(t, cb.Allow())
+		require.Equal(t, providertypes.CircuitBreakerHalfOpen, cb.Status().State)
+
+		cb.RecordSuccess()
+		require.Equal(t, providertypes.CircuitBreakerClosed, cb.Status().State)
+	})
+
+	t.Run("reopens after a failed probe", func(t *testing.T) {
+		cb := base.NewCircuitBreaker(breakerCfg, nil)
+		for i := 0; i < breakerCfg.MaxConsecutiveFailures; i++ {
+			cb.RecordFailure()
+		}
+
+		time.Sleep(breakerCfg.CoolDown)
+		require.Equal(t, providertypes.CircuitBreakerHalfOpen, cb.Status().State)
+
+		cb.RecordFailure()
+		require.False(t, cb.Allow())
+		require.Equal(t, providertypes.CircuitBreakerOpen, cb.Status().State)
+	})
+
+	t.Run("outlier probes require a price update without outliers", func(t *testing.T) {
+		cb := base.NewCircuitBreaker(breakerCfg, nil)
+		for i := 0; i < breakerCfg.MaxConsecutiveOutliers; i++ {
+			cb.RecordOutliers(1)
+		}
+
+		time.Sleep(breakerCfg.CoolDown)
+		cb.RecordSuccess()
+		require.Equal(t, providertypes.CircuitBreakerHalfOpen, cb.Status().State)
+
+		cb.RecordOutliers(1)
+		require.Equal(t, providertypes.CircuitBreakerOpen, cb.Status().State)
+
+		time.Sleep(breakerCfg.CoolDown)
+		cb.RecordOutliers(0)
+		require.Equal(t, providertypes.CircuitBreakerClosed, cb.Status().State)
+	})
+
+	t.Run("calls the transition callback on every state change", func(t *testing.T) {
+		var transitions []providertypes.CircuitBreakerState
+		cb := base.NewCircuitBreaker(breakerCfg, func(_ providertypes.CircuitBreakerState, to providertypes.CircuitBreakerStatus) {
+			transitions = append(transitions, to.State)
+		})
+
+		for i := 0; i < breakerCfg.MaxConsecutiveFailures; i++ {
+			cb.RecordFailure()
+		}
+		time.Sleep(breakerCfg.CoolDown)
+		cb.RecordSuccess()
+
+		require.Equal(t, []providertypes.CircuitBreakerState{
+			providertypes.CircuitBreakerOpen,
+			providertypes.CircuitBreakerHalfOpen,
+			providertypes.CircuitBreakerClosed,
+		}, transitions)
+	})
+}
@@ -199,6 +199,15 @@ func (p *Provider[K, V]) recv(ctx context.Context) {
 				p.metrics.AddProviderResponseByID(p.name, strID, providermetrics.Failure, result.Code(), p.Type())
 				p.metrics.AddProviderResponse(p.name, providermetrics.Failure, result.Code(), p.Type())
 			}
+
+			// Record the outcome of the response in the circuit breaker. A response is only
+			// considered failed if none of its IDs were resolved.
+			switch {
+			case len(resolved) > 0:
+				p.breaker.RecordSuccess()
+			case len(unResolved) > 0:
+				p.breaker.RecordFailure()
+			}
 		}
 	}
 }
@@ -240,6 +249,14 @@ func (p *Provider[K, V]) updateData(id K, result providertypes.ResolvedResult[V]
 		current.Timestamp = result.Timestamp
 		p.data[id] = current
 	default:
+		// Some providers only report market data (volume, best bid / ask) on a subset of their
+		// messages, so we retain the latest market data if the result does not carry any.
+		if !result.HasMarketData() {
+			result.Volume = current.Volume
+			result.BestBid = current.BestBid
+			result.BestAsk = current.BestAsk
+		}
+
 		// Otherwise, update the data.
 		p.logger.Debug(
 			"updating base provider data",
@@ -15,6 +15,11 @@ type ProviderMetrics struct {
 	mock.Mock
 }
 
+// AddCircuitBreakerTrip provides a mock function with given fields: providerName, reason, providerType
+func (_m *ProviderMetrics) AddCircuitBreakerTrip(providerName string, reason types.CircuitBreakerReason, providerType types.ProviderType) {
+	_m.Called(providerName, reason, providerType)
+}
+
 // AddProviderResponse provides a mock function with given fields: providerName, status, ec, providerType
 func (_m *ProviderMetrics) AddProviderResponse(providerName string, status metrics.Status, ec types.ErrorCode, providerType types.ProviderType) {
 	_m.Called(providerName, status, ec, providerType)
@@ -30,6 +35,11 @@ func (_m *ProviderMetrics) LastUpdated(providerName string, id string, providerT
 	_m.Called(providerName, id, providerType)
 }
 
+// SetCircuitBreakerState provides a mock function with given fields: providerName, state, providerType
+func (_m *ProviderMetrics) SetCircuitBreakerState(providerName string, state types.CircuitBreakerState, providerType types.ProviderType) {
+	_m.Called(providerName, state, providerType)
+}
+
 // NewProviderMetrics creates a new instance of ProviderMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProviderMetrics(t interface {
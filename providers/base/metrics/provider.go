@@ -24,6 +24,8 @@ const (
 	ErrorLabel = "error"
 	// ErrorCodeLabel is a label for and an error code of a failed provider response.
 	ErrorCodeLabel = "code"
+	// ReasonLabel is a label for the reason a provider's circuit breaker tripped.
+	ReasonLabel = "reason"
 )
 
 type (
@@ -50,6 +52,12 @@ type ProviderMetrics interface {
 
 	// LastUpdated updates the last time a given ID (i.e. currency pair) was updated.
 	LastUpdated(providerName, id string, providerType providertypes.ProviderType)
+
+	// SetCircuitBreakerState sets the current state of a provider's circuit breaker.
+	SetCircuitBreakerState(providerName string, state providertypes.CircuitBreakerState, providerType providertypes.ProviderType)
+
+	// AddCircuitBreakerTrip increments the number of times a provider's circuit breaker tripped.
+	AddCircuitBreakerTrip(providerName string, reason providertypes.CircuitBreakerReason, providerType providertypes.ProviderType)
 }
 
 // ProviderMetricsImpl contains metrics exposed by this package.
@@ -62,6 +70,12 @@ type ProviderMetricsImpl struct {
 
 	// Last time a given ID (i.e. currency pair) was updated.
 	lastUpdatedPerProvider *prometheus.GaugeVec
+
+	// State of each provider's circuit breaker.
+	circuitBreakerState *prometheus.GaugeVec
+
+	// Number of times each provider's circuit breaker tripped.
+	circuitBreakerTrips *prometheus.CounterVec
 }
 
 // NewProviderMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -90,12 +104,24 @@ func NewProviderMetrics() ProviderMetrics {
 			Name:      "provider_last_updated_id",
 			Help:      "Last time a given ID (i.e. currency pair) was updated.",
 		}, []string{ProviderLabel, IDLabel, ProviderTypeLabel}),
+		circuitBreakerState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "provider_circuit_breaker_state",
+			Help:      "State of the provider's circuit breaker (0 = closed, 1 = half open, 2 = open).",
+		}, []string{ProviderLabel, ProviderTypeLabel}),
+		circuitBreakerTrips: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "provider_circuit_breaker_trips",
+			Help:      "Number of times the provider's circuit breaker tripped.",
+		}, []string{ProviderLabel, ReasonLabel, ProviderTypeLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.responseStatusPerProviderByID)
 	prometheus.MustRegister(m.responseStatusPerProvider)
 	prometheus.MustRegister(m.lastUpdatedPerProvider)
+	prometheus.MustRegister(m.circuitBreakerState)
+	prometheus.MustRegister(m.circuitBreakerTrips)
 
 	return m
 }
@@ -114,6 +140,12 @@ func (m *noOpProviderMetricsImpl) AddProviderResponse(_ string, _ Status, _ prov
 }
 func (m *noOpProviderMetricsImpl) LastUpdated(_, _ string, _ providertypes.ProviderType) {}
 
+func (m *noOpProviderMetricsImpl) SetCircuitBreakerState(_ string, _ providertypes.CircuitBreakerState, _ providertypes.ProviderType) {
+}
+
+func (m *noOpProviderMetricsImpl) AddCircuitBreakerTrip(_ string, _ providertypes.CircuitBreakerReason, _ providertypes.ProviderType) {
+}
+
 // AddProviderResponseByID increments the number of ticks with a fully successful provider update
 // for a given provider and ID (i.e. currency pair).
 func (m *ProviderMetricsImpl) AddProviderResponseByID(providerName, id string, status Status, ec providertypes.ErrorCode, providerType providertypes.ProviderType) {
@@ -148,3 +180,30 @@ func (m *ProviderMetricsImpl) LastUpdated(providerName, id string, providerType
 	},
 	).Set(float64(now.Unix()))
 }
+
+// SetCircuitBreakerState sets the current state of a provider's circuit breaker.
+func (m *ProviderMetricsImpl) SetCircuitBreakerState(providerName string, state providertypes.CircuitBreakerState, providerType providertypes.ProviderType) {
+	var value float64
+	switch state {
+	case providertypes.CircuitBreakerHalfOpen:
+		value = 1
+	case providertypes.CircuitBreakerOpen:
+		value = 2
+	}
+
+	m.circuitBreakerState.With(prometheus.Labels{
+		ProviderLabel:     providerName,
+		ProviderTypeLabel: string(providerType),
+	},
+	).Set(value)
+}
+
+// AddCircuitBreakerTrip increments the number of times a provider's circuit breaker tripped.
+func (m *ProviderMetricsImpl) AddCircuitBreakerTrip(providerName string, reason providertypes.CircuitBreakerReason, providerType providertypes.ProviderType) {
+	m.circuitBreakerTrips.With(prometheus.Labels{
+		ProviderLabel:     providerName,
+		ReasonLabel:       string(reason),
+		ProviderTypeLabel: string(providerType),
+	},
+	).Add(1)
+}
@@ -89,6 +89,17 @@ func WithWebSocketConfig[K providertypes.ResponseKey, V providertypes.ResponseVa
 	}
 }
 
+// WithCircuitBreakerConfig sets the circuit breaker configuration for the provider.
+func WithCircuitBreakerConfig[K providertypes.ResponseKey, V providertypes.ResponseValue](cfg config.CircuitBreakerConfig) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		if cfg.ValidateBasic() != nil {
+			panic("invalid circuit breaker config")
+		}
+
+		p.breakerCfg = cfg
+	}
+}
+
 // WithIDs sets the IDs that the provider is responsible for fetching data for.
 func WithIDs[K providertypes.ResponseKey, V providertypes.ResponseValue](ids []K) ProviderOption[K, V] {
 	return func(p *Provider[K, V]) {
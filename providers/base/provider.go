@@ -51,6 +51,13 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 	// metrics is the metrics implementation for the provider.
 	metrics providermetrics.ProviderMetrics
 
+	// breakerCfg is the circuit breaker configuration for the provider.
+	breakerCfg config.CircuitBreakerConfig
+
+	// breaker is the circuit breaker that quarantines the provider after repeated failures or
+	// outlier prices.
+	breaker *CircuitBreaker
+
 	// fetchCtx is the context for the fetch function.
 	fetchCtx context.Context
 
@@ -94,6 +101,8 @@ func NewProvider[K providertypes.ResponseKey, V providertypes.ResponseValue](opt
 		p.metrics = providermetrics.NewNopProviderMetrics()
 	}
 
+	p.breaker = NewCircuitBreaker(p.breakerCfg, p.onCircuitBreakerTransition)
+
 	return p, nil
 }
 
@@ -229,6 +238,49 @@ func (p *Provider[K, V]) GetData() map[K]providertypes.ResolvedResult[V] {
 	return cpy
 }
 
+// CircuitBreakerStatus returns the current status of the provider's circuit breaker.
+func (p *Provider[K, V]) CircuitBreakerStatus() providertypes.CircuitBreakerStatus {
+	return p.breaker.Status()
+}
+
+// IsQuarantined returns true if the provider's circuit breaker is open, i.e. the provider's data
+// should not be used until the cool-down has elapsed.
+func (p *Provider[K, V]) IsQuarantined() bool {
+	return !p.breaker.Allow()
+}
+
+// RecordOutliers records the number of the provider's prices that were rejected as outliers in
+// a price update. The provider is quarantined after the configured number of consecutive price
+// updates with outliers.
+func (p *Provider[K, V]) RecordOutliers(rejected int) {
+	p.breaker.RecordOutliers(rejected)
+}
+
+// onCircuitBreakerTransition logs and records every state change of the provider's circuit
+// breaker.
+func (p *Provider[K, V]) onCircuitBreakerTransition(
+	from providertypes.CircuitBreakerState,
+	to providertypes.CircuitBreakerStatus,
+) {
+	p.metrics.SetCircuitBreakerState(p.name, to.State, p.Type())
+
+	switch to.State {
+	case providertypes.CircuitBreakerOpen:
+		p.logger.Warn(
+			"circuit breaker tripped; quarantining provider",
+			zap.String("reason", string(to.Reason)),
+			zap.Time("open_until", to.OpenUntil),
+		)
+		p.metrics.AddCircuitBreakerTrip(p.name, to.Reason, p.Type())
+	default:
+		p.logger.Info(
+			"circuit breaker state changed",
+			zap.String("from", string(from)),
+			zap.String("to", string(to.State)),
+		)
+	}
+}
+
 // Type returns the type of data handler the provider uses.
 func (p *Provider[K, V]) Type() providertypes.ProviderType {
 	switch {
@@ -327,11 +327,12 @@ func TestStop(t *testing.T) {
 
 func TestWebSocketProvider(t *testing.T) {
 	testCases := []struct {
-		name           string
-		handler        func() wshandlers.WebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int]
-		pairs          []slinkytypes.CurrencyPair
-		cfg            config.WebSocketConfig
-		expectedPrices map[slinkytypes.CurrencyPair]*big.Int
+		name            string
+		handler         func() wshandlers.WebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int]
+		pairs           []slinkytypes.CurrencyPair
+		cfg             config.WebSocketConfig
+		expectedPrices  map[slinkytypes.CurrencyPair]*big.Int
+		expectedVolumes map[slinkytypes.CurrencyPair]*big.Float
 	}{
 		{
 			name: "no prices to fetch",
@@ -593,6 +594,44 @@ func TestWebSocketProvider(t *testing.T) {
 				pairs[0]: big.NewInt(100),
 			},
 		},
+		{
+			name: "retains the market data associated with a result if the updated result does not carry any",
+			handler: func() wshandlers.WebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int] {
+				// First response carries a volume and best bid / ask.
+				resolved := map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+					pairs[0]: providertypes.NewResult(big.NewInt(100), time.Now().Add(time.Hour)).
+						WithVolume(big.NewFloat(10)).
+						WithBidAsk(big.NewFloat(99), big.NewFloat(101)),
+				}
+
+				// Second response only updates the price.
+				updatedResolved := map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+					pairs[0]: providertypes.NewResult(big.NewInt(200), time.Now().Add(2*time.Hour)),
+				}
+
+				responses := []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+					providertypes.NewGetResponse(resolved, nil),
+					providertypes.NewGetResponse(updatedResolved, nil),
+				}
+
+				return testutils.CreateWebSocketQueryHandlerWithGetResponses[slinkytypes.CurrencyPair, *big.Int](
+					t,
+					time.Second,
+					logger,
+					responses,
+				)
+			},
+			pairs: []slinkytypes.CurrencyPair{
+				pairs[0],
+			},
+			cfg: wsCfg,
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				pairs[0]: big.NewInt(200),
+			},
+			expectedVolumes: map[slinkytypes.CurrencyPair]*big.Float{
+				pairs[0]: big.NewFloat(10),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -619,6 +658,11 @@ func TestWebSocketProvider(t *testing.T) {
 				require.Equal(t, price, result.Value)
 				require.True(t, result.Timestamp.After(now))
 			}
+
+			for cp, volume := range tc.expectedVolumes {
+				require.Contains(t, data, cp)
+				require.Equal(t, volume, data[cp].Volume)
+			}
 		})
 	}
 }
@@ -0,0 +1,157 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// RecordType is the type of traffic that a record captures.
+type RecordType string
+
+const (
+	// HTTPRecord is a raw HTTP response returned by a provider.
+	HTTPRecord RecordType = "http"
+	// WebSocketRecord is a raw websocket frame sent by a provider.
+	WebSocketRecord RecordType = "websocket"
+)
+
+// Record is a single HTTP response or websocket frame received from a provider. Records are
+// stored as JSON lines.
+type Record struct {
+	// Provider is the name of the provider that sent the response or frame.
+	Provider string `json:"provider"`
+	// Type is the type of the record.
+	Type RecordType `json:"type"`
+	// Timestamp is the time at which the response or frame was received.
+	Timestamp time.Time `json:"timestamp"`
+	// URL is the URL that was requested. This is only set for HTTP records.
+	URL string `json:"url,omitempty"`
+	// StatusCode is the status code of the response. This is only set for HTTP records.
+	StatusCode int `json:"status_code,omitempty"`
+	// Header contains the headers of the response. This is only set for HTTP records.
+	Header http.Header `json:"header,omitempty"`
+	// Connection is the index of the websocket connection, in the order in which the
+	// connections were created for the provider. This is only set for websocket records.
+	Connection int `json:"connection,omitempty"`
+	// Body is the raw body of the response or frame.
+	Body []byte `json:"body"`
+}
+
+// key returns the key of the stream of records that the record belongs to. HTTP records are
+// grouped by provider and URL, websocket records by provider and connection.
+func (r Record) key() string {
+	return streamKey(r.Type, r.Provider, r.URL, r.Connection)
+}
+
+// streamKey returns the key of a stream of records.
+func streamKey(recordType RecordType, provider, url string, connection int) string {
+	if recordType == HTTPRecord {
+		return fmt.Sprintf("%s/%s/%s", recordType, provider, url)
+	}
+
+	return fmt.Sprintf("%s/%s/%d", recordType, provider, connection)
+}
+
+// Config is the configuration of a Recorder.
+type Config struct {
+	// Path is the path of the file to which records are written.
+	Path string
+	// MaxSize is the maximum size in megabytes of the file before it is rotated.
+	MaxSize int
+	// MaxBackups is the maximum number of rotated files to retain. All rotated files are
+	// retained if this is zero.
+	MaxBackups int
+}
+
+// ValidateBasic performs basic validation of the recorder config.
+func (c Config) ValidateBasic() error {
+	if c.Path == "" {
+		return fmt.Errorf("recording path cannot be empty")
+	}
+
+	if c.MaxSize < 0 {
+		return fmt.Errorf("max size cannot be negative")
+	}
+
+	if c.MaxBackups < 0 {
+		return fmt.Errorf("max backups cannot be negative")
+	}
+
+	return nil
+}
+
+// Recorder writes the traffic of providers to a rotating file. It is safe for concurrent use.
+type Recorder struct {
+	mu sync.Mutex
+
+	// writer is the rotating file to which records are written.
+	writer *lumberjack.Logger
+
+	// encoder encodes the records as JSON lines.
+	encoder *json.Encoder
+
+	// connections tracks the number of websocket connections that have been recorded for
+	// each provider.
+	connections map[string]int
+
+	// redactor replaces the secrets of the providers in the records before they are written.
+	redactor redactor
+}
+
+// New returns a new Recorder that writes to the file in the config.
+func New(cfg Config) (*Recorder, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	writer := &lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    cfg.MaxSize,
+		MaxBackups: cfg.MaxBackups,
+	}
+
+	return &Recorder{
+		writer:      writer,
+		encoder:     json.NewEncoder(writer),
+		connections: make(map[string]int),
+	}, nil
+}
+
+// Record writes the record to the file, with all redacted secrets replaced.
+func (r *Recorder) Record(record Record) error {
+	record = r.redactor.redactRecord(record)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.encoder.Encode(record)
+}
+
+// Redact adds secrets, e.g. the API keys of the providers, that are replaced with Redacted in
+// all records that are written after the call.
+func (r *Recorder) Redact(secrets ...string) {
+	r.redactor.add(secrets...)
+}
+
+// NextConnection returns the index of the next websocket connection of the provider.
+func (r *Recorder) NextConnection(provider string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	connection := r.connections[provider]
+	r.connections[provider]++
+	return connection
+}
+
+// Close closes the file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.writer.Close()
+}
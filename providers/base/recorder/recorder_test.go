@@ -0,0 +1,249 @@
+package recorder_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+func TestConfigValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cfg       recorder.Config
+		expectErr bool
+	}{
+		{
+			name: "valid config",
+			cfg: recorder.Config{
+				Path:       "traffic.jsonl",
+				MaxSize:    10,
+				MaxBackups: 2,
+			},
+		},
+		{
+			name:      "empty path",
+			cfg:       recorder.Config{},
+			expectErr: true,
+		},
+		{
+			name: "negative max size",
+			cfg: recorder.Config{
+				Path:    "traffic.jsonl",
+				MaxSize: -1,
+			},
+			expectErr: true,
+		},
+		{
+			name: "negative max backups",
+			cfg: recorder.Config{
+				Path:       "traffic.jsonl",
+				MaxBackups: -1,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecordAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traffic.jsonl")
+	rec, err := recorder.New(recorder.Config{Path: path})
+	require.NoError(t, err)
+
+	start := time.Now().UTC()
+	records := []recorder.Record{
+		{
+			Provider:   "binance",
+			Type:       recorder.HTTPRecord,
+			Timestamp:  start,
+			URL:        "https://api.binance.com/prices",
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       []byte(`{"price": "1"}`),
+		},
+		{
+			Provider:   "okx",
+			Type:       recorder.WebSocketRecord,
+			Timestamp:  start.Add(10 * time.Millisecond),
+			Connection: 1,
+			Body:       []byte{0x1f, 0x8b, 0x00},
+		},
+	}
+	for _, record := range records {
+		require.NoError(t, rec.Record(record))
+	}
+	require.NoError(t, rec.Close())
+
+	recording, err := recorder.Load(path)
+	require.NoError(t, err)
+
+	response, err := recording.NextResponse(context.Background(), "binance", "https://api.binance.com/prices")
+	require.NoError(t, err)
+	require.Equal(t, records[0].StatusCode, response.StatusCode)
+	require.Equal(t, records[0].Header, response.Header)
+	require.Equal(t, records[0].Body, response.Body)
+
+	frame, err := recording.NextFrame(context.Background(), "okx", 1)
+	require.NoError(t, err)
+	require.Equal(t, records[1].Body, frame.Body)
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traffic.jsonl")
+	rec, err := recorder.New(recorder.Config{Path: path})
+	require.NoError(t, err)
+	rec.Redact("secret/key", "")
+
+	url := "https://api.coingecko.com/prices?key=secret%2Fkey"
+	require.NoError(t, rec.Record(recorder.Record{
+		Provider:   "coingecko",
+		Type:       recorder.HTTPRecord,
+		Timestamp:  time.Now().UTC(),
+		URL:        url,
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"X-Api-Key": []string{"secret/key"}},
+		Body:       []byte(`{"key": "secret/key"}`),
+	}))
+	require.NoError(t, rec.Close())
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "secret")
+
+	recording, err := recorder.Load(path)
+	require.NoError(t, err)
+
+	// The recorded URL is redacted, so it is only matched if the secret is redacted on replay.
+	_, err = recording.NextResponse(context.Background(), "coingecko", url)
+	require.ErrorIs(t, err, recorder.ErrNotRecorded)
+
+	recording.Redact("secret/key")
+	response, err := recording.NextResponse(context.Background(), "coingecko", url)
+	require.NoError(t, err)
+	require.Equal(t, "https://api.coingecko.com/prices?key="+recorder.Redacted, response.URL)
+	require.Equal(t, []string{recorder.Redacted}, response.Header["X-Api-Key"])
+	require.Equal(t, `{"key": "`+recorder.Redacted+`"}`, string(response.Body))
+}
+
+func TestLoadRotatedRecordings(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now().UTC()
+
+	write := func(name string, records ...recorder.Record) {
+		rec, err := recorder.New(recorder.Config{Path: filepath.Join(dir, name)})
+		require.NoError(t, err)
+		for _, record := range records {
+			require.NoError(t, rec.Record(record))
+		}
+		require.NoError(t, rec.Close())
+	}
+
+	frame := func(offset time.Duration, body string) recorder.Record {
+		return recorder.Record{
+			Provider:  "okx",
+			Type:      recorder.WebSocketRecord,
+			Timestamp: start.Add(offset),
+			Body:      []byte(body),
+		}
+	}
+
+	write("traffic-2024-01-01T00-00-00.000.jsonl", frame(0, "first"))
+	write("traffic-2024-01-01T00-01-00.000.jsonl", frame(time.Millisecond, "second"))
+	write("traffic.jsonl", frame(2*time.Millisecond, "third"))
+
+	recording, err := recorder.Load(filepath.Join(dir, "traffic.jsonl"))
+	require.NoError(t, err)
+
+	for _, expected := range []string{"first", "second", "third"} {
+		record, err := recording.NextFrame(context.Background(), "okx", 0)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(record.Body))
+	}
+
+	_, err = recording.NextFrame(context.Background(), "okx", 0)
+	require.ErrorIs(t, err, recorder.ErrExhausted)
+}
+
+func TestLoadMissingRecording(t *testing.T) {
+	_, err := recorder.Load(filepath.Join(t.TempDir(), "traffic.jsonl"))
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "traffic.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0o600))
+	_, err = recorder.Load(path)
+	require.Error(t, err)
+}
+
+func TestRecordingReplay(t *testing.T) {
+	start := time.Now().UTC()
+	recording := recorder.NewRecording([]recorder.Record{
+		{
+			Provider:  "coinbase",
+			Type:      recorder.HTTPRecord,
+			Timestamp: start.Add(200 * time.Millisecond),
+			URL:       "url",
+			Body:      []byte("second"),
+		},
+		{
+			Provider:  "coinbase",
+			Type:      recorder.HTTPRecord,
+			Timestamp: start,
+			URL:       "url",
+			Body:      []byte("first"),
+		},
+	})
+
+	t.Run("records are replayed in order at their recorded offsets", func(t *testing.T) {
+		replayStart := time.Now()
+
+		record, err := recording.NextResponse(context.Background(), "coinbase", "url")
+		require.NoError(t, err)
+		require.Equal(t, "first", string(record.Body))
+		require.Less(t, time.Since(replayStart), 100*time.Millisecond)
+
+		// The second record is not due yet.
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = recording.NextResponse(ctx, "coinbase", "url")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		record, err = recording.NextResponse(context.Background(), "coinbase", "url")
+		require.NoError(t, err)
+		require.Equal(t, "second", string(record.Body))
+		require.GreaterOrEqual(t, time.Since(replayStart), 200*time.Millisecond)
+
+		_, err = recording.NextResponse(context.Background(), "coinbase", "url")
+		require.ErrorIs(t, err, recorder.ErrExhausted)
+	})
+
+	t.Run("unrecorded traffic", func(t *testing.T) {
+		_, err := recording.NextResponse(context.Background(), "coinbase", "other")
+		require.ErrorIs(t, err, recorder.ErrNotRecorded)
+
+		_, err = recording.NextFrame(context.Background(), "coinbase", 0)
+		require.ErrorIs(t, err, recorder.ErrNotRecorded)
+	})
+
+	t.Run("connections are numbered per provider", func(t *testing.T) {
+		require.Equal(t, 0, recording.NextConnection("okx"))
+		require.Equal(t, 1, recording.NextConnection("okx"))
+		require.Equal(t, 0, recording.NextConnection("kraken"))
+	})
+}
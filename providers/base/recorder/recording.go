@@ -0,0 +1,206 @@
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNotRecorded is returned when the recording does not contain any traffic for a request
+	// or websocket connection.
+	ErrNotRecorded = errors.New("traffic was not recorded")
+	// ErrExhausted is returned when all recorded traffic for a request or websocket connection
+	// has been replayed.
+	ErrExhausted = errors.New("recorded traffic has been exhausted")
+)
+
+// Recording replays recorded provider traffic. Each stream of records (i.e. the responses to
+// the same provider URL or the frames of the same websocket connection) is replayed in the
+// order in which it was recorded. A record is replayed no earlier than its offset from the
+// first record in the recording, measured from the first time the recording is used. This
+// reproduces the recorded traffic, including its timing, deterministically.
+type Recording struct {
+	mu sync.Mutex
+
+	// origin is the timestamp of the first record in the recording.
+	origin time.Time
+
+	// start is the time at which the replay started. This is the zero time until the
+	// recording is first used.
+	start time.Time
+
+	// streams contains the records of each stream, sorted by timestamp.
+	streams map[string][]Record
+
+	// cursors contains the index of the next record to replay for each stream.
+	cursors map[string]int
+
+	// connections tracks the number of websocket connections that have been replayed for
+	// each provider.
+	connections map[string]int
+
+	// redactor replaces the secrets of the providers in the requested URLs, such that they match
+	// the redacted URLs of the recording.
+	redactor redactor
+}
+
+// NewRecording returns a new Recording that replays the given records.
+func NewRecording(records []Record) *Recording {
+	sorted := make([]Record, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	r := &Recording{
+		streams:     make(map[string][]Record),
+		cursors:     make(map[string]int),
+		connections: make(map[string]int),
+	}
+
+	if len(sorted) > 0 {
+		r.origin = sorted[0].Timestamp
+	}
+
+	for _, record := range sorted {
+		key := record.key()
+		r.streams[key] = append(r.streams[key], record)
+	}
+
+	return r
+}
+
+// Load reads the recording at the given path. The rotated files of the recording are read as
+// well, oldest first.
+func Load(path string) (*Recording, error) {
+	ext := filepath.Ext(path)
+	prefix := strings.TrimSuffix(path, ext)
+	backups, err := filepath.Glob(prefix + "-*" + ext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find rotated recordings of %s: %w", path, err)
+	}
+	sort.Strings(backups)
+
+	files := backups
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, path)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no recording found at %s", path)
+	}
+
+	var records []Record
+	for _, file := range files {
+		fileRecords, err := readRecords(file)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, fileRecords...)
+	}
+
+	return NewRecording(records), nil
+}
+
+// readRecords reads all of the records in the given file.
+func readRecords(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var records []Record
+	decoder := json.NewDecoder(f)
+	for {
+		var record Record
+		if err := decoder.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, fmt.Errorf("failed to read recording %s: %w", path, err)
+		}
+
+		records = append(records, record)
+	}
+}
+
+// NextResponse returns the next recorded response of the provider to a request with the given
+// URL. This blocks until the response is due or the context is done.
+func (r *Recording) NextResponse(ctx context.Context, provider, url string) (Record, error) {
+	return r.next(ctx, streamKey(HTTPRecord, provider, r.redactor.redactString(url), 0))
+}
+
+// Redact adds secrets, e.g. the API keys of the providers, that were redacted when the traffic
+// was recorded. These are replaced in the requested URLs before they are looked up.
+func (r *Recording) Redact(secrets ...string) {
+	r.redactor.add(secrets...)
+}
+
+// NextFrame returns the next recorded frame of the given websocket connection of the provider.
+// This blocks until the frame is due or the context is done.
+func (r *Recording) NextFrame(ctx context.Context, provider string, connection int) (Record, error) {
+	return r.next(ctx, streamKey(WebSocketRecord, provider, "", connection))
+}
+
+// NextConnection returns the index of the next websocket connection of the provider.
+func (r *Recording) NextConnection(provider string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	connection := r.connections[provider]
+	r.connections[provider]++
+	return connection
+}
+
+// next returns the next record of the given stream once it is due.
+func (r *Recording) next(ctx context.Context, key string) (Record, error) {
+	for {
+		r.mu.Lock()
+		if r.start.IsZero() {
+			r.start = time.Now()
+		}
+
+		records, ok := r.streams[key]
+		if !ok {
+			r.mu.Unlock()
+			return Record{}, fmt.Errorf("%w: %s", ErrNotRecorded, key)
+		}
+
+		cursor := r.cursors[key]
+		if cursor >= len(records) {
+			r.mu.Unlock()
+			return Record{}, fmt.Errorf("%w: %s", ErrExhausted, key)
+		}
+
+		record := records[cursor]
+		wait := time.Until(r.start.Add(record.Timestamp.Sub(r.origin)))
+		if wait <= 0 {
+			r.cursors[key]++
+			r.mu.Unlock()
+			return record, nil
+		}
+		r.mu.Unlock()
+
+		// Wait for the record to be due. The cursor is checked again afterwards, as the
+		// record may have been replayed concurrently in the meantime.
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return Record{}, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
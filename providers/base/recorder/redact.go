@@ -0,0 +1,97 @@
+package recorder
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces the secrets, e.g. the API keys of the providers, in recorded traffic.
+const Redacted = "REDACTED"
+
+// redactor replaces secrets in records. It is safe for concurrent use.
+type redactor struct {
+	mu sync.RWMutex
+
+	// secrets are the secrets that are redacted, along with their query escaped form. These are
+	// sorted longest first, such that secrets are redacted deterministically even if one contains
+	// another.
+	secrets []string
+}
+
+// add adds the given secrets to the set of redacted secrets. Empty secrets are ignored.
+func (r *redactor) add(secrets ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, secret := range secrets {
+		if len(secret) == 0 {
+			continue
+		}
+
+		for _, s := range []string{secret, url.QueryEscape(secret)} {
+			if !slices.Contains(r.secrets, s) {
+				r.secrets = append(r.secrets, s)
+			}
+		}
+	}
+
+	sort.Slice(r.secrets, func(i, j int) bool {
+		if len(r.secrets[i]) != len(r.secrets[j]) {
+			return len(r.secrets[i]) > len(r.secrets[j])
+		}
+
+		return r.secrets[i] < r.secrets[j]
+	})
+}
+
+// redactString returns the given string with all secrets replaced.
+func (r *redactor) redactString(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.replace(s)
+}
+
+// redactRecord returns the given record with all secrets in its URL, headers and body replaced.
+func (r *redactor) redactRecord(record Record) Record {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.secrets) == 0 {
+		return record
+	}
+
+	if len(record.Header) > 0 {
+		header := make(http.Header, len(record.Header))
+		for key, values := range record.Header {
+			redacted := make([]string, len(values))
+			for i, value := range values {
+				redacted[i] = r.replace(value)
+			}
+			header[key] = redacted
+		}
+		record.Header = header
+	}
+
+	record.URL = r.replace(record.URL)
+	for _, secret := range r.secrets {
+		record.Body = bytes.ReplaceAll(record.Body, []byte(secret), []byte(Redacted))
+	}
+
+	return record
+}
+
+// replace returns the given string with all secrets replaced. This assumes the caller holds the
+// lock.
+func (r *redactor) replace(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+
+	return s
+}
@@ -98,6 +98,7 @@ func CreateAPIProviderWithGetResponses[K providertypes.ResponseKey, V providerty
 		base.WithAPIQueryHandler[K, V](handler),
 		base.WithAPIConfig[K, V](cfg.API),
 		base.WithLogger[K, V](logger),
+		base.WithCircuitBreakerConfig[K, V](cfg.CircuitBreaker),
 		base.WithIDs[K, V](ids),
 	)
 	require.NoError(t, err)
@@ -125,6 +126,7 @@ func CreateAPIProviderWithResponseFn[K providertypes.ResponseKey, V providertype
 		base.WithAPIQueryHandler[K, V](handler),
 		base.WithAPIConfig[K, V](cfg.API),
 		base.WithLogger[K, V](logger),
+		base.WithCircuitBreakerConfig[K, V](cfg.CircuitBreaker),
 		base.WithIDs[K, V](ids),
 	)
 	require.NoError(t, err)
@@ -90,6 +90,7 @@ func CreateWebSocketProviderWithGetResponses[K providertypes.ResponseKey, V prov
 		base.WithWebSocketQueryHandler[K, V](handler),
 		base.WithWebSocketConfig[K, V](cfg.WebSocket),
 		base.WithLogger[K, V](logger),
+		base.WithCircuitBreakerConfig[K, V](cfg.CircuitBreaker),
 		base.WithIDs[K, V](ids),
 	)
 	require.NoError(t, err)
@@ -116,6 +117,7 @@ func CreateWebSocketProviderWithResponseFn[K providertypes.ResponseKey, V provid
 		base.WithWebSocketQueryHandler[K, V](handler),
 		base.WithWebSocketConfig[K, V](cfg.WebSocket),
 		base.WithLogger[K, V](logger),
+		base.WithCircuitBreakerConfig[K, V](cfg.CircuitBreaker),
 	)
 	require.NoError(t, err)
 
@@ -1,5 +1,9 @@
 package handlers
 
+import (
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
+)
+
 // Option is a function that is used to configure a WebSocketConnHandler.
 type Option func(*WebSocketConnHandlerImpl)
 
@@ -13,3 +17,15 @@ func WithPreDialHook(hook PreDialHook) Option {
 		r.preDialHook = hook
 	}
 }
+
+// WithMetrics is an option that is used to set the metrics used to report the health of the
+// endpoints of a websocket connection.
+func WithMetrics(m metrics.WebSocketMetrics) Option {
+	return func(r *WebSocketConnHandlerImpl) {
+		if m == nil {
+			panic("metrics cannot be nil")
+		}
+
+		r.metrics = m
+	}
+}
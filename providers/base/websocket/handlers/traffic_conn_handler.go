@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+var (
+	_ WebSocketConnHandler = (*RecordingConnHandler)(nil)
+	_ WebSocketConnHandler = (*ReplayConnHandler)(nil)
+)
+
+// RecordingConnHandler is a WebSocketConnHandler that records the raw frames received from the
+// provider before returning them. Each copy of the handler is recorded as a separate connection.
+type RecordingConnHandler struct {
+	// handler is the underlying connection handler.
+	handler WebSocketConnHandler
+
+	// recorder records the frames.
+	recorder *recorder.Recorder
+
+	// provider is the name of the provider.
+	provider string
+
+	// connection is the index of the connection.
+	connection int
+}
+
+// NewRecordingConnHandler returns a new RecordingConnHandler that records the frames read by
+// the given connection handler.
+func NewRecordingConnHandler(
+	handler WebSocketConnHandler,
+	rec *recorder.Recorder,
+	provider string,
+) (WebSocketConnHandler, error) {
+	if handler == nil {
+		return nil, fmt.Errorf("connection handler cannot be nil")
+	}
+
+	if rec == nil {
+		return nil, fmt.Errorf("recorder cannot be nil")
+	}
+
+	return &RecordingConnHandler{
+		handler:    handler,
+		recorder:   rec,
+		provider:   provider,
+		connection: rec.NextConnection(provider),
+	}, nil
+}
+
+// Read reads a frame using the underlying connection handler and records it.
+func (h *RecordingConnHandler) Read() ([]byte, error) {
+	message, err := h.handler.Read()
+	if err != nil {
+		return message, err
+	}
+
+	err = h.recorder.Record(recorder.Record{
+		Provider:   h.provider,
+		Type:       recorder.WebSocketRecord,
+		Timestamp:  time.Now().UTC(),
+		Connection: h.connection,
+		Body:       message,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record frame: %w", err)
+	}
+
+	return message, nil
+}
+
+// Write writes the message using the underlying connection handler.
+func (h *RecordingConnHandler) Write(message []byte) error {
+	return h.handler.Write(message)
+}
+
+// Close closes the underlying connection handler.
+func (h *RecordingConnHandler) Close() error {
+	return h.handler.Close()
+}
+
+// Dial dials the provider using the underlying connection handler.
+func (h *RecordingConnHandler) Dial() error {
+	return h.handler.Dial()
+}
+
+// Copy returns a copy of the connection handler that is recorded as a new connection.
+func (h *RecordingConnHandler) Copy() WebSocketConnHandler {
+	return &RecordingConnHandler{
+		handler:    h.handler.Copy(),
+		recorder:   h.recorder,
+		provider:   h.provider,
+		connection: h.recorder.NextConnection(h.provider),
+	}
+}
+
+// ReplayConnHandler is a WebSocketConnHandler that replays the recorded frames of the provider
+// instead of connecting to the provider. Messages written to the connection are discarded. Each
+// copy of the handler replays the next recorded connection.
+type ReplayConnHandler struct {
+	// recording contains the recorded frames.
+	recording *recorder.Recording
+
+	// cfg is the websocket configuration of the provider.
+	cfg config.WebSocketConfig
+
+	// connection is the index of the replayed connection.
+	connection int
+}
+
+// NewReplayConnHandler returns a new ReplayConnHandler that replays the frames of the provider
+// from the given recording.
+func NewReplayConnHandler(recording *recorder.Recording, cfg config.WebSocketConfig) (WebSocketConnHandler, error) {
+	if recording == nil {
+		return nil, fmt.Errorf("recording cannot be nil")
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &ReplayConnHandler{
+		recording:  recording,
+		cfg:        cfg,
+		connection: recording.NextConnection(cfg.Name),
+	}, nil
+}
+
+// Read returns the next recorded frame of the connection. Similar to a live connection, this
+// returns an error if no frame is due within the read timeout.
+func (h *ReplayConnHandler) Read() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.cfg.ReadTimeout)
+	defer cancel()
+
+	record, err := h.recording.NextFrame(ctx, h.cfg.Name, h.connection)
+	if err != nil {
+		// Wait for the read timeout if the frames have been replayed, so that the query
+		// handler does not spin.
+		<-ctx.Done()
+		return nil, err
+	}
+
+	return record.Body, nil
+}
+
+// Write discards the message.
+func (h *ReplayConnHandler) Write(_ []byte) error {
+	return nil
+}
+
+// Close is a no-op.
+func (h *ReplayConnHandler) Close() error {
+	return nil
+}
+
+// Dial is a no-op.
+func (h *ReplayConnHandler) Dial() error {
+	return nil
+}
+
+// Copy returns a copy of the connection handler that replays the next recorded connection.
+func (h *ReplayConnHandler) Copy() WebSocketConnHandler {
+	return &ReplayConnHandler{
+		recording:  h.recording,
+		cfg:        h.cfg,
+		connection: h.recording.NextConnection(h.cfg.Name),
+	}
+}
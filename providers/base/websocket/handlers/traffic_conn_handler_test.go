@@ -0,0 +1,75 @@
+package handlers_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/base/recorder"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers/mocks"
+)
+
+func TestRecordAndReplayConnHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traffic.jsonl")
+	rec, err := recorder.New(recorder.Config{Path: path})
+	require.NoError(t, err)
+
+	first := mocks.NewWebSocketConnHandler(t)
+	first.On("Read").Return([]byte("first"), nil).Once()
+
+	second := mocks.NewWebSocketConnHandler(t)
+	second.On("Read").Return([]byte("second"), nil).Once()
+
+	connHandler := mocks.NewWebSocketConnHandler(t)
+	connHandler.On("Copy").Return(first).Once()
+	connHandler.On("Copy").Return(second).Once()
+
+	// Each copy of the handler is recorded as a separate connection.
+	recordingHandler, err := handlers.NewRecordingConnHandler(connHandler, rec, cfg.Name)
+	require.NoError(t, err)
+
+	firstCopy := recordingHandler.Copy()
+	secondCopy := recordingHandler.Copy()
+
+	message, err := secondCopy.Read()
+	require.NoError(t, err)
+	require.Equal(t, "second", string(message))
+
+	message, err = firstCopy.Read()
+	require.NoError(t, err)
+	require.Equal(t, "first", string(message))
+	require.NoError(t, rec.Close())
+
+	recording, err := recorder.Load(path)
+	require.NoError(t, err)
+
+	replayCfg := cfg
+	replayCfg.ReadTimeout = 100 * time.Millisecond
+
+	replayHandler, err := handlers.NewReplayConnHandler(recording, replayCfg)
+	require.NoError(t, err)
+
+	firstReplay := replayHandler.Copy()
+	secondReplay := replayHandler.Copy()
+
+	require.NoError(t, firstReplay.Dial())
+	require.NoError(t, firstReplay.Write([]byte("subscribe")))
+
+	message, err = firstReplay.Read()
+	require.NoError(t, err)
+	require.Equal(t, "first", string(message))
+
+	message, err = secondReplay.Read()
+	require.NoError(t, err)
+	require.Equal(t, "second", string(message))
+
+	// Once the frames have been replayed, reads time out.
+	start := time.Now()
+	_, err = firstReplay.Read()
+	require.ErrorIs(t, err, recorder.ErrExhausted)
+	require.GreaterOrEqual(t, time.Since(start), replayCfg.ReadTimeout)
+	require.NoError(t, firstReplay.Close())
+}
@@ -9,8 +9,14 @@ import (
 	"github.com/gorilla/websocket"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
 )
 
+// MaxEndpointBackoff is the maximum amount of time an endpoint is skipped for after failing to
+// establish a connection.
+const MaxEndpointBackoff = 5 * time.Minute
+
 type (
 	// WebsocketEncodedMessage is a type alias for a websocket message encoded to bytes.
 	WebsocketEncodedMessage []byte
@@ -49,6 +55,11 @@ type WebSocketConnHandler interface {
 }
 
 // WebSocketConnHandlerImpl is a struct that implements the WebSocketConnHandler interface.
+//
+// If multiple endpoints are configured, the handler rotates through them in order each time
+// it (re)connects. Endpoints that fail to establish a connection are skipped for an
+// exponentially increasing amount of time, starting at the configured reconnection timeout
+// and capped at MaxEndpointBackoff, unless every endpoint is backing off.
 type WebSocketConnHandlerImpl struct {
 	sync.Mutex
 	cfg config.WebSocketConfig
@@ -58,6 +69,27 @@ type WebSocketConnHandlerImpl struct {
 
 	// preDialHook is a function that is called before the connection is established.
 	preDialHook PreDialHook
+
+	// metrics is used to report the health of each endpoint and the active endpoint.
+	metrics metrics.WebSocketMetrics
+
+	// endpoint is the index of the endpoint that was last dialed.
+	endpoint int
+
+	// dialed indicates whether an endpoint has been dialed yet.
+	dialed bool
+
+	// health tracks the health of each of the configured endpoints.
+	health []endpointHealth
+}
+
+// endpointHealth tracks the health of a single websocket endpoint.
+type endpointHealth struct {
+	// failures is the number of consecutive failed attempts to connect to the endpoint.
+	failures int
+
+	// retryAfter is the time before which the endpoint is skipped.
+	retryAfter time.Time
 }
 
 // NewWebSocketHandlerImpl returns a new WebSocketConnHandlerImpl.
@@ -67,7 +99,8 @@ func NewWebSocketHandlerImpl(cfg config.WebSocketConfig, opts ...Option) (*WebSo
 	}
 
 	h := &WebSocketConnHandlerImpl{
-		cfg: cfg,
+		cfg:     cfg,
+		metrics: metrics.NewNopWebSocketMetrics(),
 	}
 
 	for _, opt := range opts {
@@ -79,6 +112,14 @@ func NewWebSocketHandlerImpl(cfg config.WebSocketConfig, opts ...Option) (*WebSo
 
 // CreateDialer is a function that dynamically creates a new websocket dialer.
 func (h *WebSocketConnHandlerImpl) CreateDialer() *websocket.Dialer {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.createDialer()
+}
+
+// createDialer creates a new websocket dialer. The caller must hold the lock.
+func (h *WebSocketConnHandlerImpl) createDialer() *websocket.Dialer {
 	return &websocket.Dialer{
 		Proxy:             http.ProxyFromEnvironment,
 		HandshakeTimeout:  h.cfg.HandshakeTimeout,
@@ -88,7 +129,8 @@ func (h *WebSocketConnHandlerImpl) CreateDialer() *websocket.Dialer {
 	}
 }
 
-// Dial is used to create a new connection to the data provider with the given URL.
+// Dial is used to create a new connection to the data provider. The endpoint that is dialed
+// is the next healthy endpoint after the one that was dialed last.
 func (h *WebSocketConnHandlerImpl) Dial() error {
 	if h.preDialHook != nil {
 		if err := h.preDialHook(h); err != nil {
@@ -96,13 +138,92 @@ func (h *WebSocketConnHandlerImpl) Dial() error {
 		}
 	}
 
+	h.Lock()
 	if len(h.cfg.Endpoints) == 0 {
+		h.Unlock()
 		return fmt.Errorf("no endpoints provided")
 	}
 
-	var err error
-	h.conn, _, err = h.CreateDialer().Dial(h.cfg.Endpoints[0].URL, nil)
-	return err
+	index := h.nextEndpoint(time.Now())
+	url := h.cfg.Endpoints[index].URL
+	dialer := h.createDialer()
+	h.Unlock()
+
+	conn, _, err := dialer.Dial(url, nil)
+
+	h.Lock()
+	defer h.Unlock()
+
+	endpoint := apimetrics.RedactedEndpointURL(index)
+	if err != nil {
+		h.recordFailure(index, time.Now())
+		h.metrics.AddWebSocketEndpointStatus(h.cfg.Name, endpoint, metrics.DialErr)
+		return err
+	}
+
+	h.health[index] = endpointHealth{}
+	h.conn = conn
+	h.metrics.AddWebSocketEndpointStatus(h.cfg.Name, endpoint, metrics.DialSuccess)
+	h.metrics.SetWebSocketActiveEndpoint(h.cfg.Name, endpoint)
+	return nil
+}
+
+// nextEndpoint selects the index of the endpoint to dial and marks it as the last dialed
+// endpoint. Endpoints are tried in order, starting after the last dialed endpoint, and those
+// that are backing off are skipped. If all endpoints are backing off, the endpoint that can be
+// retried the soonest is selected. The caller must hold the lock.
+func (h *WebSocketConnHandlerImpl) nextEndpoint(now time.Time) int {
+	// Reset the health of the endpoints if the endpoints have changed, e.g. by a pre-dial hook.
+	if len(h.health) != len(h.cfg.Endpoints) {
+		h.health = make([]endpointHealth, len(h.cfg.Endpoints))
+		h.dialed = false
+	}
+
+	start := 0
+	if h.dialed {
+		start = (h.endpoint + 1) % len(h.health)
+	}
+	h.dialed = true
+
+	h.endpoint = start
+	for i := range h.health {
+		index := (start + i) % len(h.health)
+		if !now.Before(h.health[index].retryAfter) {
+			h.endpoint = index
+			return index
+		}
+
+		if h.health[index].retryAfter.Before(h.health[h.endpoint].retryAfter) {
+			h.endpoint = index
+		}
+	}
+
+	return h.endpoint
+}
+
+// recordFailure records a failed attempt to connect to the endpoint at the given index and
+// backs the endpoint off accordingly. The caller must hold the lock.
+func (h *WebSocketConnHandlerImpl) recordFailure(index int, now time.Time) {
+	health := &h.health[index]
+	health.failures++
+
+	backoff := h.cfg.ReconnectionTimeout
+	for i := 1; i < health.failures && backoff < MaxEndpointBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxEndpointBackoff {
+		backoff = MaxEndpointBackoff
+	}
+
+	health.retryAfter = now.Add(backoff)
+}
+
+// Endpoint returns the index of the endpoint that was last dialed.
+func (h *WebSocketConnHandlerImpl) Endpoint() int {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.endpoint
 }
 
 // Read is used to read data from the data provider. Each websocket data handler is responsible
@@ -175,6 +296,7 @@ func (h *WebSocketConnHandlerImpl) Copy() WebSocketConnHandler {
 	return &WebSocketConnHandlerImpl{
 		cfg:         h.cfg,
 		preDialHook: h.preDialHook,
+		metrics:     h.metrics,
 	}
 }
 
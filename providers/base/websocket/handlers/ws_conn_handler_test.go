@@ -0,0 +1,152 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
+	mockmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics/mocks"
+)
+
+// newWebSocketServer returns a websocket server that accepts all connections and the websocket
+// URL of the server.
+func newWebSocketServer(t *testing.T) string {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// newUnavailableURL returns the websocket URL of a server that is no longer running.
+func newUnavailableURL(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func connConfig(urls ...string) config.WebSocketConfig {
+	c := cfg
+	c.ReconnectionTimeout = time.Minute
+	c.Endpoints = make([]config.Endpoint, len(urls))
+	for i, url := range urls {
+		c.Endpoints[i] = config.Endpoint{URL: url}
+	}
+
+	return c
+}
+
+func TestWebSocketConnHandlerDial(t *testing.T) {
+	t.Run("single endpoint is always dialed", func(t *testing.T) {
+		h, err := handlers.NewWebSocketHandlerImpl(connConfig(newWebSocketServer(t)))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, h.Dial())
+			require.Equal(t, 0, h.Endpoint())
+			require.NoError(t, h.Close())
+		}
+	})
+
+	t.Run("single unavailable endpoint is retried", func(t *testing.T) {
+		h, err := handlers.NewWebSocketHandlerImpl(connConfig(newUnavailableURL(t)))
+		require.NoError(t, err)
+
+		require.Error(t, h.Dial())
+		require.Error(t, h.Dial())
+		require.Equal(t, 0, h.Endpoint())
+	})
+
+	t.Run("rotates to the next endpoint after a failure", func(t *testing.T) {
+		m := mockmetrics.NewWebSocketMetrics(t)
+		h, err := handlers.NewWebSocketHandlerImpl(
+			connConfig(newUnavailableURL(t), newWebSocketServer(t)),
+			handlers.WithMetrics(m),
+		)
+		require.NoError(t, err)
+
+		m.On("AddWebSocketEndpointStatus", name, apimetrics.RedactedEndpointURL(0), metrics.DialErr).Once()
+		require.Error(t, h.Dial())
+		require.Equal(t, 0, h.Endpoint())
+
+		m.On("AddWebSocketEndpointStatus", name, apimetrics.RedactedEndpointURL(1), metrics.DialSuccess).Once()
+		m.On("SetWebSocketActiveEndpoint", name, apimetrics.RedactedEndpointURL(1)).Once()
+		require.NoError(t, h.Dial())
+		require.Equal(t, 1, h.Endpoint())
+		require.NoError(t, h.Close())
+	})
+
+	t.Run("skips endpoints that are backing off", func(t *testing.T) {
+		h, err := handlers.NewWebSocketHandlerImpl(
+			connConfig(newWebSocketServer(t), newUnavailableURL(t), newWebSocketServer(t)),
+		)
+		require.NoError(t, err)
+
+		// The first endpoint is dialed first.
+		require.NoError(t, h.Dial())
+		require.Equal(t, 0, h.Endpoint())
+		require.NoError(t, h.Close())
+
+		// The second endpoint is unavailable and starts backing off.
+		require.Error(t, h.Dial())
+		require.Equal(t, 1, h.Endpoint())
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, 2, h.Endpoint())
+		require.NoError(t, h.Close())
+
+		// The second endpoint is skipped while it is backing off.
+		require.NoError(t, h.Dial())
+		require.Equal(t, 0, h.Endpoint())
+		require.NoError(t, h.Close())
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, 2, h.Endpoint())
+		require.NoError(t, h.Close())
+	})
+
+	t.Run("copies start from the first endpoint", func(t *testing.T) {
+		h, err := handlers.NewWebSocketHandlerImpl(
+			connConfig(newWebSocketServer(t), newWebSocketServer(t)),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, h.Dial())
+		require.NoError(t, h.Close())
+		require.NoError(t, h.Dial())
+		require.Equal(t, 1, h.Endpoint())
+		require.NoError(t, h.Close())
+
+		c, ok := h.Copy().(*handlers.WebSocketConnHandlerImpl)
+		require.True(t, ok)
+		require.NoError(t, c.Dial())
+		require.Equal(t, 0, c.Endpoint())
+		require.NoError(t, c.Close())
+	})
+}
@@ -13,6 +13,9 @@ import (
 const (
 	// StatusLabel is the label used for the status of a provider response.
 	StatusLabel = "status"
+
+	// EndpointLabel is the label used for the (redacted) websocket endpoint of a provider.
+	EndpointLabel = "endpoint"
 )
 
 // WebSocketMetrics is an interface that defines the API for metrics collection for providers
@@ -31,6 +34,13 @@ type WebSocketMetrics interface {
 	// ObserveWebSocketLatency adds a latency observation to the metrics collector for the
 	// given provider.
 	ObserveWebSocketLatency(provider string, duration time.Duration)
+
+	// AddWebSocketEndpointStatus adds a status response to the metrics collector for the given
+	// provider and endpoint. Specifically, this tracks the health of each configured endpoint.
+	AddWebSocketEndpointStatus(provider, endpoint string, status ConnectionStatus)
+
+	// SetWebSocketActiveEndpoint sets the endpoint that the given provider is connected to.
+	SetWebSocketActiveEndpoint(provider, endpoint string)
 }
 
 // WebSocketMetricsImpl contains metrics exposed by this package.
@@ -43,6 +53,12 @@ type WebSocketMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	responseTimePerProvider *prometheus.HistogramVec
+
+	// Number of connection statuses per endpoint.
+	endpointStatusPerProvider *prometheus.CounterVec
+
+	// Gauge set to 1 for the endpoint each provider is connected to.
+	activeEndpointPerProvider *prometheus.GaugeVec
 }
 
 // NewWebSocketMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -72,12 +88,24 @@ func NewWebSocketMetrics() WebSocketMetrics {
 			Help:      "Response time per web socket provider.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel}),
+		endpointStatusPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_endpoint_status",
+			Help:      "Statuses associated with each of the web socket endpoints of a provider.",
+		}, []string{providermetrics.ProviderLabel, EndpointLabel, StatusLabel}),
+		activeEndpointPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_active_endpoint",
+			Help:      "The web socket endpoint that each provider is connected to.",
+		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.connectionStatusPerProvider)
 	prometheus.MustRegister(m.dataHandlerStatusPerProvider)
 	prometheus.MustRegister(m.responseTimePerProvider)
+	prometheus.MustRegister(m.endpointStatusPerProvider)
+	prometheus.MustRegister(m.activeEndpointPerProvider)
 
 	return m
 }
@@ -98,6 +126,12 @@ func (m *noOpWebSocketMetricsImpl) AddWebSocketDataHandlerStatus(_ string, _ Han
 func (m *noOpWebSocketMetricsImpl) ObserveWebSocketLatency(_ string, _ time.Duration) {
 }
 
+func (m *noOpWebSocketMetricsImpl) AddWebSocketEndpointStatus(_, _ string, _ ConnectionStatus) {
+}
+
+func (m *noOpWebSocketMetricsImpl) SetWebSocketActiveEndpoint(_, _ string) {
+}
+
 // AddWebSocketConnectionStatus adds a method / status response to the metrics collector for the
 // given provider. Specifically, this tracks various connection related errors.
 func (m *WebSocketMetricsImpl) AddWebSocketConnectionStatus(provider string, status ConnectionStatus) {
@@ -125,3 +159,27 @@ func (m *WebSocketMetricsImpl) ObserveWebSocketLatency(provider string, duration
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// AddWebSocketEndpointStatus adds a status response to the metrics collector for the given
+// provider and endpoint. Specifically, this tracks the health of each configured endpoint.
+func (m *WebSocketMetricsImpl) AddWebSocketEndpointStatus(provider, endpoint string, status ConnectionStatus) {
+	m.endpointStatusPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		EndpointLabel:                 endpoint,
+		StatusLabel:                   status.String(),
+	},
+	).Add(1)
+}
+
+// SetWebSocketActiveEndpoint sets the endpoint that the given provider is connected to. The
+// previously active endpoint of the provider is removed.
+func (m *WebSocketMetricsImpl) SetWebSocketActiveEndpoint(provider, endpoint string) {
+	m.activeEndpointPerProvider.DeletePartialMatch(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+	})
+	m.activeEndpointPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		EndpointLabel:                 endpoint,
+	},
+	).Set(1)
+}
@@ -3,6 +3,7 @@ package oracle
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strings"
 
@@ -15,8 +16,14 @@ import (
 	"github.com/skip-mev/slinky/providers/apis/binance"
 	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
 	"github.com/skip-mev/slinky/providers/apis/coingecko"
+	"github.com/skip-mev/slinky/providers/apis/defi/astroport"
+	"github.com/skip-mev/slinky/providers/apis/defi/chainlink"
+	"github.com/skip-mev/slinky/providers/apis/defi/osmosis"
+	"github.com/skip-mev/slinky/providers/apis/defi/solanaclmm"
+	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv2"
 	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv3"
 	"github.com/skip-mev/slinky/providers/apis/geckoterminal"
+	"github.com/skip-mev/slinky/providers/apis/generic"
 	"github.com/skip-mev/slinky/providers/apis/kraken"
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
@@ -32,6 +39,19 @@ func APIQueryHandlerFactory(
 	logger *zap.Logger,
 	cfg config.ProviderConfig,
 	metrics metrics.APIMetrics,
+) (types.PriceAPIQueryHandler, error) {
+	return newAPIQueryHandler(ctx, logger, cfg, metrics, Traffic{})
+}
+
+// newAPIQueryHandler returns the API query handler of the given provider. The traffic of REST
+// API providers is recorded or replayed according to the traffic config, which is not supported
+// by the other providers.
+func newAPIQueryHandler(
+	ctx context.Context,
+	logger *zap.Logger,
+	cfg config.ProviderConfig,
+	metrics metrics.APIMetrics,
+	traffic Traffic,
 ) (types.PriceAPIQueryHandler, error) {
 	// Validate the provider config.
 	err := cfg.ValidateBasic()
@@ -39,6 +59,13 @@ func APIQueryHandlerFactory(
 		return nil, err
 	}
 
+	// Only the traffic of REST API providers and the mock providers can be recorded or replayed.
+	// Rather than silently using live traffic, providers that query e.g. JSON-RPC endpoints are
+	// rejected.
+	if traffic.Enabled() && !supportsTraffic(cfg) {
+		return nil, fmt.Errorf("recording and replaying traffic is not supported by provider %s", cfg.Name)
+	}
+
 	// Create the underlying client that will be used to fetch data from the API. This client
 	// will limit the number of concurrent connections and uses the configured timeout to
 	// ensure requests do not hang.
@@ -53,32 +80,18 @@ func APIQueryHandlerFactory(
 	var (
 		apiPriceFetcher types.PriceAPIFetcher
 		apiDataHandler  types.PriceAPIDataHandler
-		headers         = make(map[string]string)
+		requestHandler  apihandlers.RequestHandler
 	)
 
-	// If the provider has an API key, add it to the headers.
-	if len(cfg.API.Endpoints) == 1 && cfg.API.Endpoints[0].Authentication.Enabled() {
-		headers[cfg.API.Endpoints[0].Authentication.APIKeyHeader] = cfg.API.Endpoints[0].Authentication.APIKey
-	}
-
-	requestHandler, err := apihandlers.NewRequestHandlerImpl(client, apihandlers.WithHTTPHeaders(headers))
-	if err != nil {
-		return nil, err
-	}
-
 	switch providerName := cfg.Name; {
-	case providerName == binance.Name:
-		apiDataHandler, err = binance.NewAPIHandler(cfg.API)
-	case providerName == coinbaseapi.Name:
-		apiDataHandler, err = coinbaseapi.NewAPIHandler(cfg.API)
-	case providerName == coingecko.Name:
-		apiDataHandler, err = coingecko.NewAPIHandler(cfg.API)
-	case providerName == geckoterminal.Name:
-		apiDataHandler, err = geckoterminal.NewAPIHandler(cfg.API)
-	case providerName == kraken.Name:
-		apiDataHandler, err = kraken.NewAPIHandler(cfg.API)
+	case isRestAPIProvider(cfg.API):
+		apiPriceFetcher, err = newRestAPIPriceFetcher(logger, cfg.API, client, metrics, traffic)
+	case strings.HasPrefix(providerName, uniswapv2.BaseName):
+		apiPriceFetcher, err = uniswapv2.NewPriceFetcher(ctx, logger, metrics, cfg.API)
 	case strings.HasPrefix(providerName, uniswapv3.BaseName):
 		apiPriceFetcher, err = uniswapv3.NewPriceFetcher(ctx, logger, metrics, cfg.API)
+	case strings.HasPrefix(providerName, chainlink.BaseName):
+		apiPriceFetcher, err = chainlink.NewPriceFetcher(ctx, logger, metrics, cfg.API)
 	case providerName == static.Name:
 		apiDataHandler = static.NewAPIHandler()
 		requestHandler = static.NewStaticMockClient()
@@ -87,6 +100,12 @@ func APIQueryHandlerFactory(
 		requestHandler = static.NewStaticMockClient()
 	case providerName == raydium.Name:
 		apiPriceFetcher, err = raydium.NewAPIPriceFetcher(logger, cfg.API, metrics)
+	case solanaclmm.IsValidProviderName(providerName):
+		apiPriceFetcher, err = solanaclmm.NewAPIPriceFetcher(logger, cfg.API, metrics)
+	case providerName == osmosis.Name:
+		apiPriceFetcher, err = osmosis.NewPriceFetcher(logger, metrics, cfg.API)
+	case providerName == astroport.Name:
+		apiPriceFetcher, err = astroport.NewPriceFetcher(logger, metrics, cfg.API)
 	default:
 		return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
 	}
@@ -94,8 +113,13 @@ func APIQueryHandlerFactory(
 		return nil, err
 	}
 
-	// if no apiPriceFetcher has been created yet, create a default REST API price fetcher.
+	// if no apiPriceFetcher has been created yet, create a REST API price fetcher for the mock providers.
 	if apiPriceFetcher == nil {
+		requestHandler, err = traffic.wrapRequestHandler(requestHandler, cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+
 		apiPriceFetcher, err = apihandlers.NewRestAPIFetcher(
 			requestHandler,
 			apiDataHandler,
@@ -116,3 +140,105 @@ func APIQueryHandlerFactory(
 		metrics,
 	)
 }
+
+// newRestAPIPriceFetcher returns a REST API price fetcher for the given provider. A fetcher is
+// created for each of the endpoints, authenticated using the endpoint's API key. If the provider
+// has multiple endpoints, the fetchers are combined according to the endpoint mode of the provider.
+func newRestAPIPriceFetcher(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client *http.Client,
+	metrics metrics.APIMetrics,
+	traffic Traffic,
+) (types.PriceAPIFetcher, error) {
+	fetchers := make([]types.PriceAPIFetcher, len(api.Endpoints))
+	for i, endpoint := range api.Endpoints {
+		endpointAPI := api
+		endpointAPI.Endpoints = []config.Endpoint{endpoint}
+		endpointAPI.EndpointMode = ""
+		endpointAPI.Quorum = 0
+
+		// If the endpoint has an API key, add it to the headers.
+		headers := make(map[string]string)
+		if endpoint.Authentication.Enabled() {
+			headers[endpoint.Authentication.APIKeyHeader] = endpoint.Authentication.APIKey
+		}
+
+		requestHandler, err := apihandlers.NewRequestHandlerImpl(client, apihandlers.WithHTTPHeaders(headers))
+		if err != nil {
+			return nil, err
+		}
+
+		requestHandler, err = traffic.wrapRequestHandler(requestHandler, api.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		apiDataHandler, err := newAPIDataHandler(endpointAPI)
+		if err != nil {
+			return nil, err
+		}
+
+		fetchers[i], err = apihandlers.NewRestAPIFetcher(
+			requestHandler,
+			apiDataHandler,
+			metrics,
+			endpointAPI,
+			logger,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(fetchers) == 1 {
+		return fetchers[0], nil
+	}
+
+	var aggregator apihandlers.ResultAggregator[*big.Float]
+	switch api.EndpointMode {
+	case config.EndpointModeFreshest:
+		aggregator = apihandlers.FreshestResult[*big.Float]
+	case config.EndpointModeMedian:
+		aggregator = apihandlers.MedianPriceResult
+	}
+
+	return apihandlers.NewMultiRestAPIFetcher(fetchers, aggregator, api, logger)
+}
+
+// isRestAPIProvider returns true if the provider is a REST API provider, i.e. one whose prices are
+// fetched by a REST API price fetcher.
+func isRestAPIProvider(api config.APIConfig) bool {
+	switch api.Name {
+	case binance.Name, coinbaseapi.Name, coingecko.Name, geckoterminal.Name, kraken.Name:
+		return true
+	default:
+		return api.Generic.Enabled()
+	}
+}
+
+// supportsTraffic returns true if the traffic of the provider can be recorded and replayed, i.e.
+// if it is a REST API provider or one of the mock providers.
+func supportsTraffic(cfg config.ProviderConfig) bool {
+	return isRestAPIProvider(cfg.API) || cfg.Name == static.Name || cfg.Name == volatile.Name
+}
+
+// newAPIDataHandler returns the API data handler of the given REST API provider.
+func newAPIDataHandler(api config.APIConfig) (types.PriceAPIDataHandler, error) {
+	switch providerName := api.Name; {
+	case api.Generic.Enabled():
+		return generic.NewAPIHandler(api)
+	case providerName == binance.Name:
+		return binance.NewAPIHandler(api)
+	case providerName == coinbaseapi.Name:
+		return coinbaseapi.NewAPIHandler(api)
+	case providerName == coingecko.Name:
+		return coingecko.NewAPIHandler(api)
+	case providerName == geckoterminal.Name:
+		return geckoterminal.NewAPIHandler(api)
+	case providerName == kraken.Name:
+		return kraken.NewAPIHandler(api)
+	default:
+		return nil, fmt.Errorf("unknown provider: %s", api.Name)
+	}
+}
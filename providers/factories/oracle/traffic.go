@@ -0,0 +1,108 @@
+package oracle
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/base/recorder"
+	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
+)
+
+// Traffic determines whether the traffic of the providers is recorded or replayed. If a
+// recorder is set, the raw HTTP responses and websocket frames received from the providers are
+// recorded. If a recording is set, the providers replay the recorded traffic instead of
+// querying the network. At most one of the two should be set.
+type Traffic struct {
+	// Recorder records the traffic of the providers.
+	Recorder *recorder.Recorder
+
+	// Recording contains the traffic that is replayed.
+	Recording *recorder.Recording
+}
+
+// Enabled returns true if the traffic of the providers is recorded or replayed.
+func (t Traffic) Enabled() bool {
+	return t.Recorder != nil || t.Recording != nil
+}
+
+// APIQueryHandlerFactory returns an API query handler factory that records or replays the
+// traffic of the REST API providers.
+func (t Traffic) APIQueryHandlerFactory() types.PriceAPIQueryHandlerFactory {
+	return func(
+		ctx context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		metrics metrics.APIMetrics,
+	) (types.PriceAPIQueryHandler, error) {
+		t.redactAPIKeys(cfg)
+		return newAPIQueryHandler(ctx, logger, cfg, metrics, t)
+	}
+}
+
+// WebSocketQueryHandlerFactory returns a websocket query handler factory that records or
+// replays the traffic of the websocket providers.
+func (t Traffic) WebSocketQueryHandlerFactory() types.PriceWebSocketQueryHandlerFactory {
+	return func(
+		ctx context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		wsMetrics wsmetrics.WebSocketMetrics,
+	) (types.PriceWebSocketQueryHandler, error) {
+		t.redactAPIKeys(cfg)
+		return newWebSocketQueryHandler(ctx, logger, cfg, wsMetrics, t)
+	}
+}
+
+// redactAPIKeys ensures that the API keys configured for the endpoints of the provider are never
+// written to a recording, e.g. as part of a URL, and are matched when the recording is replayed.
+func (t Traffic) redactAPIKeys(cfg config.ProviderConfig) {
+	var keys []string
+	for _, endpoint := range cfg.API.Endpoints {
+		keys = append(keys, endpoint.Authentication.APIKey)
+	}
+	for _, endpoint := range cfg.WebSocket.Endpoints {
+		keys = append(keys, endpoint.Authentication.APIKey)
+	}
+
+	if t.Recorder != nil {
+		t.Recorder.Redact(keys...)
+	}
+	if t.Recording != nil {
+		t.Recording.Redact(keys...)
+	}
+}
+
+// wrapRequestHandler wraps the request handler of the provider such that its responses are
+// recorded or replayed.
+func (t Traffic) wrapRequestHandler(handler apihandlers.RequestHandler, provider string) (apihandlers.RequestHandler, error) {
+	switch {
+	case t.Recording != nil:
+		return apihandlers.NewReplayRequestHandler(t.Recording, provider, handler.Type())
+	case t.Recorder != nil:
+		return apihandlers.NewRecordingRequestHandler(handler, t.Recorder, provider)
+	default:
+		return handler, nil
+	}
+}
+
+// wrapConnHandler wraps the connection handler of the provider such that its frames are
+// recorded or replayed.
+func (t Traffic) wrapConnHandler(
+	handler wshandlers.WebSocketConnHandler,
+	cfg config.WebSocketConfig,
+) (wshandlers.WebSocketConnHandler, error) {
+	switch {
+	case t.Recording != nil:
+		return wshandlers.NewReplayConnHandler(t.Recording, cfg)
+	case t.Recorder != nil:
+		return wshandlers.NewRecordingConnHandler(handler, t.Recorder, cfg.Name)
+	default:
+		return handler, nil
+	}
+}
@@ -30,10 +30,22 @@ import (
 // factory. Specifically, this factory function returns websocket query handlers that are used to
 // fetch data from the price providers.
 func WebSocketQueryHandlerFactory(
+	ctx context.Context,
+	logger *zap.Logger,
+	cfg config.ProviderConfig,
+	wsMetrics wsmetrics.WebSocketMetrics,
+) (types.PriceWebSocketQueryHandler, error) {
+	return newWebSocketQueryHandler(ctx, logger, cfg, wsMetrics, Traffic{})
+}
+
+// newWebSocketQueryHandler returns the websocket query handler of the given provider. The
+// traffic of the provider is recorded or replayed according to the traffic config.
+func newWebSocketQueryHandler(
 	_ context.Context,
 	logger *zap.Logger,
 	cfg config.ProviderConfig,
 	wsMetrics wsmetrics.WebSocketMetrics,
+	traffic Traffic,
 ) (types.PriceWebSocketQueryHandler, error) {
 	err := cfg.ValidateBasic()
 	if err != nil {
@@ -95,6 +107,7 @@ func WebSocketQueryHandlerFactory(
 		connHandler, err = wshandlers.NewWebSocketHandlerImpl(
 			cfg.WebSocket,
 			wshandlers.WithPreDialHook(kucoin.PreDialHook(cfg.API, requestHandler)),
+			wshandlers.WithMetrics(wsMetrics),
 		)
 	case mexc.Name:
 		wsDataHandler, err = mexc.NewWebSocketDataHandler(logger, cfg.WebSocket)
@@ -109,12 +122,20 @@ func WebSocketQueryHandlerFactory(
 
 	// If a custom request handler is not provided, create a new default one.
 	if connHandler == nil {
-		connHandler, err = wshandlers.NewWebSocketHandlerImpl(cfg.WebSocket)
+		connHandler, err = wshandlers.NewWebSocketHandlerImpl(
+			cfg.WebSocket,
+			wshandlers.WithMetrics(wsMetrics),
+		)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	connHandler, err = traffic.wrapConnHandler(connHandler, cfg.WebSocket)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the websocket query handler which encapsulates all fetching and parsing logic.
 	return types.NewPriceWebSocketQueryHandler(
 		logger,
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+)
+
+// CircuitBreakerState is the state of a provider's circuit breaker.
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed indicates that the provider is healthy and its data is used.
+	CircuitBreakerClosed CircuitBreakerState = "closed"
+	// CircuitBreakerOpen indicates that the provider is quarantined and its data is not used
+	// until the cool-down period has elapsed.
+	CircuitBreakerOpen CircuitBreakerState = "open"
+	// CircuitBreakerHalfOpen indicates that the cool-down period has elapsed and the provider's
+	// data is used again to probe whether the provider has recovered.
+	CircuitBreakerHalfOpen CircuitBreakerState = "half_open"
+)
+
+// CircuitBreakerReason is the reason a provider's circuit breaker tripped.
+type CircuitBreakerReason string
+
+const (
+	// CircuitBreakerFailures indicates that the circuit breaker tripped because the provider
+	// returned too many consecutive failed responses.
+	CircuitBreakerFailures CircuitBreakerReason = "failures"
+	// CircuitBreakerOutliers indicates that the circuit breaker tripped because the provider's
+	// prices were rejected as outliers in too many consecutive price updates.
+	CircuitBreakerOutliers CircuitBreakerReason = "outliers"
+)
+
+// CircuitBreakerStatus is a snapshot of a provider's circuit breaker.
+type CircuitBreakerStatus struct {
+	// State is the current state of the circuit breaker.
+	State CircuitBreakerState
+	// Reason is the reason the circuit breaker last tripped. This is empty if the circuit
+	// breaker has never tripped.
+	Reason CircuitBreakerReason
+	// ConsecutiveFailures is the number of consecutive failed responses.
+	ConsecutiveFailures int
+	// ConsecutiveOutliers is the number of consecutive price updates with outlier prices.
+	ConsecutiveOutliers int
+	// OpenUntil is the time until which the provider is quarantined. This is the zero time if
+	// the circuit breaker has never tripped.
+	OpenUntil time.Time
+}
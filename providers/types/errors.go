@@ -25,6 +25,7 @@ const (
 	ErrorWebSocketGeneral      ErrorCode = 14
 	ErrorGRPCGeneral           ErrorCode = 15
 	ErrorNoExistingPrice       ErrorCode = 16
+	ErrorStalePrice            ErrorCode = 17
 )
 
 // Error returns the error representation of the ErrorCode.
@@ -62,6 +63,8 @@ func (e ErrorCode) Error() error {
 		return errors.New("general grpc error")
 	case ErrorNoExistingPrice:
 		return errors.New("no existing price")
+	case ErrorStalePrice:
+		return errors.New("stale price")
 	case ErrorUnknown:
 		fallthrough
 	default:
@@ -79,6 +82,11 @@ func (ec ErrorWithCode) Error() string {
 	return ec.internalErr.Error()
 }
 
+// Unwrap returns the internal error.
+func (ec ErrorWithCode) Unwrap() error {
+	return ec.internalErr
+}
+
 // Code returns the internal ErrorCode.
 func (ec ErrorWithCode) Code() ErrorCode {
 	return ec.code
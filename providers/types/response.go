@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 )
 
@@ -56,6 +57,15 @@ type ResolvedResult[V ResponseValue] struct {
 	// ResponseCode is an optional code that can be attached to responses to provide
 	// additional context.
 	ResponseCode ResponseCode
+	// Volume is the (optional) trading volume of the requested ID, i.e. the rolling 24 hour
+	// volume in units of the base asset. This is nil if the provider does not report volume.
+	Volume *big.Float
+	// BestBid is the (optional) best bid of the requested ID. This is nil if the provider does
+	// not report the top of the order book.
+	BestBid *big.Float
+	// BestAsk is the (optional) best ask of the requested ID. This is nil if the provider does
+	// not report the top of the order book.
+	BestAsk *big.Float
 }
 
 // UnresolvedResult is an unresolved (failed) result of a single requested ID.
@@ -122,13 +132,53 @@ func NewResultWithCode[V ResponseValue](value V, timestamp time.Time, code Respo
 	}
 }
 
+// WithVolume returns a copy of the ResolvedResult with the given volume.
+func (r ResolvedResult[V]) WithVolume(volume *big.Float) ResolvedResult[V] {
+	r.Volume = volume
+	return r
+}
+
+// WithBidAsk returns a copy of the ResolvedResult with the given best bid and best ask.
+func (r ResolvedResult[V]) WithBidAsk(bid, ask *big.Float) ResolvedResult[V] {
+	r.BestBid = bid
+	r.BestAsk = ask
+	return r
+}
+
+// HasMarketData returns true if the ResolvedResult carries a volume or a best bid / ask.
+func (r ResolvedResult[V]) HasMarketData() bool {
+	return r.Volume != nil || r.BestBid != nil || r.BestAsk != nil
+}
+
+// Spread returns the difference between the best ask and the best bid. This returns nil if
+// either the best bid or best ask is not reported.
+func (r ResolvedResult[V]) Spread() *big.Float {
+	if r.BestBid == nil || r.BestAsk == nil {
+		return nil
+	}
+
+	return new(big.Float).Sub(r.BestAsk, r.BestBid)
+}
+
 // String returns a string representation of the ResolvedResult. This is mostly used for logging
 // and testing purposes.
 func (r ResolvedResult[V]) String() string {
+	if !r.HasMarketData() {
+		return fmt.Sprintf(
+			"(value: %s, timestamp: %s, response code: %s)",
+			r.Value.String(),
+			r.Timestamp.String(),
+			r.ResponseCode.String(),
+		)
+	}
+
 	return fmt.Sprintf(
-		"(value: %s, timestamp: %s, response code: %s)",
+		"(value: %s, timestamp: %s, response code: %s, volume: %v, best bid: %v, best ask: %v)",
 		r.Value.String(),
 		r.Timestamp.String(),
 		r.ResponseCode.String(),
+		r.Volume,
+		r.BestBid,
+		r.BestAsk,
 	)
 }
@@ -203,6 +203,20 @@ type TickerMessageResponse struct {
 		// Note: This is unused but is included since json.Unmarshal requires all fields with same character but different casing
 		// to be present.
 		StatisticsCloseTime int64 `json:"C"`
+		// BestBid is the best bid price.
+		BestBid string `json:"b"`
+		// BestBidQuantity is the best bid quantity.
+		//
+		// Note: This is unused but is included for the same reason as StatisticsCloseTime.
+		BestBidQuantity string `json:"B"`
+		// BestAsk is the best ask price.
+		BestAsk string `json:"a"`
+		// BestAskQuantity is the best ask quantity.
+		//
+		// Note: This is unused but is included for the same reason as StatisticsCloseTime.
+		BestAskQuantity string `json:"A"`
+		// Volume is the total traded base asset volume.
+		Volume string `json:"v"`
 	} `json:"data"`
 }
 
@@ -36,3 +36,24 @@ func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price
 	resolved[ticker] = types.NewPriceResult(priceFloat, time.Now().UTC())
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
+
+// parseTickerUpdateMessage parses a ticker update message from the Binance websocket feed. In
+// addition to the last price, the ticker stream reports the 24hr rolling volume and the best
+// bid / ask, which are attached to the resolved price (if they can be parsed).
+func (h *WebSocketHandler) parseTickerUpdateMessage(msg TickerMessageResponse) (types.PriceResponse, error) {
+	resp, err := h.parsePriceUpdateMessage(msg.Data.Ticker, msg.Data.LastPrice)
+	if err != nil {
+		return resp, err
+	}
+
+	for ticker, result := range resp.Resolved {
+		resp.Resolved[ticker] = types.WithParsedMarketData(
+			result,
+			msg.Data.Volume,
+			msg.Data.BestBid,
+			msg.Data.BestAsk,
+		)
+	}
+
+	return resp, nil
+}
@@ -105,14 +105,15 @@ func (h *WebSocketHandler) HandleMessage(
 
 	switch streamMsg.GetStreamType() {
 	case TickerStream:
-		// Ticker stream is sent every 1000ms and contains the latest price of a ticker.
+		// Ticker stream is sent every 1000ms and contains the latest price, volume, and best bid / ask
+		// of a ticker.
 		var tickerResp TickerMessageResponse
 		if err := json.Unmarshal(message, &tickerResp); err != nil {
 			return resp, nil, fmt.Errorf("failed to unmarshal ticker message %w", err)
 		}
 
 		h.logger.Debug("received ticker message", zap.String("ticker", tickerResp.Data.Ticker))
-		resp, err := h.parsePriceUpdateMessage(tickerResp.Data.Ticker, tickerResp.Data.LastPrice)
+		resp, err := h.parseTickerUpdateMessage(tickerResp)
 		return resp, nil, err
 	case AggregateTradeStream:
 		// Aggregate trade stream is sent when a trade is executed on the Binance exchange.
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
@@ -125,6 +126,37 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "ticker stream message with volume and best bid / ask",
+			msg: func() []byte {
+				msg := `
+				{
+					"stream": "btcusdt@ticker",
+					"data": {
+						"s": "btcusdt",
+						"c": "10000.00000000",
+						"C": 1600000000000,
+						"b": "9999.00000000",
+						"B": "1.50000000",
+						"a": "10001.00000000",
+						"A": "2.50000000",
+						"v": "12345.67000000"
+						}
+				}`
+
+				return []byte(msg)
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: types.NewPriceResult(big.NewFloat(10000.0), time.Now()).
+						WithVolume(big.NewFloat(12345.67)).
+						WithBidAsk(big.NewFloat(9999.0), big.NewFloat(10001.0)),
+				},
+				types.UnResolvedPrices{},
+			),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "ticker stream message with bad price",
 			msg: func() []byte {
@@ -289,6 +321,7 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				requireMarketDataEqual(t, result, resp.Resolved[cp])
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -392,3 +425,21 @@ func TestCreateMessages(t *testing.T) {
 		})
 	}
 }
+
+func requireMarketDataEqual(t *testing.T, expected, actual types.PriceResult) {
+	t.Helper()
+
+	for _, pair := range [][2]*big.Float{
+		{expected.Volume, actual.Volume},
+		{expected.BestBid, actual.BestBid},
+		{expected.BestAsk, actual.BestAsk},
+	} {
+		if pair[0] == nil {
+			require.Nil(t, pair[1])
+			continue
+		}
+
+		require.NotNil(t, pair[1])
+		require.Equal(t, pair[0].SetPrec(18), pair[1].SetPrec(18))
+	}
+}
@@ -331,6 +331,17 @@ const (
 type TickerData struct {
 	// VolumeWeightedAveragePrice is the volume weighted average price.
 	VolumeWeightedAveragePrice []string `json:"p"`
+
+	// BestAsk is the best ask array. The elements of the array are of mixed
+	// types, so they are decoded lazily.
+	BestAsk []json.RawMessage `json:"a"`
+
+	// BestBid is the best bid array. The elements of the array are of mixed
+	// types, so they are decoded lazily.
+	BestBid []json.RawMessage `json:"b"`
+
+	// Volume is the volume array.
+	Volume []string `json:"v"`
 }
 
 const (
@@ -341,4 +352,12 @@ const (
 	// ExpectedVolumeWeightedAveragePriceLength is the expected length of the ticker's
 	// VolumeWeightedAveragePrice array.
 	ExpectedVolumeWeightedAveragePriceLength = 2
+
+	// BestPriceIndex is the index of the price in the ticker's BestAsk and
+	// BestBid arrays.
+	BestPriceIndex = 0
+
+	// Last24HoursVolumeIndex is the index of the last 24 hours volume in the
+	// ticker's Volume array.
+	Last24HoursVolumeIndex = 1
 )
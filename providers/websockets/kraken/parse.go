@@ -116,10 +116,37 @@ func (h *WebSocketHandler) parseTickerMessage(
 		return types.NewPriceResponse(resolved, unResolved), unResolved[ticker]
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// Attach the market data of the ticker. This is optional, so any values that are
+	// missing or malformed are ignored.
+	var volume string
+	if len(resp.TickerData.Volume) > Last24HoursVolumeIndex {
+		volume = resp.TickerData.Volume[Last24HoursVolumeIndex]
+	}
+
+	resolved[ticker] = types.WithParsedMarketData(
+		types.NewPriceResult(price, time.Now().UTC()),
+		volume,
+		decodeBestPrice(resp.TickerData.BestBid),
+		decodeBestPrice(resp.TickerData.BestAsk),
+	)
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
 
+// decodeBestPrice decodes the price from a best bid / ask array. An empty string is returned
+// if the price cannot be decoded.
+func decodeBestPrice(bestPrice []json.RawMessage) string {
+	if len(bestPrice) <= BestPriceIndex {
+		return ""
+	}
+
+	var price string
+	if err := json.Unmarshal(bestPrice[BestPriceIndex], &price); err != nil {
+		return ""
+	}
+
+	return price
+}
+
 // DecodeTickerResponseMessage decodes a ticker response message.
 func DecodeTickerResponseMessage(message []byte) (TickerResponseMessage, error) {
 	var rawResponse []json.RawMessage
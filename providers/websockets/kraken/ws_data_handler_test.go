@@ -36,6 +36,27 @@ func TestHandleMessage(t *testing.T) {
 			msg: func() []byte {
 				return []byte(`[340,{"a":["42694.60000",31,"31.27308189"],"b":["42694.50000",1,"1.01355072"],"c":["42694.60000","0.00455773"],"v":["2068.49653432","2075.61202911"],"p":["42596.41907","42598.31137"],"t":[21771,22049],"l":["42190.20000","42190.20000"],"h":["43165.00000","43165.00000"],"o":["43134.70000","43159.20000"]},"ticker","XBT/USD"]`)
 			},
+			resp: types.PriceResponse{
+				Resolved: types.ResolvedPrices{
+					btcusd: {
+						Value:   big.NewFloat(42596.41907000),
+						Volume:  big.NewFloat(2075.61202911),
+						BestBid: big.NewFloat(42694.50000),
+						BestAsk: big.NewFloat(42694.60000),
+					},
+				},
+				UnResolved: types.UnResolvedPrices{},
+			},
+			updateMsg: func() []handlers.WebsocketEncodedMessage {
+				return nil
+			},
+			expectedErr: false,
+		},
+		{
+			name: "valid ticker response message without market data",
+			msg: func() []byte {
+				return []byte(`[340,{"c":["42694.60000","0.00455773"],"p":["42596.41907","42598.31137"]},"ticker","XBT/USD"]`)
+			},
 			resp: types.PriceResponse{
 				Resolved: types.ResolvedPrices{
 					btcusd: {
@@ -220,6 +241,14 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+
+				if result.HasMarketData() {
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+					require.Equal(t, result.BestBid.SetPrec(18), resp.Resolved[cp].BestBid.SetPrec(18))
+					require.Equal(t, result.BestAsk.SetPrec(18), resp.Resolved[cp].BestAsk.SetPrec(18))
+				} else {
+					require.False(t, resp.Resolved[cp].HasMarketData())
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -325,6 +354,13 @@ func TestDecodeTickerResponseMessage(t *testing.T) {
 				ChannelID: 340,
 				TickerData: kraken.TickerData{
 					VolumeWeightedAveragePrice: []string{"42596.41907", "42598.31137"},
+					BestAsk: []json.RawMessage{
+						json.RawMessage(`"42694.60000"`), json.RawMessage(`31`), json.RawMessage(`"31.27308189"`),
+					},
+					BestBid: []json.RawMessage{
+						json.RawMessage(`"42694.50000"`), json.RawMessage(`1`), json.RawMessage(`"1.01355072"`),
+					},
+					Volume: []string{"2068.49653432", "2075.61202911"},
 				},
 				ChannelName: "ticker",
 				Pair:        "XBT/USD",
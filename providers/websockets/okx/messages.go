@@ -14,7 +14,8 @@ type (
 	Operation string
 	// Channel is the channel to subscribe to. The channel is used to determine the type of
 	// price data that we want. This can later be extended to support other channels. Currently,
-	// only the index tickers (spot markets) channel is supported.
+	// the index tickers (spot markets) channel is used for prices and the tickers channel is
+	// used for market data (volume and best bid / ask).
 	Channel string
 	// EventType is the event type. This is the expected event type that we want to receive
 	// from the websocket. The event types pertain to subscription events.
@@ -29,6 +30,9 @@ const (
 const (
 	// IndexTickersChannel is the channel for mark price updates.
 	IndexTickersChannel Channel = "index-tickers"
+	// TickersChannel is the channel for ticker updates. This is used to retrieve the 24 hour
+	// volume and best bid / ask of an instrument.
+	TickersChannel Channel = "tickers"
 )
 
 const (
@@ -197,3 +201,58 @@ type IndexTicker struct {
 	// IndexPrice is the index price.
 	IndexPrice string `json:"idxPx" validate:"required"`
 }
+
+// TickersResponseMessage is the response message for ticker updates. This message type is
+// pushed at most every 100ms when there is a trade or a change in the best bid / ask. The
+// format of the message is:
+//
+//	{
+//		"arg": {
+//			"channel": "tickers",
+//			"instId": "BTC-USDT"
+//		},
+//		"data": [
+//			{
+//				"instType": "SPOT",
+//				"instId": "BTC-USDT",
+//				"last": "9999.99",
+//				"lastSz": "0.1",
+//				"askPx": "9999.99",
+//				"askSz": "11",
+//				"bidPx": "8888.88",
+//				"bidSz": "5",
+//				"open24h": "9000",
+//				"high24h": "10000",
+//				"low24h": "8888.88",
+//				"volCcy24h": "2222",
+//				"vol24h": "2222",
+//				"sodUtc0": "2222",
+//				"sodUtc8": "2222",
+//				"ts": "1597026383085"
+//			}
+//		]
+//	}
+//
+// For more information, see https://www.okx.com/docs-v5/en/?shell#order-book-trading-market-data-ws-tickers-channel
+type TickersResponseMessage struct {
+	// Arguments is the list of arguments for the operation.
+	Arguments SubscriptionTopic `json:"arg" validate:"required"`
+
+	// Data is the list of ticker data.
+	Data []Ticker `json:"data" validate:"required"`
+}
+
+// Ticker is the ticker data.
+type Ticker struct {
+	// ID is the instrument ID.
+	ID string `json:"instId" validate:"required"`
+
+	// BestBid is the best bid price.
+	BestBid string `json:"bidPx"`
+
+	// BestAsk is the best ask price.
+	BestAsk string `json:"askPx"`
+
+	// Volume is the 24 hour trading volume in units of the base currency.
+	Volume string `json:"vol24h"`
+}
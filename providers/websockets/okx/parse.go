@@ -100,8 +100,33 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 			continue
 		}
 
-		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+		result := types.NewPriceResult(price, time.Now().UTC())
+		if data, ok := h.marketData[instrument.ID]; ok {
+			result = result.WithVolume(data.Volume).WithBidAsk(data.BestBid, data.BestAsk)
+		}
+
+		resolved[ticker] = result
 	}
 
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
+
+// parseTickersResponseMessage parses a tickers response message. The format of the message is
+// defined in the messages.go file. The volume and best bid / ask of each instrument are cached
+// and attached to the next index ticker price of the instrument.
+func (h *WebSocketHandler) parseTickersResponseMessage(resp TickersResponseMessage) {
+	for _, instrument := range resp.Data {
+		if _, ok := h.cache.FromOffChainTicker(instrument.ID); !ok {
+			h.logger.Debug("ticker not found for instrument ID", zap.String("instrument_id", instrument.ID))
+			continue
+		}
+
+		result := types.WithParsedMarketData(
+			types.PriceResult{},
+			instrument.Volume,
+			instrument.BestBid,
+			instrument.BestAsk,
+		)
+		h.marketData[instrument.ID] = types.MarketDataFromResult(result)
+	}
+}
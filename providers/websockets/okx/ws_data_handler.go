@@ -22,6 +22,9 @@ type WebSocketHandler struct {
 	ws config.WebSocketConfig
 	// cache maintains the latest set of tickers seen by the handler.
 	cache types.ProviderTickers
+	// marketData maintains the latest market data (volume and best bid / ask) seen on the
+	// tickers channel, keyed by instrument ID. This is attached to the index ticker prices.
+	marketData map[string]types.MarketData
 }
 
 // NewWebSocketDataHandler returns a new OKX PriceWebSocketDataHandler.
@@ -42,9 +45,10 @@ func NewWebSocketDataHandler(
 	}
 
 	return &WebSocketHandler{
-		logger: logger,
-		ws:     ws,
-		cache:  types.NewProviderTickers(),
+		logger:     logger,
+		ws:         ws,
+		cache:      types.NewProviderTickers(),
+		marketData: make(map[string]types.MarketData),
 	}, nil
 }
 
@@ -54,7 +58,8 @@ func NewWebSocketDataHandler(
 //  1. Subscribe response message. The subscribe response message is used to determine if
 //     the subscription was successful.
 //  2. Ticker response message. This is sent when a ticker update is received from the
-//     OKX websocket API.
+//     OKX websocket API. Index ticker messages carry the price, while ticker messages
+//     carry the market data (volume and best bid / ask) of the instrument.
 //
 // Heartbeat messages are NOT sent by the OKX websocket. The connection is only closed
 // iff no data is received within a 30-second interval or if all subscriptions
@@ -98,6 +103,18 @@ func (h *WebSocketHandler) HandleMessage(
 			return resp, nil, fmt.Errorf("failed to unmarshal ticker response message: %w", err)
 		}
 
+		// Ticker messages only update the market data cache; the prices are derived from the
+		// index tickers channel.
+		if Channel(tickerMessage.Arguments.Channel) == TickersChannel {
+			var marketDataMessage TickersResponseMessage
+			if err := json.Unmarshal(message, &marketDataMessage); err != nil {
+				return resp, nil, fmt.Errorf("failed to unmarshal tickers response message: %w", err)
+			}
+
+			h.parseTickersResponseMessage(marketDataMessage)
+			return resp, nil, nil
+		}
+
 		resp, err := h.parseTickerResponseMessage(tickerMessage)
 		if err != nil {
 			return resp, nil, fmt.Errorf("failed to parse ticker response message: %w", err)
@@ -110,17 +127,25 @@ func (h *WebSocketHandler) HandleMessage(
 }
 
 // CreateMessages is used to create an initial subscription message to send to the data provider.
-// Only the currency pairs that are specified in the config are subscribed to. The only channel
-// that is subscribed to is the index tickers channel - which supports spot markets.
+// Only the currency pairs that are specified in the config are subscribed to. Each instrument is
+// subscribed to on the index tickers channel - which supports spot markets - and on the tickers
+// channel, which is used to retrieve the market data of the instrument.
 func (h *WebSocketHandler) CreateMessages(
 	tickers []types.ProviderTicker,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	instruments := make([]SubscriptionTopic, 0)
 	for _, ticker := range tickers {
-		instruments = append(instruments, SubscriptionTopic{
-			Channel:      string(IndexTickersChannel),
-			InstrumentID: ticker.GetOffChainTicker(),
-		})
+		instruments = append(
+			instruments,
+			SubscriptionTopic{
+				Channel:      string(IndexTickersChannel),
+				InstrumentID: ticker.GetOffChainTicker(),
+			},
+			SubscriptionTopic{
+				Channel:      string(TickersChannel),
+				InstrumentID: ticker.GetOffChainTicker(),
+			},
+		)
 		h.cache.Add(ticker)
 	}
 
@@ -135,8 +160,9 @@ func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessa
 // Copy is used to create a copy of the WebSocketHandler.
 func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 	return &WebSocketHandler{
-		logger: h.logger,
-		ws:     h.ws,
-		cache:  types.NewProviderTickers(),
+		logger:     h.logger,
+		ws:         h.ws,
+		cache:      types.NewProviderTickers(),
+		marketData: make(map[string]types.MarketData),
 	}
 }
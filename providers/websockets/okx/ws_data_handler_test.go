@@ -324,6 +324,79 @@ func TestHandleMessage(t *testing.T) {
 	}
 }
 
+func TestHandleMessageWithMarketData(t *testing.T) {
+	wsHandler, err := okx.NewWebSocketDataHandler(logger, okx.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdt, ethusdt})
+	require.NoError(t, err)
+
+	// Ticker messages only update the market data and do not resolve any prices.
+	bz, err := json.Marshal(okx.TickersResponseMessage{
+		Arguments: okx.SubscriptionTopic{
+			Channel:      string(okx.TickersChannel),
+			InstrumentID: "BTC-USDT",
+		},
+		Data: []okx.Ticker{
+			{
+				ID:      "BTC-USDT",
+				BestBid: "41999",
+				BestAsk: "42001",
+				Volume:  "1234.5",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, updateMsg, err := wsHandler.HandleMessage(bz)
+	require.NoError(t, err)
+	require.Nil(t, updateMsg)
+	require.Empty(t, resp.Resolved)
+	require.Empty(t, resp.UnResolved)
+
+	// Index ticker messages carry the latest market data of the instrument.
+	bz, err = json.Marshal(okx.IndexTickersResponseMessage{
+		Arguments: okx.SubscriptionTopic{
+			Channel:      string(okx.IndexTickersChannel),
+			InstrumentID: "BTC-USDT",
+		},
+		Data: []okx.IndexTicker{
+			{
+				ID:         "BTC-USDT",
+				IndexPrice: "42000",
+			},
+			{
+				ID:         "ETH-USDT",
+				IndexPrice: "2000",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, updateMsg, err = wsHandler.HandleMessage(bz)
+	require.NoError(t, err)
+	require.Nil(t, updateMsg)
+	require.Len(t, resp.Resolved, 2)
+
+	btc := resp.Resolved[btcusdt]
+	require.Equal(t, big.NewFloat(42000).SetPrec(18), btc.Value.SetPrec(18))
+	require.Equal(t, 0, btc.Volume.Cmp(big.NewFloat(1234.5)))
+	require.Equal(t, 0, btc.BestBid.Cmp(big.NewFloat(41999)))
+	require.Equal(t, 0, btc.BestAsk.Cmp(big.NewFloat(42001)))
+
+	// No market data has been received for ETH-USDT.
+	require.False(t, resp.Resolved[ethusdt].HasMarketData())
+
+	// Copies of the handler do not share market data.
+	copied := wsHandler.Copy()
+	_, err = copied.CreateMessages([]types.ProviderTicker{btcusdt})
+	require.NoError(t, err)
+
+	resp, _, err = copied.HandleMessage(bz)
+	require.NoError(t, err)
+	require.False(t, resp.Resolved[btcusdt].HasMarketData())
+}
+
 func TestCreateMessage(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -345,20 +418,24 @@ func TestCreateMessage(t *testing.T) {
 				btcusdt,
 			},
 			expected: func() []handlers.WebsocketEncodedMessage {
-				msg := okx.SubscribeRequestMessage{
-					Operation: string(okx.OperationSubscribe),
-					Arguments: []okx.SubscriptionTopic{
-						{
-							Channel:      string(okx.IndexTickersChannel),
-							InstrumentID: "BTC-USDT",
+				msgs := make([]handlers.WebsocketEncodedMessage, 0, 2)
+				for _, channel := range []okx.Channel{okx.IndexTickersChannel, okx.TickersChannel} {
+					msg := okx.SubscribeRequestMessage{
+						Operation: string(okx.OperationSubscribe),
+						Arguments: []okx.SubscriptionTopic{
+							{
+								Channel:      string(channel),
+								InstrumentID: "BTC-USDT",
+							},
 						},
-					},
-				}
+					}
 
-				bz, err := json.Marshal(msg)
-				require.NoError(t, err)
+					bz, err := json.Marshal(msg)
+					require.NoError(t, err)
+					msgs = append(msgs, bz)
+				}
 
-				return []handlers.WebsocketEncodedMessage{bz}
+				return msgs
 			},
 			expectedErr: false,
 		},
@@ -369,20 +446,22 @@ func TestCreateMessage(t *testing.T) {
 				ethusdt,
 			},
 			expected: func() []handlers.WebsocketEncodedMessage {
-				msgs := make([]handlers.WebsocketEncodedMessage, 2)
-				for i, ticker := range []string{"BTC-USDT", "ETH-USDT"} {
-					msg := okx.SubscribeRequestMessage{
-						Operation: string(okx.OperationSubscribe),
-						Arguments: []okx.SubscriptionTopic{
-							{
-								Channel:      string(okx.IndexTickersChannel),
-								InstrumentID: ticker,
+				msgs := make([]handlers.WebsocketEncodedMessage, 0, 4)
+				for _, ticker := range []string{"BTC-USDT", "ETH-USDT"} {
+					for _, channel := range []okx.Channel{okx.IndexTickersChannel, okx.TickersChannel} {
+						msg := okx.SubscribeRequestMessage{
+							Operation: string(okx.OperationSubscribe),
+							Arguments: []okx.SubscriptionTopic{
+								{
+									Channel:      string(channel),
+									InstrumentID: ticker,
+								},
 							},
-						},
+						}
+						bz, err := json.Marshal(msg)
+						require.NoError(t, err)
+						msgs = append(msgs, bz)
 					}
-					bz, err := json.Marshal(msg)
-					require.NoError(t, err)
-					msgs[i] = bz
 				}
 
 				return msgs
@@ -41,7 +41,8 @@ type GRPCClient struct {
 }
 
 // NewClientFromConfig creates a new grpc client of the oracle service with the given
-// app configuration. This returns an error if the configuration is invalid.
+// app configuration. If prices are prefetched, the client is wrapped in a PrefetchClient.
+// This returns an error if the configuration is invalid.
 func NewClientFromConfig(
 	cfg config.AppConfig,
 	logger log.Logger,
@@ -64,7 +65,16 @@ func NewClientFromConfig(
 		return nil, fmt.Errorf("metrics cannot be nil")
 	}
 
-	return NewClient(logger, cfg.OracleAddress, cfg.ClientTimeout, metrics, opts...)
+	client, err := NewClient(logger, cfg.OracleAddress, cfg.ClientTimeout, metrics, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.PrefetchPrices {
+		return client, nil
+	}
+
+	return NewPrefetchClient(logger, client, cfg.PrefetchInterval, cfg.MaxPriceAge)
 }
 
 // NewClient creates a new grpc client of the oracle service with the given
@@ -198,6 +208,79 @@ func (c *GRPCClient) Prices(
 	return c.client.Prices(ctx, req, grpc.WaitForReady(true))
 }
 
+// StreamPrices opens a stream of prices from the remote oracle service. Unlike Prices, the stream is not subject to
+// the timeout configured on the client, and remains open until the context is cancelled or the remote closes it.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.StreamPricesRequest,
+	opts ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.StreamPrices(ctx, req, append(opts, grpc.WaitForReady(true))...)
+}
+
+// PriceDetails returns the breakdown of the latest index price calculation of each market from the remote oracle
+// service. This method blocks for the timeout duration configured on the client.
+func (c *GRPCClient) PriceDetails(
+	ctx context.Context,
+	req *types.QueryPriceDetailsRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryPriceDetailsResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.PriceDetails(ctx, req, grpc.WaitForReady(true))
+}
+
+// Providers returns the status of each price provider from the remote oracle service. This method blocks for the
+// timeout duration configured on the client.
+func (c *GRPCClient) Providers(
+	ctx context.Context,
+	req *types.QueryProvidersRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryProvidersResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.Providers(ctx, req, grpc.WaitForReady(true))
+}
+
 func (c *GRPCClient) MarketMap(ctx context.Context, req *types.QueryMarketMapRequest, _ ...grpc.CallOption) (res *types.QueryMarketMapResponse, err error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
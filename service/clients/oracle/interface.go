@@ -47,6 +47,24 @@ func (NoOpClient) Prices(
 	return nil, nil
 }
 
+// PriceDetails is a no-op.
+func (NoOpClient) PriceDetails(
+	_ context.Context,
+	_ *types.QueryPriceDetailsRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPriceDetailsResponse, error) {
+	return nil, nil
+}
+
+// Providers is a no-op.
+func (NoOpClient) Providers(
+	_ context.Context,
+	_ *types.QueryProvidersRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProvidersResponse, error) {
+	return nil, nil
+}
+
 func (c NoOpClient) MarketMap(
 	_ context.Context,
 	_ *types.QueryMarketMapRequest,
@@ -54,3 +72,12 @@ func (c NoOpClient) MarketMap(
 ) (*types.QueryMarketMapResponse, error) {
 	return nil, nil
 }
+
+// StreamPrices is a no-op.
+func (NoOpClient) StreamPrices(
+	_ context.Context,
+	_ *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return nil, nil
+}
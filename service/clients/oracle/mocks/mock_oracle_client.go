@@ -54,6 +54,43 @@ func (_m *OracleClient) MarketMap(ctx context.Context, in *types.QueryMarketMapR
 	return r0, r1
 }
 
+// PriceDetails provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) PriceDetails(ctx context.Context, in *types.QueryPriceDetailsRequest, opts ...grpc.CallOption) (*types.QueryPriceDetailsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceDetails")
+	}
+
+	var r0 *types.QueryPriceDetailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest, ...grpc.CallOption) (*types.QueryPriceDetailsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest, ...grpc.CallOption) *types.QueryPriceDetailsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceDetailsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceDetailsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Prices provides a mock function with given fields: ctx, in, opts
 func (_m *OracleClient) Prices(ctx context.Context, in *types.QueryPricesRequest, opts ...grpc.CallOption) (*types.QueryPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -91,6 +128,43 @@ func (_m *OracleClient) Prices(ctx context.Context, in *types.QueryPricesRequest
 	return r0, r1
 }
 
+// Providers provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) Providers(ctx context.Context, in *types.QueryProvidersRequest, opts ...grpc.CallOption) (*types.QueryProvidersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Providers")
+	}
+
+	var r0 *types.QueryProvidersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProvidersRequest, ...grpc.CallOption) (*types.QueryProvidersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProvidersRequest, ...grpc.CallOption) *types.QueryProvidersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProvidersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProvidersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *OracleClient) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -127,6 +201,43 @@ func (_m *OracleClient) Stop() error {
 	return r0
 }
 
+// StreamPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) StreamPrices(ctx context.Context, in *types.StreamPricesRequest, opts ...grpc.CallOption) (types.Oracle_StreamPricesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 types.Oracle_StreamPricesClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) (types.Oracle_StreamPricesClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) types.Oracle_StreamPricesClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Oracle_StreamPricesClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewOracleClient creates a new instance of OracleClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleClient(t interface {
@@ -0,0 +1,181 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"google.golang.org/grpc"
+
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+var _ OracleClient = (*PrefetchClient)(nil)
+
+// PrefetchClient is an OracleClient that continuously fetches prices from the remote oracle
+// service in the background, and serves Prices requests from a local cache. This keeps the
+// latency of the remote oracle out of the consensus hot path (i.e. ExtendVote). Prices are
+// only served if they were fetched, and last synced by the oracle, within the max price age.
+// All other requests are passed through to the underlying client.
+type PrefetchClient struct {
+	OracleClient
+
+	logger log.Logger
+	mutex  sync.RWMutex
+
+	// interval is the interval at which prices are fetched.
+	interval time.Duration
+	// maxAge is the maximum age of the cached prices that are served.
+	maxAge time.Duration
+
+	// prices is the latest response fetched from the remote oracle service.
+	prices *types.QueryPricesResponse
+	// fetchedAt is the time at which the latest response was fetched.
+	fetchedAt time.Time
+
+	// cancel stops the background fetch routine.
+	cancel context.CancelFunc
+	// done is closed once the background fetch routine has stopped.
+	done chan struct{}
+}
+
+// NewPrefetchClient returns a new PrefetchClient that fetches prices using the given client
+// every interval, and serves prices that are at most maxAge old.
+func NewPrefetchClient(
+	logger log.Logger,
+	client OracleClient,
+	interval time.Duration,
+	maxAge time.Duration,
+) (*PrefetchClient, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("oracle client cannot be nil")
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("prefetch interval must be positive")
+	}
+
+	if maxAge < interval {
+		return nil, fmt.Errorf("max price age must be at least the prefetch interval")
+	}
+
+	return &PrefetchClient{
+		OracleClient: client,
+		logger:       logger,
+		interval:     interval,
+		maxAge:       maxAge,
+	}, nil
+}
+
+// Start starts the underlying client and the background routine that fetches prices.
+func (c *PrefetchClient) Start(ctx context.Context) error {
+	if err := c.OracleClient.Start(ctx); err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.cancel != nil {
+		return fmt.Errorf("oracle prefetch client already started")
+	}
+
+	// The fetch routine must outlive the context used to start the client.
+	fetchCtx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+	go c.fetchPrices(fetchCtx, c.done)
+
+	c.logger.Info("oracle prefetch client started", "interval", c.interval, "max_price_age", c.maxAge)
+
+	return nil
+}
+
+// Stop stops the background routine that fetches prices and the underlying client.
+func (c *PrefetchClient) Stop() error {
+	c.mutex.Lock()
+	cancel, done := c.cancel, c.done
+	c.cancel, c.done = nil, nil
+	c.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+
+	return c.OracleClient.Stop()
+}
+
+// Prices returns the latest prices fetched from the remote oracle service. This does not block
+// on the remote oracle. An error is returned if no prices have been fetched yet, or if the latest
+// prices were fetched or last synced by the oracle more than the max price age ago.
+func (c *PrefetchClient) Prices(
+	_ context.Context,
+	_ *types.QueryPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPricesResponse, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if c.prices == nil {
+		return nil, fmt.Errorf("no prices have been fetched from the oracle")
+	}
+
+	if age := time.Since(c.fetchedAt); age > c.maxAge {
+		return nil, fmt.Errorf("prefetched prices are stale; age %s exceeds max price age %s", age, c.maxAge)
+	}
+
+	// The oracle may keep responding while its prices are no longer being updated.
+	if age := time.Since(c.prices.Timestamp); age > c.maxAge {
+		return nil, fmt.Errorf("oracle prices are stale; last synced %s ago which exceeds max price age %s", age, c.maxAge)
+	}
+
+	return c.prices, nil
+}
+
+// fetchPrices fetches prices from the remote oracle service every interval until the context
+// is cancelled.
+func (c *PrefetchClient) fetchPrices(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.fetch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetch fetches the latest prices and caches them. The cache is left unchanged on failure, so
+// that prices are served until they exceed the max price age.
+func (c *PrefetchClient) fetch(ctx context.Context) {
+	resp, err := c.OracleClient.Prices(ctx, &types.QueryPricesRequest{})
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Error("failed to prefetch prices from oracle", "err", err)
+		}
+
+		return
+	}
+
+	if resp == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	c.prices = resp
+	c.fetchedAt = time.Now()
+	c.mutex.Unlock()
+}
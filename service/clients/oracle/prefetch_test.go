@@ -0,0 +1,172 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	client "github.com/skip-mev/slinky/service/clients/oracle"
+	"github.com/skip-mev/slinky/service/clients/oracle/mocks"
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+const (
+	interval = 20 * time.Millisecond
+	maxAge   = 100 * time.Millisecond
+)
+
+func TestNewPrefetchClient(t *testing.T) {
+	testCases := []struct {
+		name      string
+		client    client.OracleClient
+		interval  time.Duration
+		maxAge    time.Duration
+		expectErr bool
+	}{
+		{
+			name:     "valid client",
+			client:   mocks.NewOracleClient(t),
+			interval: interval,
+			maxAge:   maxAge,
+		},
+		{
+			name:      "nil client",
+			interval:  interval,
+			maxAge:    maxAge,
+			expectErr: true,
+		},
+		{
+			name:      "zero interval",
+			client:    mocks.NewOracleClient(t),
+			maxAge:    maxAge,
+			expectErr: true,
+		},
+		{
+			name:      "max age less than interval",
+			client:    mocks.NewOracleClient(t),
+			interval:  maxAge,
+			maxAge:    interval,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.NewPrefetchClient(log.NewNopLogger(), tc.client, tc.interval, tc.maxAge)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPrefetchClient(t *testing.T) {
+	t.Run("serves prefetched prices", func(t *testing.T) {
+		oracleClient := mocks.NewOracleClient(t)
+		resp := &types.QueryPricesResponse{
+			Prices:    map[string]string{"BTC/USD": "100"},
+			Timestamp: time.Now(),
+		}
+
+		oracleClient.On("Start", mock.Anything).Return(nil).Once()
+		oracleClient.On("Prices", mock.Anything, mock.Anything).Return(resp, nil)
+		oracleClient.On("Stop").Return(nil).Once()
+
+		prefetchClient, err := client.NewPrefetchClient(log.NewNopLogger(), oracleClient, interval, maxAge)
+		require.NoError(t, err)
+		require.NoError(t, prefetchClient.Start(context.Background()))
+
+		require.Eventually(t, func() bool {
+			prices, err := prefetchClient.Prices(context.Background(), &types.QueryPricesRequest{})
+			return err == nil && prices == resp
+		}, time.Second, interval)
+
+		require.NoError(t, prefetchClient.Stop())
+	})
+
+	t.Run("errors if no prices have been fetched", func(t *testing.T) {
+		oracleClient := mocks.NewOracleClient(t)
+		oracleClient.On("Start", mock.Anything).Return(nil).Once()
+		oracleClient.On("Prices", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("oracle unavailable"))
+		oracleClient.On("Stop").Return(nil).Once()
+
+		prefetchClient, err := client.NewPrefetchClient(log.NewNopLogger(), oracleClient, interval, maxAge)
+		require.NoError(t, err)
+		require.NoError(t, prefetchClient.Start(context.Background()))
+
+		time.Sleep(2 * interval)
+		_, err = prefetchClient.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.Error(t, err)
+
+		require.NoError(t, prefetchClient.Stop())
+	})
+
+	t.Run("errors if prefetched prices are stale", func(t *testing.T) {
+		oracleClient := mocks.NewOracleClient(t)
+		resp := &types.QueryPricesResponse{
+			Prices:    map[string]string{"BTC/USD": "100"},
+			Timestamp: time.Now(),
+		}
+
+		oracleClient.On("Start", mock.Anything).Return(nil).Once()
+		oracleClient.On("Prices", mock.Anything, mock.Anything).Return(resp, nil).Once()
+		oracleClient.On("Prices", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("oracle unavailable"))
+		oracleClient.On("Stop").Return(nil).Once()
+
+		prefetchClient, err := client.NewPrefetchClient(log.NewNopLogger(), oracleClient, interval, maxAge)
+		require.NoError(t, err)
+		require.NoError(t, prefetchClient.Start(context.Background()))
+
+		require.Eventually(t, func() bool {
+			_, err := prefetchClient.Prices(context.Background(), &types.QueryPricesRequest{})
+			return err == nil
+		}, time.Second, time.Millisecond)
+
+		// The cached prices are served until they exceed the max price age.
+		require.Eventually(t, func() bool {
+			_, err := prefetchClient.Prices(context.Background(), &types.QueryPricesRequest{})
+			return err != nil
+		}, time.Second, interval)
+
+		require.NoError(t, prefetchClient.Stop())
+	})
+
+	t.Run("errors if the oracle's prices are stale", func(t *testing.T) {
+		oracleClient := mocks.NewOracleClient(t)
+		resp := &types.QueryPricesResponse{
+			Prices:    map[string]string{"BTC/USD": "100"},
+			Timestamp: time.Now().Add(-2 * maxAge),
+		}
+
+		oracleClient.On("Start", mock.Anything).Return(nil).Once()
+		oracleClient.On("Prices", mock.Anything, mock.Anything).Return(resp, nil)
+		oracleClient.On("Stop").Return(nil).Once()
+
+		prefetchClient, err := client.NewPrefetchClient(log.NewNopLogger(), oracleClient, interval, maxAge)
+		require.NoError(t, err)
+		require.NoError(t, prefetchClient.Start(context.Background()))
+
+		// The oracle keeps responding, but its prices were last synced too long ago.
+		time.Sleep(2 * interval)
+		_, err = prefetchClient.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.Error(t, err)
+
+		require.NoError(t, prefetchClient.Stop())
+	})
+
+	t.Run("errors if the underlying client fails to start", func(t *testing.T) {
+		oracleClient := mocks.NewOracleClient(t)
+		oracleClient.On("Start", mock.Anything).Return(fmt.Errorf("failed to dial")).Once()
+
+		prefetchClient, err := client.NewPrefetchClient(log.NewNopLogger(), oracleClient, interval, maxAge)
+		require.NoError(t, err)
+		require.Error(t, prefetchClient.Start(context.Background()))
+	})
+}
@@ -22,6 +22,11 @@ func (_m *Metrics) AddABCIRequest(method metrics.ABCIMethod, status metrics.Labe
 	_m.Called(method, status)
 }
 
+// AddHeldBackPriceForTicker provides a mock function with given fields: ticker, status
+func (_m *Metrics) AddHeldBackPriceForTicker(ticker types.CurrencyPair, status metrics.Labeller) {
+	_m.Called(ticker, status)
+}
+
 // AddOracleResponse provides a mock function with given fields: status
 func (_m *Metrics) AddOracleResponse(status metrics.Labeller) {
 	_m.Called(status)
@@ -0,0 +1,68 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+const (
+	// StreamPricesPath is the HTTP path of the StreamPrices method. Requests to this path that accept
+	// EventStreamContentType are served as server-sent events, otherwise they are served by the grpc-gateway
+	// as a stream of newline-delimited JSON messages.
+	StreamPricesPath = "/slinky/oracle/v1/prices/stream"
+
+	// EventStreamContentType is the content type of server-sent events.
+	EventStreamContentType = "text/event-stream"
+
+	// tickersQueryParam is the query parameter used to filter the streamed prices by ticker, i.e.
+	// ?tickers=BTC/USD&tickers=ETH/USD.
+	tickersQueryParam = "tickers"
+)
+
+// streamPricesEvents serves the StreamPrices method as server-sent events. Each event contains the JSON encoded
+// QueryPricesResponse, and is sent every time the oracle completes a price update.
+func (os *OracleServer) streamPricesEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// check that oracle is running before committing to the event stream
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		http.Error(w, ErrOracleNotRunning.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	tickers := r.URL.Query()[tickersQueryParam]
+	os.logger.Debug("received request to stream price events", zap.Strings("tickers", tickers))
+
+	w.Header().Set("Content-Type", EventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err := os.streamPrices(r.Context(), tickers, func(resp *types.QueryPricesResponse) error {
+		bz, err := os.marshaler.Marshal(resp)
+		if err != nil {
+			return fmt.Errorf("failed to marshal prices: %w", err)
+		}
+
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", bz); err != nil {
+			return err
+		}
+
+		flusher.Flush()
+		return nil
+	})
+	if err != nil && !errors.Is(err, r.Context().Err()) {
+		os.logger.Error("price event stream closed with error", zap.Error(err))
+	}
+}
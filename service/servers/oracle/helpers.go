@@ -1,9 +1,29 @@
 package oracle
 
 import (
+	"math/big"
+
 	"github.com/skip-mev/slinky/oracle/types"
+	servicetypes "github.com/skip-mev/slinky/service/servers/oracle/types"
 )
 
+// FilterTickers returns the entries of the given map whose keys are in the given set of tickers. If no tickers are
+// given, the map is returned as is.
+func FilterTickers[V any](data map[string]V, tickers []string) map[string]V {
+	if len(tickers) == 0 {
+		return data
+	}
+
+	filtered := make(map[string]V, len(tickers))
+	for _, ticker := range tickers {
+		if value, ok := data[ticker]; ok {
+			filtered[ticker] = value
+		}
+	}
+
+	return filtered
+}
+
 func ToReqPrices(prices types.Prices) map[string]string {
 	reqPrices := make(map[string]string, len(prices))
 
@@ -14,3 +34,76 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+func ToReqMarketData(marketData types.TickerMarketData) map[string]servicetypes.MarketData {
+	reqMarketData := make(map[string]servicetypes.MarketData, len(marketData))
+
+	for cp, data := range marketData {
+		var reqData servicetypes.MarketData
+		reqData.Volume = toReqDecimal(data.Volume)
+		if data.BestBid != nil && data.BestAsk != nil {
+			reqData.BestBid = toReqInt(data.BestBid)
+			reqData.BestAsk = toReqInt(data.BestAsk)
+		}
+		reqMarketData[cp] = reqData
+	}
+
+	return reqMarketData
+}
+
+func toReqInt(value *big.Float) string {
+	intValue, _ := value.Int(nil)
+	return intValue.String()
+}
+
+func ToReqPriceDetails(details types.TickerPriceDetails) map[string]servicetypes.MarketPriceDetails {
+	reqDetails := make(map[string]servicetypes.MarketPriceDetails, len(details))
+
+	for cp, market := range details {
+		providers := make([]servicetypes.ProviderPriceDetails, len(market.Providers))
+		for i, provider := range market.Providers {
+			providers[i] = servicetypes.ProviderPriceDetails{
+				Provider:       provider.Provider,
+				OffChainTicker: provider.OffChainTicker,
+				RawPrice:       toReqDecimal(provider.RawPrice),
+				ConvertedPrice: toReqDecimal(provider.ConvertedPrice),
+				Timestamp:      provider.Timestamp,
+				Status:         string(provider.Status),
+			}
+		}
+
+		reqDetails[cp] = servicetypes.MarketPriceDetails{
+			Providers:       providers,
+			MedianPrice:     toReqDecimal(market.MedianPrice),
+			AggregatedPrice: toReqDecimal(market.AggregatedPrice),
+		}
+	}
+
+	return reqDetails
+}
+
+func ToReqProviders(circuitBreakers types.ProviderCircuitBreakers) map[string]servicetypes.ProviderStatus {
+	reqProviders := make(map[string]servicetypes.ProviderStatus, len(circuitBreakers))
+
+	for name, status := range circuitBreakers {
+		reqProviders[name] = servicetypes.ProviderStatus{
+			CircuitBreaker: servicetypes.CircuitBreakerStatus{
+				State:               string(status.State),
+				Reason:              string(status.Reason),
+				ConsecutiveFailures: uint64(status.ConsecutiveFailures),
+				ConsecutiveOutliers: uint64(status.ConsecutiveOutliers),
+				OpenUntil:           status.OpenUntil,
+			},
+		}
+	}
+
+	return reqProviders
+}
+
+func toReqDecimal(value *big.Float) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.Text('f', -1)
+}
@@ -37,6 +37,9 @@ type OracleServer struct { //nolint
 	// grpc-gateway mux -- serves all http grpc proxy requests
 	gatewayMux *runtime.ServeMux
 
+	// json marshaler used by the grpc-gateway mux and the price event stream
+	marshaler runtime.Marshaler
+
 	// underlying http server
 	httpSrv *http.Server
 
@@ -54,6 +57,11 @@ func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
 	os := &OracleServer{
 		o:      o,
 		logger: logger,
+		marshaler: &gateway.JSONPb{
+			EmitDefaults: true,
+			Indent:       "",
+			OrigName:     true,
+		},
 	}
 	os.Closer = sync.NewCloser().WithCallback(func() {
 		// if the server has been started, close it
@@ -68,13 +76,15 @@ func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
 	return os
 }
 
-// routeRequest determines if the incoming http request is a grpc or http request and routes to the proper handler.
+// routeRequest determines if the incoming http request is a grpc, server-sent events, or http request and routes to the
+// proper handler.
 func (os *OracleServer) routeRequest(w http.ResponseWriter, r *http.Request) {
-	if r.ProtoMajor == 2 && strings.HasPrefix(
-		r.Header.Get("Content-Type"), "application/grpc") {
-
+	switch {
+	case r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc"):
 		os.grpcSrv.ServeHTTP(w, r)
-	} else {
+	case r.URL.Path == StreamPricesPath && strings.Contains(r.Header.Get("Accept"), EventStreamContentType):
+		os.streamPricesEvents(w, r)
+	default:
 		os.gatewayMux.ServeHTTP(w, r)
 	}
 }
@@ -96,11 +106,7 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	// register the grpc-gateway
 	// it handles the http request and dials the server endpoint with the grpc request
 	os.gatewayMux = runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
-			EmitDefaults: true,
-			Indent:       "",
-			OrigName:     true,
-		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, os.marshaler),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithNoProxy()}
 	err := types.RegisterOracleHandlerFromEndpoint(ctx, os.gatewayMux, serverEndpoint, opts)
@@ -165,16 +171,7 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 
 	// run the request in a goroutine, to unblock server + ctx cancellation
 	go func() {
-		// get the prices
-		prices := os.o.GetPrices()
-
-		// get the latest timestamp of the latest update from the oracle
-		timestamp := os.o.GetLastSyncTime()
-
-		resCh <- &types.QueryPricesResponse{
-			Prices:    ToReqPrices(prices),
-			Timestamp: timestamp,
-		}
+		resCh <- os.pricesResponse(nil)
 	}()
 
 	// defer to context closure
@@ -187,6 +184,122 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	}
 }
 
+// StreamPrices streams the latest prices from the underlying oracle. A response is sent every time the oracle completes
+// a price update, optionally filtered to the tickers specified in the request. The stream is closed when the client
+// cancels the request, or when the server is closed.
+func (os *OracleServer) StreamPrices(req *types.StreamPricesRequest, stream types.Oracle_StreamPricesServer) error {
+	// check that the request is non-nil
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	os.logger.Debug("received request to stream prices", zap.Strings("tickers", req.Tickers))
+
+	return os.streamPrices(stream.Context(), req.Tickers, stream.Send)
+}
+
+// streamPrices sends the latest prices (filtered to the given tickers) using the given send function every time the
+// oracle completes a price update. This blocks until the context is cancelled, the server is closed, or send errors.
+func (os *OracleServer) streamPrices(
+	ctx context.Context,
+	tickers []string,
+	send func(*types.QueryPricesResponse) error,
+) error {
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return ErrOracleNotRunning
+	}
+
+	updates, unsubscribe := os.o.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.logger.Debug("price stream closed by client")
+			return ctx.Err()
+		case <-os.Done():
+			os.logger.Debug("price stream closed by server")
+			return nil
+		case <-updates:
+			if err := send(os.pricesResponse(tickers)); err != nil {
+				os.logger.Debug("failed to send prices to stream", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// pricesResponse returns the latest prices, market data, price dispersion, and sync time of the oracle. If tickers is non-empty, only
+// the data for the given tickers is included.
+func (os *OracleServer) pricesResponse(tickers []string) *types.QueryPricesResponse {
+	// get the prices
+	prices := FilterTickers(os.o.GetPrices(), tickers)
+
+	// get the market data (volume and best bid / ask) reported alongside the prices
+	marketData := FilterTickers(os.o.GetMarketData(), tickers)
+
+	// get the dispersion of the provider prices used to calculate the prices
+	dispersion := FilterTickers(os.o.GetPriceDispersion(), tickers)
+
+	// get the latest timestamp of the latest update from the oracle
+	timestamp := os.o.GetLastSyncTime()
+
+	return &types.QueryPricesResponse{
+		Prices:     ToReqPrices(prices),
+		Timestamp:  timestamp,
+		MarketData: ToReqMarketData(marketData),
+		Dispersion: ToReqPrices(dispersion),
+	}
+}
+
+// PriceDetails returns the breakdown of the latest index price calculation of each market from the underlying oracle,
+// optionally filtered to the tickers specified in the request.
+func (os *OracleServer) PriceDetails(
+	_ context.Context,
+	req *types.QueryPriceDetailsRequest,
+) (*types.QueryPriceDetailsResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for price details", zap.Strings("tickers", req.Tickers))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	return &types.QueryPriceDetailsResponse{
+		Markets:   ToReqPriceDetails(FilterTickers(os.o.GetPriceDetails(), req.Tickers)),
+		Timestamp: os.o.GetLastSyncTime(),
+	}, nil
+}
+
+// Providers returns the status of each price provider of the underlying oracle, i.e. the state of the provider's
+// circuit breaker.
+func (os *OracleServer) Providers(_ context.Context, req *types.QueryProvidersRequest) (*types.QueryProvidersResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for providers")
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	return &types.QueryProvidersResponse{
+		Providers: ToReqProviders(os.o.GetProviderCircuitBreakers()),
+	}, nil
+}
+
 // MarketMap returns the current market map from the Oracle.
 func (os *OracleServer) MarketMap(_ context.Context, _ *types.QueryMarketMapRequest) (*types.QueryMarketMapResponse, error) {
 	mm := os.o.GetMarketMap()
@@ -1,6 +1,7 @@
 package oracle_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -18,6 +19,7 @@ import (
 	"github.com/skip-mev/slinky/oracle/mocks"
 	"github.com/skip-mev/slinky/oracle/types"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	providertypes "github.com/skip-mev/slinky/providers/types"
 	client "github.com/skip-mev/slinky/service/clients/oracle"
 	"github.com/skip-mev/slinky/service/metrics"
 	server "github.com/skip-mev/slinky/service/servers/oracle"
@@ -111,6 +113,8 @@ func (s *ServerTestSuite) TestOracleServerTimeout() {
 	// set the mock oracle to delay GetPrices response (delay for absurd time)
 	s.mockOracle.On("IsRunning").Return(true)
 	s.mockOracle.On("GetPrices").Return(nil).After(delay)
+	s.mockOracle.On("GetMarketData").Return(nil).Maybe()
+	s.mockOracle.On("GetPriceDispersion").Return(nil).Maybe()
 
 	// call from client
 	_, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
@@ -142,6 +146,16 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 		cp1.String(): big.NewFloat(100.1),
 		cp2.String(): big.NewFloat(200.1),
 	})
+	s.mockOracle.On("GetMarketData").Return(types.TickerMarketData{
+		cp1.String(): {
+			Volume:  big.NewFloat(1.5),
+			BestBid: big.NewFloat(99.9),
+			BestAsk: big.NewFloat(100.3),
+		},
+	})
+	s.mockOracle.On("GetPriceDispersion").Return(types.Prices{
+		cp1.String(): big.NewFloat(2.5),
+	})
 	ts := time.Now()
 	s.mockOracle.On("GetLastSyncTime").Return(ts)
 
@@ -152,6 +166,11 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	// check response
 	s.Require().Equal(resp.Prices[cp1.String()], big.NewInt(100).String())
 	s.Require().Equal(resp.Prices[cp2.String()], big.NewInt(200).String())
+	// check market data
+	s.Require().Equal(stypes.MarketData{Volume: "1.5", BestBid: "99", BestAsk: "100"}, resp.MarketData[cp1.String()])
+	s.Require().NotContains(resp.MarketData, cp2.String())
+	// check dispersion
+	s.Require().Equal(map[string]string{cp1.String(): "2"}, resp.Dispersion)
 	// check timestamp
 
 	s.Require().Equal(resp.Timestamp, ts.UTC())
@@ -167,6 +186,229 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
 }
 
+func (s *ServerTestSuite) TestOracleServerStreamPricesNotRunning() {
+	// set the mock oracle to not be running
+	s.mockOracle.On("IsRunning").Return(false)
+
+	// open the stream from the client
+	stream, err := s.client.StreamPrices(context.Background(), &stypes.StreamPricesRequest{})
+	s.Require().NoError(err)
+
+	// expect oracle not running error on the first receive
+	_, err = stream.Recv()
+	s.Require().Equal(err.Error(), grpcErrPrefix+server.ErrOracleNotRunning.Error())
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPrices() {
+	cp1, cp2 := streamTickers()
+	updates, unsubscribed := s.setupStream(cp1, cp2)
+
+	// open a stream filtered to the first ticker
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := s.client.StreamPrices(ctx, &stypes.StreamPricesRequest{Tickers: []string{cp1.String()}})
+	s.Require().NoError(err)
+
+	// expect a response for every price update
+	for i := 0; i < 2; i++ {
+		updates <- struct{}{}
+
+		resp, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]string{cp1.String(): "100"}, resp.Prices)
+		s.Require().Equal(stypes.MarketData{Volume: "1.5"}, resp.MarketData[cp1.String()])
+		s.Require().Empty(resp.Dispersion)
+	}
+
+	// closing the stream should unsubscribe from the oracle
+	cancel()
+	select {
+	case <-unsubscribed:
+	case <-time.After(timeout):
+		s.T().Fatal("stream failed to unsubscribe")
+	}
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesEvents() {
+	cp1, cp2 := streamTickers()
+	updates, unsubscribed := s.setupStream(cp1, cp2)
+
+	// open an event stream over http
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("http://%s:%s%s?tickers=%s", localhost, port, server.StreamPricesPath, cp2.String()),
+		nil,
+	)
+	s.Require().NoError(err)
+	req.Header.Set("Accept", server.EventStreamContentType)
+
+	// the http server may not be accepting connections yet
+	var httpResp *http.Response
+	s.Require().Eventually(func() bool {
+		httpResp, err = s.httpClient.Do(req) //nolint:bodyclose
+		return err == nil
+	}, timeout, 10*time.Millisecond)
+	defer httpResp.Body.Close()
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	s.Require().Equal(server.EventStreamContentType, httpResp.Header.Get("Content-Type"))
+
+	// expect an event for the price update
+	updates <- struct{}{}
+	reader := bufio.NewReader(httpResp.Body)
+	line, err := reader.ReadString('\n')
+	s.Require().NoError(err)
+	s.Require().Contains(line, fmt.Sprintf(`data: {"prices":{"%s":"200"},"timestamp":`, cp2.String()))
+
+	// closing the stream should unsubscribe from the oracle
+	cancel()
+	select {
+	case <-unsubscribed:
+	case <-time.After(timeout):
+		s.T().Fatal("event stream failed to unsubscribe")
+	}
+}
+
+func streamTickers() (slinkytypes.CurrencyPair, slinkytypes.CurrencyPair) {
+	return slinkytypes.NewCurrencyPair("BTC", "USD"), slinkytypes.NewCurrencyPair("ETH", "USD")
+}
+
+// setupStream sets up the mock oracle to serve a price stream. The returned updates channel is used to
+// trigger price updates, and the returned unsubscribed channel is closed when the stream unsubscribes.
+func (s *ServerTestSuite) setupStream(cp1, cp2 slinkytypes.CurrencyPair) (chan struct{}, chan struct{}) {
+	updates := make(chan struct{})
+	unsubscribed := make(chan struct{})
+
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("Subscribe").Return((<-chan struct{})(updates), func() { close(unsubscribed) }).Once()
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		cp1.String(): big.NewFloat(100.1),
+		cp2.String(): big.NewFloat(200.1),
+	})
+	s.mockOracle.On("GetMarketData").Return(types.TickerMarketData{
+		cp1.String(): {Volume: big.NewFloat(1.5)},
+	})
+	s.mockOracle.On("GetPriceDispersion").Return(types.Prices{
+		cp2.String(): big.NewFloat(0.5),
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now())
+
+	return updates, unsubscribed
+}
+
+func (s *ServerTestSuite) TestOracleServerPriceDetails() {
+	// set the mock oracle to return price details
+	s.mockOracle.On("IsRunning").Return(true)
+	cp1, cp2 := streamTickers()
+
+	ts := time.Now().UTC()
+	s.mockOracle.On("GetPriceDetails").Return(types.TickerPriceDetails{
+		cp1.String(): {
+			Providers: []types.ProviderPriceDetails{
+				{
+					Provider:       "coinbase",
+					OffChainTicker: "BTC-USD",
+					RawPrice:       big.NewFloat(100.5),
+					ConvertedPrice: big.NewFloat(100.5),
+					Timestamp:      ts,
+					Status:         types.ProviderPriceUsed,
+				},
+				{
+					Provider:       "binance",
+					OffChainTicker: "BTCUSDT",
+					Status:         types.ProviderPriceMissing,
+				},
+			},
+			MedianPrice:     big.NewFloat(100.5),
+			AggregatedPrice: big.NewFloat(100.5),
+		},
+		cp2.String(): {},
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	// call from grpc client
+	resp, err := s.client.PriceDetails(context.Background(), &stypes.QueryPriceDetailsRequest{
+		Tickers: []string{cp1.String()},
+	})
+	s.Require().NoError(err)
+
+	// check response
+	s.Require().Len(resp.Markets, 1)
+	market := resp.Markets[cp1.String()]
+	s.Require().Equal("100.5", market.MedianPrice)
+	s.Require().Equal("100.5", market.AggregatedPrice)
+	s.Require().Equal([]stypes.ProviderPriceDetails{
+		{
+			Provider:       "coinbase",
+			OffChainTicker: "BTC-USD",
+			RawPrice:       "100.5",
+			ConvertedPrice: "100.5",
+			Timestamp:      ts,
+			Status:         "used",
+		},
+		{
+			Provider:       "binance",
+			OffChainTicker: "BTCUSDT",
+			Timestamp:      time.Time{},
+			Status:         "missing",
+		},
+	}, market.Providers)
+	s.Require().Equal(ts, resp.Timestamp)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/prices/details", localhost, port))
+	s.Require().NoError(err)
+
+	// check response
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"status":"used"`)
+	s.Require().Contains(string(respBz), cp2.String())
+}
+
+func (s *ServerTestSuite) TestOracleServerProviders() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	openUntil := time.Now().UTC().Add(time.Minute)
+	s.mockOracle.On("GetProviderCircuitBreakers").Return(types.ProviderCircuitBreakers{
+		"coinbase": {
+			State: providertypes.CircuitBreakerClosed,
+		},
+		"binance": {
+			State:     providertypes.CircuitBreakerOpen,
+			Reason:    providertypes.CircuitBreakerOutliers,
+			OpenUntil: openUntil,
+		},
+	})
+
+	// call from grpc client
+	resp, err := s.client.Providers(context.Background(), &stypes.QueryProvidersRequest{})
+	s.Require().NoError(err)
+
+	// check response
+	s.Require().Len(resp.Providers, 2)
+	s.Require().Equal("closed", resp.Providers["coinbase"].CircuitBreaker.State)
+	s.Require().Equal(stypes.CircuitBreakerStatus{
+		State:     "open",
+		Reason:    "outliers",
+		OpenUntil: openUntil,
+	}, resp.Providers["binance"].CircuitBreaker)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/providers", localhost, port))
+	s.Require().NoError(err)
+
+	// check response
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"state":"open"`)
+	s.Require().Contains(string(respBz), `"reason":"outliers"`)
+}
+
 func (s *ServerTestSuite) TestOracleMarketMap() {
 	dummyMarketMap := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
 		"foo": {
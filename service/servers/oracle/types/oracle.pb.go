@@ -77,6 +77,13 @@ type QueryPricesResponse struct {
 	// prices defines the list of prices.
 	Prices    map[string]string `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Timestamp time.Time         `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// market_data defines the (optional) market data reported alongside the
+	// prices. This is only populated for tickers whose providers report it.
+	MarketData map[string]MarketData `protobuf:"bytes,3,rep,name=market_data,json=marketData,proto3" json:"market_data" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// dispersion defines the standard deviation of the provider prices used to
+	// calculate each price, scaled by the ticker's decimals. It can be used as a
+	// confidence interval around the price.
+	Dispersion map[string]string `protobuf:"bytes,4,rep,name=dispersion,proto3" json:"dispersion" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
@@ -126,22 +133,43 @@ func (m *QueryPricesResponse) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
-// QueryMarketMapRequest defines the request type for the MarketMap method.
-type QueryMarketMapRequest struct {
+func (m *QueryPricesResponse) GetMarketData() map[string]MarketData {
+	if m != nil {
+		return m.MarketData
+	}
+	return nil
 }
 
-func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
-func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryMarketMapRequest) ProtoMessage()    {}
-func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPricesResponse) GetDispersion() map[string]string {
+	if m != nil {
+		return m.Dispersion
+	}
+	return nil
+}
+
+// MarketData defines the market data (volume and best bid / ask) aggregated
+// for a ticker. Each field is empty if it is not available.
+type MarketData struct {
+	// volume defines the rolling 24 hour volume in units of the base asset.
+	Volume string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	// best_bid defines the best bid, scaled by the ticker's decimals.
+	BestBid string `protobuf:"bytes,2,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
+	// best_ask defines the best ask, scaled by the ticker's decimals.
+	BestAsk string `protobuf:"bytes,3,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+}
+
+func (m *MarketData) Reset()         { *m = MarketData{} }
+func (m *MarketData) String() string { return proto.CompactTextString(m) }
+func (*MarketData) ProtoMessage()    {}
+func (*MarketData) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e88883d464f0f25b, []int{2}
 }
-func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
+func (m *MarketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMarketMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MarketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMarketMapRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MarketData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -151,36 +179,58 @@ func (m *QueryMarketMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryMarketMapRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMarketMapRequest.Merge(m, src)
+func (m *MarketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketData.Merge(m, src)
 }
-func (m *QueryMarketMapRequest) XXX_Size() int {
+func (m *MarketData) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMarketMapRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMarketMapRequest.DiscardUnknown(m)
+func (m *MarketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMarketMapRequest proto.InternalMessageInfo
+var xxx_messageInfo_MarketData proto.InternalMessageInfo
 
-// QueryMarketMapResponse defines the response type for the MarketMap method.
-type QueryMarketMapResponse struct {
-	// market_map defines the current market map configuration.
-	MarketMap *types.MarketMap `protobuf:"bytes,1,opt,name=market_map,json=marketMap,proto3" json:"market_map,omitempty"`
+func (m *MarketData) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
 }
 
-func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{} }
-func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMarketMapResponse) ProtoMessage()    {}
-func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
+func (m *MarketData) GetBestBid() string {
+	if m != nil {
+		return m.BestBid
+	}
+	return ""
+}
+
+func (m *MarketData) GetBestAsk() string {
+	if m != nil {
+		return m.BestAsk
+	}
+	return ""
+}
+
+// StreamPricesRequest defines the request type for the StreamPrices method.
+type StreamPricesRequest struct {
+	// tickers defines the (optional) list of tickers to stream prices for. If
+	// empty, the prices of all tickers are streamed.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *StreamPricesRequest) Reset()         { *m = StreamPricesRequest{} }
+func (m *StreamPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPricesRequest) ProtoMessage()    {}
+func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e88883d464f0f25b, []int{3}
 }
-func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
+func (m *StreamPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMarketMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMarketMapResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamPricesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -190,391 +240,3272 @@ func (m *QueryMarketMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryMarketMapResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMarketMapResponse.Merge(m, src)
+func (m *StreamPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPricesRequest.Merge(m, src)
 }
-func (m *QueryMarketMapResponse) XXX_Size() int {
+func (m *StreamPricesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMarketMapResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMarketMapResponse.DiscardUnknown(m)
+func (m *StreamPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPricesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMarketMapResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamPricesRequest proto.InternalMessageInfo
 
-func (m *QueryMarketMapResponse) GetMarketMap() *types.MarketMap {
+func (m *StreamPricesRequest) GetTickers() []string {
 	if m != nil {
-		return m.MarketMap
+		return m.Tickers
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
-	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
-	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
-	proto.RegisterType((*QueryMarketMapRequest)(nil), "slinky.service.v1.QueryMarketMapRequest")
-	proto.RegisterType((*QueryMarketMapResponse)(nil), "slinky.service.v1.QueryMarketMapResponse")
+// QueryPriceDetailsRequest defines the request type for the PriceDetails
+// method.
+type QueryPriceDetailsRequest struct {
+	// tickers defines the (optional) list of tickers to return the price details
+	// for. If empty, the price details of all tickers are returned.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
 }
 
-func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
-
-var fileDescriptor_e88883d464f0f25b = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x1e, 0x54, 0xc4, 0x5d, 0xc0, 0x14, 0x28, 0x01, 0xd2, 0xa8, 0x08, 0x54, 0x06,
-	0x6c, 0x5d, 0x18, 0xf8, 0x21, 0xb1, 0x54, 0x62, 0x3c, 0x71, 0x17, 0x21, 0x21, 0xb1, 0x9c, 0xdc,
-	0xc8, 0x84, 0xa8, 0x75, 0x6c, 0x62, 0x27, 0x52, 0x56, 0x24, 0x26, 0x96, 0x93, 0xf8, 0xa7, 0x6e,
-	0x3c, 0x89, 0x85, 0x09, 0x50, 0x8b, 0xf8, 0x3b, 0x50, 0x6c, 0xa7, 0x07, 0x3d, 0xd0, 0x75, 0x8a,
-	0x9f, 0xbf, 0xef, 0x3d, 0x7f, 0xdf, 0xc7, 0x0e, 0x0c, 0xd4, 0x22, 0xcb, 0xe7, 0x35, 0x51, 0xac,
-	0xa8, 0xb2, 0x84, 0x91, 0x6a, 0x97, 0x88, 0x82, 0x26, 0x0b, 0x86, 0x65, 0x21, 0xb4, 0x40, 0x57,
-	0xac, 0x8e, 0x9d, 0x8e, 0xab, 0x5d, 0x7f, 0x90, 0x8a, 0x54, 0x18, 0x95, 0x34, 0x2b, 0x9b, 0xe8,
-	0xdf, 0x4e, 0x85, 0x48, 0x17, 0x8c, 0x50, 0x99, 0x11, 0x9a, 0xe7, 0x42, 0x53, 0x9d, 0x89, 0x5c,
-	0x39, 0x75, 0xe4, 0x54, 0x13, 0xcd, 0xca, 0xb7, 0x44, 0x67, 0x9c, 0x29, 0x4d, 0xb9, 0x74, 0x09,
-	0x37, 0x13, 0xa1, 0xb8, 0x50, 0x87, 0xb6, 0xaf, 0x0d, 0x9c, 0x14, 0x3a, 0x8b, 0x9c, 0x16, 0x73,
-	0xa6, 0x39, 0x95, 0x8d, 0x49, 0x1b, 0xd8, 0x8c, 0xf1, 0x00, 0xa2, 0x83, 0x92, 0x15, 0xf5, 0x7e,
-	0x91, 0x25, 0x4c, 0xc5, 0xec, 0x7d, 0xc9, 0x94, 0x1e, 0xff, 0x02, 0xf0, 0xea, 0x5f, 0xdb, 0x4a,
-	0x8a, 0x5c, 0x31, 0xb4, 0x0f, 0x7b, 0xd2, 0xec, 0x0c, 0x41, 0xb8, 0x33, 0xe9, 0x47, 0x11, 0x3e,
-	0x33, 0x23, 0xfe, 0x47, 0x1d, 0xb6, 0xe1, 0x8b, 0x5c, 0x17, 0xf5, 0xf4, 0xc2, 0xf1, 0xb7, 0x51,
-	0x27, 0x76, 0x7d, 0xd0, 0x14, 0x7a, 0xeb, 0x79, 0x86, 0xdd, 0x10, 0x4c, 0xfa, 0x91, 0x8f, 0xed,
-	0xc4, 0xb8, 0x9d, 0x18, 0xbf, 0x6a, 0x33, 0xa6, 0x97, 0x9a, 0xe2, 0xa3, 0xef, 0x23, 0x10, 0x9f,
-	0x96, 0xf9, 0x4f, 0x61, 0xff, 0x8f, 0x03, 0xd0, 0x65, 0xb8, 0x33, 0x67, 0xf5, 0x10, 0x84, 0x60,
-	0xe2, 0xc5, 0xcd, 0x12, 0x0d, 0xe0, 0xc5, 0x8a, 0x2e, 0x4a, 0x66, 0x0e, 0xf0, 0x62, 0x1b, 0x3c,
-	0xeb, 0x3e, 0x01, 0xe3, 0x1b, 0xf0, 0x9a, 0xf1, 0xbb, 0x67, 0x98, 0xec, 0x51, 0xd9, 0x12, 0x78,
-	0x0d, 0xaf, 0x6f, 0x0a, 0x8e, 0xc1, 0x73, 0x08, 0x2d, 0xc1, 0x43, 0x4e, 0xa5, 0x39, 0xa5, 0x1f,
-	0x05, 0x2d, 0x87, 0x35, 0xe8, 0x86, 0xc4, 0x69, 0xad, 0xc7, 0xdb, 0x65, 0xf4, 0xa9, 0x0b, 0x7b,
-	0x2f, 0xcd, 0x33, 0x41, 0x35, 0xec, 0x59, 0xdf, 0xe8, 0xde, 0x79, 0x1c, 0x8d, 0x29, 0xff, 0xfe,
-	0x76, 0xb8, 0xc7, 0xe1, 0x87, 0x2f, 0x3f, 0x3f, 0x77, 0x7d, 0x34, 0x24, 0xee, 0xfe, 0xed, 0xbb,
-	0x6c, 0x2e, 0xdf, 0x61, 0xff, 0x08, 0xa0, 0xb7, 0xb6, 0x87, 0x26, 0xff, 0xeb, 0xbb, 0x89, 0xc5,
-	0x7f, 0xb0, 0x45, 0xa6, 0x33, 0x71, 0xd7, 0x98, 0xb8, 0x83, 0x6e, 0x9d, 0x35, 0xb1, 0xa6, 0x34,
-	0x3d, 0x38, 0x5e, 0x06, 0xe0, 0x64, 0x19, 0x80, 0x1f, 0xcb, 0x00, 0x1c, 0xad, 0x82, 0xce, 0xc9,
-	0x2a, 0xe8, 0x7c, 0x5d, 0x05, 0x9d, 0x37, 0x8f, 0xd3, 0x4c, 0xbf, 0x2b, 0x67, 0x38, 0x11, 0x9c,
-	0xa8, 0x79, 0x26, 0x1f, 0x72, 0x56, 0x91, 0x8d, 0x3f, 0xae, 0xf9, 0xb2, 0x42, 0xb5, 0x9d, 0x75,
-	0x2d, 0x99, 0x9a, 0xf5, 0xcc, 0xb3, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x54, 0x12, 0xd7,
-	0x59, 0x9f, 0x03, 0x00, 0x00,
+func (m *QueryPriceDetailsRequest) Reset()         { *m = QueryPriceDetailsRequest{} }
+func (m *QueryPriceDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceDetailsRequest) ProtoMessage()    {}
+func (*QueryPriceDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{4}
+}
+func (m *QueryPriceDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceDetailsRequest.Merge(m, src)
+}
+func (m *QueryPriceDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceDetailsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPriceDetailsRequest proto.InternalMessageInfo
 
-// OracleClient is the client API for Oracle service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type OracleClient interface {
-	// Prices defines a method for fetching the latest prices.
-	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
-	// MarketMap defines a method for fetching the latest market map
-	// configuration.
-	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
+func (m *QueryPriceDetailsRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
 }
 
-type oracleClient struct {
-	cc grpc1.ClientConn
+// QueryPriceDetailsResponse defines the response type for the PriceDetails
+// method.
+type QueryPriceDetailsResponse struct {
+	// markets defines the price details of each market, indexed by ticker.
+	Markets   map[string]MarketPriceDetails `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timestamp time.Time                     `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 }
 
-func NewOracleClient(cc grpc1.ClientConn) OracleClient {
-	return &oracleClient{cc}
+func (m *QueryPriceDetailsResponse) Reset()         { *m = QueryPriceDetailsResponse{} }
+func (m *QueryPriceDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceDetailsResponse) ProtoMessage()    {}
+func (*QueryPriceDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{5}
 }
-
-func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
-	out := new(QueryPricesResponse)
-	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Prices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceDetailsResponse.Merge(m, src)
+}
+func (m *QueryPriceDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceDetailsResponse.DiscardUnknown(m)
 }
 
-func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error) {
-	out := new(QueryMarketMapResponse)
-	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/MarketMap", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceDetailsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceDetailsResponse) GetMarkets() map[string]MarketPriceDetails {
+	if m != nil {
+		return m.Markets
 	}
-	return out, nil
+	return nil
 }
 
-// OracleServer is the server API for Oracle service.
-type OracleServer interface {
-	// Prices defines a method for fetching the latest prices.
-	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
-	// MarketMap defines a method for fetching the latest market map
-	// configuration.
-	MarketMap(context.Context, *QueryMarketMapRequest) (*QueryMarketMapResponse, error)
+func (m *QueryPriceDetailsResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
 }
 
-// UnimplementedOracleServer can be embedded to have forward compatible implementations.
-type UnimplementedOracleServer struct {
+// MarketPriceDetails defines the breakdown of the latest index price
+// calculation of a market. All prices are unscaled decimal strings.
+type MarketPriceDetails struct {
+	// providers defines the contribution of each of the market's providers.
+	Providers []ProviderPriceDetails `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
+	// median_price defines the median of the converted prices that were used.
+	// This is empty if no index price was calculated.
+	MedianPrice string `protobuf:"bytes,2,opt,name=median_price,json=medianPrice,proto3" json:"median_price,omitempty"`
+	// aggregated_price defines the index price calculated using the market's
+	// aggregation method. This is empty if no index price was calculated.
+	AggregatedPrice string `protobuf:"bytes,3,opt,name=aggregated_price,json=aggregatedPrice,proto3" json:"aggregated_price,omitempty"`
 }
 
-func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+func (m *MarketPriceDetails) Reset()         { *m = MarketPriceDetails{} }
+func (m *MarketPriceDetails) String() string { return proto.CompactTextString(m) }
+func (*MarketPriceDetails) ProtoMessage()    {}
+func (*MarketPriceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{6}
 }
-func (*UnimplementedOracleServer) MarketMap(ctx context.Context, req *QueryMarketMapRequest) (*QueryMarketMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+func (m *MarketPriceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterOracleServer(s grpc1.Server, srv OracleServer) {
-	s.RegisterService(&_Oracle_serviceDesc, srv)
+func (m *MarketPriceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPriceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPriceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPriceDetails.Merge(m, src)
+}
+func (m *MarketPriceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPriceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPriceDetails.DiscardUnknown(m)
 }
 
-func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OracleServer).Prices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.service.v1.Oracle/Prices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).Prices(ctx, req.(*QueryPricesRequest))
+var xxx_messageInfo_MarketPriceDetails proto.InternalMessageInfo
+
+func (m *MarketPriceDetails) GetProviders() []ProviderPriceDetails {
+	if m != nil {
+		return m.Providers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketMapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OracleServer).MarketMap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.service.v1.Oracle/MarketMap",
+func (m *MarketPriceDetails) GetMedianPrice() string {
+	if m != nil {
+		return m.MedianPrice
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).MarketMap(ctx, req.(*QueryMarketMapRequest))
+	return ""
+}
+
+func (m *MarketPriceDetails) GetAggregatedPrice() string {
+	if m != nil {
+		return m.AggregatedPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Oracle_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "slinky.service.v1.Oracle",
-	HandlerType: (*OracleServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Prices",
-			Handler:    _Oracle_Prices_Handler,
-		},
-		{
-			MethodName: "MarketMap",
-			Handler:    _Oracle_MarketMap_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "slinky/service/v1/oracle.proto",
+// ProviderPriceDetails defines the contribution of a single provider to the
+// index price of a market.
+type ProviderPriceDetails struct {
+	// provider defines the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// off_chain_ticker defines the off-chain ticker of the provider's market.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// raw_price defines the price as reported by the provider. This is empty if
+	// the price is missing or stale.
+	RawPrice string `protobuf:"bytes,3,opt,name=raw_price,json=rawPrice,proto3" json:"raw_price,omitempty"`
+	// converted_price defines the price converted to the market's ticker. This
+	// is empty if the price could not be converted.
+	ConvertedPrice string `protobuf:"bytes,4,opt,name=converted_price,json=convertedPrice,proto3" json:"converted_price,omitempty"`
+	// timestamp defines the time at which the provider last reported the price.
+	Timestamp time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// status defines whether the price was used to calculate the index price,
+	// or why it was not i.e. used, missing, stale, missing_index_price,
+	// conversion_failed, outlier, insufficient_providers, or aggregation_failed.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderPriceDetails) Reset()         { *m = ProviderPriceDetails{} }
+func (m *ProviderPriceDetails) String() string { return proto.CompactTextString(m) }
+func (*ProviderPriceDetails) ProtoMessage()    {}
+func (*ProviderPriceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{7}
+}
+func (m *ProviderPriceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPriceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPriceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ProviderPriceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPriceDetails.Merge(m, src)
+}
+func (m *ProviderPriceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPriceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPriceDetails.DiscardUnknown(m)
 }
 
-func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProviderPriceDetails proto.InternalMessageInfo
+
+func (m *ProviderPriceDetails) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ProviderPriceDetails) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
+	}
+	return ""
 }
 
-func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderPriceDetails) GetRawPrice() string {
+	if m != nil {
+		return m.RawPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderPriceDetails) GetConvertedPrice() string {
+	if m != nil {
+		return m.ConvertedPrice
+	}
+	return ""
 }
 
-func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+func (m *ProviderPriceDetails) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Prices) > 0 {
-		for k := range m.Prices {
-			v := m.Prices[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	return time.Time{}
+}
+
+func (m *ProviderPriceDetails) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// QueryProvidersRequest defines the request type for the Providers method.
+type QueryProvidersRequest struct {
+}
+
+func (m *QueryProvidersRequest) Reset()         { *m = QueryProvidersRequest{} }
+func (m *QueryProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersRequest) ProtoMessage()    {}
+func (*QueryProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{8}
+}
+func (m *QueryProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersRequest.Merge(m, src)
+}
+func (m *QueryProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProvidersRequest proto.InternalMessageInfo
+
+// QueryProvidersResponse defines the response type for the Providers method.
+type QueryProvidersResponse struct {
+	// providers defines the status of each price provider, indexed by name.
+	Providers map[string]ProviderStatus `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *QueryProvidersResponse) Reset()         { *m = QueryProvidersResponse{} }
+func (m *QueryProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersResponse) ProtoMessage()    {}
+func (*QueryProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{9}
+}
+func (m *QueryProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersResponse.Merge(m, src)
+}
+func (m *QueryProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryProvidersResponse proto.InternalMessageInfo
+
+func (m *QueryProvidersResponse) GetProviders() map[string]ProviderStatus {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
 }
 
-func (m *QueryMarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// ProviderStatus defines the status of a price provider.
+type ProviderStatus struct {
+	// circuit_breaker defines the state of the provider's circuit breaker.
+	CircuitBreaker CircuitBreakerStatus `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
 }
 
-func (m *QueryMarketMapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderStatus) Reset()         { *m = ProviderStatus{} }
+func (m *ProviderStatus) String() string { return proto.CompactTextString(m) }
+func (*ProviderStatus) ProtoMessage()    {}
+func (*ProviderStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{10}
+}
+func (m *ProviderStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ProviderStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderStatus.Merge(m, src)
+}
+func (m *ProviderStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderStatus.DiscardUnknown(m)
 }
 
-func (m *QueryMarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProviderStatus proto.InternalMessageInfo
+
+func (m *ProviderStatus) GetCircuitBreaker() CircuitBreakerStatus {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreakerStatus{}
 }
 
-func (m *QueryMarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketMap != nil {
-		{
-			size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
+// CircuitBreakerStatus defines the state of a provider's circuit breaker.
+type CircuitBreakerStatus struct {
+	// state defines the state of the circuit breaker i.e. closed, open, or
+	// half_open. The provider's prices are not used while the circuit breaker
+	// is open.
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// reason defines why the circuit breaker last tripped i.e. failures or
+	// outliers. This is empty if the circuit breaker has never tripped.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// consecutive_failures defines the number of consecutive failed responses.
+	ConsecutiveFailures uint64 `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// consecutive_outliers defines the number of consecutive price updates in
+	// which the provider's prices were rejected as outliers.
+	ConsecutiveOutliers uint64 `protobuf:"varint,4,opt,name=consecutive_outliers,json=consecutiveOutliers,proto3" json:"consecutive_outliers,omitempty"`
+	// open_until defines the time until which the provider is quarantined.
+	OpenUntil time.Time `protobuf:"bytes,5,opt,name=open_until,json=openUntil,proto3,stdtime" json:"open_until"`
+}
+
+func (m *CircuitBreakerStatus) Reset()         { *m = CircuitBreakerStatus{} }
+func (m *CircuitBreakerStatus) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerStatus) ProtoMessage()    {}
+func (*CircuitBreakerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{11}
+}
+func (m *CircuitBreakerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CircuitBreakerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerStatus.Merge(m, src)
+}
+func (m *CircuitBreakerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerStatus.DiscardUnknown(m)
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CircuitBreakerStatus proto.InternalMessageInfo
+
+func (m *CircuitBreakerStatus) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CircuitBreakerStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreakerStatus) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for k, v := range m.Prices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+	return 0
+}
+
+func (m *CircuitBreakerStatus) GetConsecutiveOutliers() uint64 {
+	if m != nil {
+		return m.ConsecutiveOutliers
+	}
+	return 0
+}
+
+func (m *CircuitBreakerStatus) GetOpenUntil() time.Time {
+	if m != nil {
+		return m.OpenUntil
+	}
+	return time.Time{}
+}
+
+// QueryMarketMapRequest defines the request type for the MarketMap method.
+type QueryMarketMapRequest struct {
+}
+
+func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
+func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketMapRequest) ProtoMessage()    {}
+func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{12}
+}
+func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketMapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+}
+func (m *QueryMarketMapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketMapRequest.Merge(m, src)
+}
+func (m *QueryMarketMapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketMapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketMapRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMarketMapRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMarketMapRequest proto.InternalMessageInfo
+
+// QueryMarketMapResponse defines the response type for the MarketMap method.
+type QueryMarketMapResponse struct {
+	// market_map defines the current market map configuration.
+	MarketMap *types.MarketMap `protobuf:"bytes,1,opt,name=market_map,json=marketMap,proto3" json:"market_map,omitempty"`
+}
+
+func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{} }
+func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketMapResponse) ProtoMessage()    {}
+func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{13}
+}
+func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketMapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketMapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketMapResponse.Merge(m, src)
+}
+func (m *QueryMarketMapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketMapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketMapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketMapResponse proto.InternalMessageInfo
+
+func (m *QueryMarketMapResponse) GetMarketMap() *types.MarketMap {
+	if m != nil {
+		return m.MarketMap
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
+	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.DispersionEntry")
+	proto.RegisterMapType((map[string]MarketData)(nil), "slinky.service.v1.QueryPricesResponse.MarketDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
+	proto.RegisterType((*MarketData)(nil), "slinky.service.v1.MarketData")
+	proto.RegisterType((*StreamPricesRequest)(nil), "slinky.service.v1.StreamPricesRequest")
+	proto.RegisterType((*QueryPriceDetailsRequest)(nil), "slinky.service.v1.QueryPriceDetailsRequest")
+	proto.RegisterType((*QueryPriceDetailsResponse)(nil), "slinky.service.v1.QueryPriceDetailsResponse")
+	proto.RegisterMapType((map[string]MarketPriceDetails)(nil), "slinky.service.v1.QueryPriceDetailsResponse.MarketsEntry")
+	proto.RegisterType((*MarketPriceDetails)(nil), "slinky.service.v1.MarketPriceDetails")
+	proto.RegisterType((*ProviderPriceDetails)(nil), "slinky.service.v1.ProviderPriceDetails")
+	proto.RegisterType((*QueryProvidersRequest)(nil), "slinky.service.v1.QueryProvidersRequest")
+	proto.RegisterType((*QueryProvidersResponse)(nil), "slinky.service.v1.QueryProvidersResponse")
+	proto.RegisterMapType((map[string]ProviderStatus)(nil), "slinky.service.v1.QueryProvidersResponse.ProvidersEntry")
+	proto.RegisterType((*ProviderStatus)(nil), "slinky.service.v1.ProviderStatus")
+	proto.RegisterType((*CircuitBreakerStatus)(nil), "slinky.service.v1.CircuitBreakerStatus")
+	proto.RegisterType((*QueryMarketMapRequest)(nil), "slinky.service.v1.QueryMarketMapRequest")
+	proto.RegisterType((*QueryMarketMapResponse)(nil), "slinky.service.v1.QueryMarketMapResponse")
+}
+
+func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
+
+var fileDescriptor_e88883d464f0f25b = []byte{
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xce, 0x26, 0xae, 0x1b, 0x3f, 0x47, 0x49, 0x7e, 0x13, 0xff, 0x82, 0xb3, 0xa5, 0x8e, 0xb3,
+	0xa8, 0xc4, 0x11, 0xe0, 0x25, 0x2e, 0xa2, 0x2d, 0xa8, 0x07, 0x9c, 0xc0, 0x05, 0x55, 0x4d, 0xdd,
+	0x02, 0xa2, 0x0a, 0x5a, 0x8d, 0xd7, 0x63, 0x67, 0x64, 0xef, 0xce, 0xb2, 0x33, 0xeb, 0xca, 0x57,
+	0x24, 0x0e, 0xdc, 0x2a, 0xf5, 0xcc, 0xdf, 0xc0, 0x89, 0x3b, 0xc7, 0x1e, 0x2b, 0x71, 0x80, 0x13,
+	0xa0, 0x84, 0x3b, 0xff, 0x01, 0x42, 0xbb, 0x33, 0xbb, 0xeb, 0x75, 0x36, 0xad, 0x83, 0x38, 0xc5,
+	0x6f, 0xde, 0xfb, 0xde, 0x7e, 0xf3, 0xde, 0x37, 0x6f, 0x26, 0x50, 0xe3, 0x23, 0xea, 0x0e, 0x27,
+	0x26, 0x27, 0xfe, 0x98, 0xda, 0xc4, 0x1c, 0xef, 0x9b, 0xcc, 0xc7, 0xf6, 0x88, 0x34, 0x3d, 0x9f,
+	0x09, 0x86, 0xfe, 0x27, 0xfd, 0x4d, 0xe5, 0x6f, 0x8e, 0xf7, 0xf5, 0xca, 0x80, 0x0d, 0x58, 0xe4,
+	0x35, 0xc3, 0x5f, 0x32, 0x50, 0x7f, 0x7d, 0xc0, 0xd8, 0x60, 0x44, 0x4c, 0xec, 0x51, 0x13, 0xbb,
+	0x2e, 0x13, 0x58, 0x50, 0xe6, 0x72, 0xe5, 0xdd, 0x56, 0xde, 0xc8, 0xea, 0x06, 0x7d, 0x53, 0x50,
+	0x87, 0x70, 0x81, 0x1d, 0x4f, 0x05, 0x6c, 0xd9, 0x8c, 0x3b, 0x8c, 0x5b, 0x32, 0xaf, 0x34, 0x94,
+	0xab, 0xae, 0x28, 0x3a, 0xd8, 0x1f, 0x12, 0xe1, 0x60, 0x2f, 0x24, 0x29, 0x0d, 0x19, 0x61, 0x54,
+	0x00, 0x3d, 0x08, 0x88, 0x3f, 0x39, 0xf2, 0xa9, 0x4d, 0x78, 0x87, 0x7c, 0x1d, 0x10, 0x2e, 0x8c,
+	0x9f, 0x0a, 0xb0, 0x91, 0x59, 0xe6, 0x1e, 0x73, 0x39, 0x41, 0x47, 0x50, 0xf4, 0xa2, 0x95, 0xaa,
+	0x56, 0x5f, 0x6a, 0x94, 0x5b, 0xad, 0xe6, 0xb9, 0x3d, 0x36, 0x73, 0x70, 0x4d, 0x69, 0x7e, 0xec,
+	0x0a, 0x7f, 0xd2, 0x2e, 0x3c, 0xff, 0x6d, 0x7b, 0xa1, 0xa3, 0xf2, 0xa0, 0x36, 0x94, 0x92, 0xfd,
+	0x54, 0x17, 0xeb, 0x5a, 0xa3, 0xdc, 0xd2, 0x9b, 0x72, 0xc7, 0xcd, 0x78, 0xc7, 0xcd, 0x47, 0x71,
+	0x44, 0x7b, 0x39, 0x04, 0x3f, 0xfd, 0x7d, 0x5b, 0xeb, 0xa4, 0x30, 0xf4, 0x15, 0x94, 0xe5, 0x9e,
+	0xac, 0x1e, 0x16, 0xb8, 0xba, 0x14, 0x51, 0x7b, 0x7f, 0x4e, 0x6a, 0xf7, 0x22, 0xe4, 0x21, 0x16,
+	0x78, 0x9a, 0x1e, 0x38, 0xc9, 0x32, 0x3a, 0x06, 0xe8, 0x51, 0xee, 0x11, 0x9f, 0x53, 0xe6, 0x56,
+	0x0b, 0x97, 0xca, 0x7e, 0x98, 0x00, 0x33, 0xd9, 0xd3, 0x7c, 0xfa, 0x1d, 0x28, 0x4f, 0x55, 0x07,
+	0xad, 0xc3, 0xd2, 0x90, 0x4c, 0xaa, 0x5a, 0x5d, 0x6b, 0x94, 0x3a, 0xe1, 0x4f, 0x54, 0x81, 0x2b,
+	0x63, 0x3c, 0x0a, 0x48, 0x54, 0x9d, 0x52, 0x47, 0x1a, 0x1f, 0x2c, 0xde, 0xd6, 0xf4, 0x63, 0x58,
+	0x9b, 0x61, 0x9f, 0x03, 0xbf, 0x39, 0x0d, 0x2f, 0xb7, 0xae, 0xe7, 0x10, 0x4f, 0x93, 0x4c, 0x67,
+	0xbf, 0x0b, 0x6b, 0x33, 0xec, 0x2f, 0x43, 0xce, 0x78, 0x0c, 0x90, 0xe6, 0x45, 0x9b, 0x50, 0x1c,
+	0xb3, 0x51, 0xe0, 0x10, 0x05, 0x56, 0x16, 0xda, 0x82, 0xe5, 0x2e, 0xe1, 0xc2, 0xea, 0xd2, 0x9e,
+	0x4a, 0x71, 0x35, 0xb4, 0xdb, 0xb4, 0x97, 0xb8, 0x30, 0x1f, 0x56, 0x97, 0x52, 0xd7, 0x47, 0x7c,
+	0x68, 0x98, 0xb0, 0xf1, 0x50, 0xf8, 0x04, 0x3b, 0x19, 0xd5, 0xa2, 0x2a, 0x5c, 0x15, 0xd4, 0x1e,
+	0x12, 0x5f, 0xca, 0xb3, 0xd4, 0x89, 0x4d, 0xe3, 0x3d, 0xa8, 0xa6, 0xdd, 0x39, 0x24, 0x02, 0xd3,
+	0xd1, 0x1c, 0xa8, 0xef, 0x17, 0x61, 0x2b, 0x07, 0xa6, 0xce, 0xc2, 0x97, 0x70, 0x55, 0x8a, 0x24,
+	0x3e, 0x0c, 0x77, 0x5e, 0xaa, 0x89, 0x19, 0xb8, 0x2a, 0x7a, 0xe6, 0x4c, 0xc4, 0xf9, 0xfe, 0x8b,
+	0x43, 0xa1, 0x63, 0x58, 0x99, 0xfe, 0x44, 0x4e, 0xef, 0x3e, 0xcc, 0x2a, 0xe3, 0xc6, 0x85, 0xca,
+	0xc8, 0xf0, 0x9f, 0x6a, 0xf1, 0x0f, 0x1a, 0xa0, 0xf3, 0x11, 0xe8, 0x53, 0x28, 0x79, 0x3e, 0x1b,
+	0xd3, 0x5e, 0x5c, 0xd2, 0x72, 0x6b, 0x37, 0x27, 0xf7, 0x91, 0x8a, 0x99, 0xc6, 0xaa, 0x42, 0xa4,
+	0x78, 0xb4, 0x03, 0x2b, 0x0e, 0xe9, 0x51, 0xec, 0x5a, 0xd1, 0xc0, 0x50, 0x22, 0x29, 0xcb, 0xb5,
+	0x08, 0x8a, 0xf6, 0x60, 0x1d, 0x0f, 0x06, 0x3e, 0x19, 0x60, 0x41, 0x7a, 0x2a, 0x4c, 0x0a, 0x66,
+	0x2d, 0x5d, 0x8f, 0x42, 0x8d, 0xbf, 0x35, 0xa8, 0xe4, 0x7d, 0x17, 0xe9, 0xb0, 0x1c, 0x7f, 0x53,
+	0x95, 0x28, 0xb1, 0x51, 0x03, 0xd6, 0x59, 0xbf, 0x6f, 0xd9, 0x27, 0x98, 0xba, 0x96, 0xd4, 0x86,
+	0xa2, 0xb1, 0xca, 0xfa, 0xfd, 0x83, 0x70, 0xf9, 0x51, 0xb4, 0x8a, 0xae, 0x41, 0xc9, 0xc7, 0x4f,
+	0x32, 0x14, 0x96, 0x7d, 0xfc, 0x44, 0xd2, 0xdc, 0x85, 0x35, 0x9b, 0xb9, 0x63, 0xe2, 0xa7, 0x2c,
+	0x0b, 0x32, 0x4b, 0xb2, 0x2c, 0x03, 0x33, 0xdd, 0xbf, 0xf2, 0xef, 0x46, 0xe2, 0x26, 0x14, 0xb9,
+	0xc0, 0x22, 0xe0, 0xd5, 0xa2, 0x3c, 0x6f, 0xd2, 0x32, 0x5e, 0x83, 0xff, 0x2b, 0x49, 0xaa, 0x02,
+	0xc7, 0x13, 0xff, 0x17, 0x0d, 0x36, 0x67, 0x3d, 0x4a, 0xe8, 0xc7, 0xe7, 0xfb, 0x79, 0xfb, 0x62,
+	0xa9, 0xcf, 0xa0, 0x93, 0x36, 0x67, 0x94, 0x9e, 0x26, 0xd4, 0x2d, 0x58, 0xcd, 0x86, 0xe4, 0x28,
+	0xf5, 0x56, 0x56, 0xa9, 0x3b, 0x2f, 0x51, 0xd3, 0xc3, 0x68, 0x9f, 0xd3, 0x2a, 0x3d, 0x81, 0xd5,
+	0xac, 0x13, 0x7d, 0x0e, 0x6b, 0x36, 0xf5, 0xed, 0x80, 0x0a, 0xab, 0xeb, 0x13, 0x3c, 0x54, 0x3d,
+	0xcf, 0x97, 0xe9, 0x81, 0x8c, 0x6c, 0xcb, 0x40, 0x99, 0x41, 0xed, 0x62, 0xd5, 0xce, 0xf8, 0x8c,
+	0xbf, 0x34, 0xa8, 0xe4, 0x85, 0x87, 0x53, 0x32, 0xac, 0x7f, 0x3c, 0xfc, 0xa4, 0x11, 0xf6, 0xc8,
+	0x27, 0x98, 0x33, 0x57, 0xa9, 0x49, 0x59, 0x68, 0x1f, 0x2a, 0x76, 0x58, 0x3a, 0x3b, 0x10, 0x74,
+	0x4c, 0xac, 0x3e, 0xa6, 0xa3, 0xc0, 0x27, 0x3c, 0x12, 0x54, 0xa1, 0xb3, 0x31, 0xe5, 0xfb, 0x44,
+	0xb9, 0x66, 0x21, 0x2c, 0x10, 0x23, 0x1a, 0x76, 0xab, 0x70, 0x0e, 0x72, 0x5f, 0xb9, 0xd0, 0x01,
+	0x00, 0xf3, 0x88, 0x6b, 0x05, 0xae, 0xa0, 0xa3, 0xcb, 0xc9, 0x2c, 0xc4, 0x7d, 0x16, 0xc2, 0x12,
+	0x39, 0xc9, 0x29, 0x70, 0x0f, 0x7b, 0xb1, 0x9c, 0xbe, 0x80, 0xcd, 0x59, 0x87, 0x52, 0xd3, 0x5d,
+	0x50, 0x77, 0xab, 0xe5, 0x60, 0x4f, 0xd5, 0xbd, 0x16, 0xd7, 0x3d, 0x79, 0xa7, 0xa4, 0xc3, 0x27,
+	0xc4, 0x96, 0x9c, 0xf8, 0x67, 0xeb, 0xc7, 0x2b, 0x50, 0xbc, 0x1f, 0xbd, 0xb2, 0xd0, 0x04, 0x8a,
+	0x72, 0xfe, 0xa3, 0x1b, 0xaf, 0xba, 0x8d, 0x23, 0x52, 0xfa, 0x9b, 0xf3, 0x5d, 0xda, 0x46, 0xfd,
+	0x9b, 0x9f, 0xff, 0x7c, 0xb6, 0xa8, 0xa3, 0xaa, 0x29, 0xe3, 0xd5, 0xb3, 0x2e, 0x7c, 0x3b, 0xa9,
+	0x57, 0xcb, 0x77, 0x1a, 0xac, 0x4c, 0xdf, 0x40, 0x28, 0x2f, 0x75, 0xce, 0x15, 0x35, 0x37, 0x85,
+	0xdd, 0x88, 0xc2, 0x0e, 0xda, 0xbe, 0x88, 0x82, 0xc9, 0xa3, 0xec, 0xef, 0x6a, 0xe8, 0x99, 0x06,
+	0x2b, 0x99, 0x59, 0xf6, 0xd6, 0x7c, 0xf7, 0x90, 0x24, 0xf4, 0xf6, 0x65, 0x2e, 0x2d, 0xa3, 0x11,
+	0xd1, 0x32, 0x50, 0xfd, 0x42, 0x5a, 0x3d, 0x45, 0xe2, 0x5b, 0x0d, 0x4a, 0xc9, 0xb9, 0x46, 0x8d,
+	0x39, 0xe6, 0x85, 0xe4, 0xb3, 0x37, 0xf7, 0x64, 0x31, 0xde, 0x88, 0xc8, 0x5c, 0x47, 0xd7, 0xf2,
+	0xc8, 0xc4, 0x5f, 0x0e, 0x79, 0x24, 0x42, 0xba, 0x98, 0xc7, 0xac, 0x80, 0xf5, 0xbd, 0x39, 0x22,
+	0x5f, 0xcd, 0x23, 0xd1, 0x73, 0xfb, 0xc1, 0xf3, 0xd3, 0x9a, 0xf6, 0xe2, 0xb4, 0xa6, 0xfd, 0x71,
+	0x5a, 0xd3, 0x9e, 0x9e, 0xd5, 0x16, 0x5e, 0x9c, 0xd5, 0x16, 0x7e, 0x3d, 0xab, 0x2d, 0x3c, 0xbe,
+	0x35, 0xa0, 0xe2, 0x24, 0xe8, 0x36, 0x6d, 0xe6, 0x98, 0x7c, 0x48, 0xbd, 0x77, 0x1c, 0x32, 0x36,
+	0x67, 0xfe, 0xb5, 0x08, 0xff, 0x12, 0x9f, 0xc7, 0x99, 0xc5, 0xc4, 0x23, 0xbc, 0x5b, 0x8c, 0x4e,
+	0xe9, 0xcd, 0x7f, 0x06, 0x00, 0x83, 0x52, 0xc8, 0x97, 0x88, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OracleClient is the client API for Oracle service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OracleClient interface {
+	// Prices defines a method for fetching the latest prices.
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A response
+	// is pushed every time the oracle completes a price update.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+	// PriceDetails defines a method for fetching the breakdown of the latest
+	// index price calculation of each market, i.e. the contribution of each
+	// provider and the resulting index price.
+	PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error)
+	// Providers defines a method for fetching the status of each price
+	// provider, i.e. the state of the provider's circuit breaker.
+	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
+	// MarketMap defines a method for fetching the latest market map
+	// configuration.
+	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
+}
+
+type oracleClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewOracleClient(cc grpc1.ClientConn) OracleClient {
+	return &oracleClient{cc}
+}
+
+func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
+	out := new(QueryPricesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Prices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Oracle_serviceDesc.Streams[0], "/slinky.service.v1.Oracle/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oracleClient) PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error) {
+	out := new(QueryPriceDetailsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/PriceDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
+	out := new(QueryProvidersResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Providers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error) {
+	out := new(QueryMarketMapResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/MarketMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OracleServer is the server API for Oracle service.
+type OracleServer interface {
+	// Prices defines a method for fetching the latest prices.
+	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A response
+	// is pushed every time the oracle completes a price update.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
+	// PriceDetails defines a method for fetching the breakdown of the latest
+	// index price calculation of each market, i.e. the contribution of each
+	// provider and the resulting index price.
+	PriceDetails(context.Context, *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error)
+	// Providers defines a method for fetching the status of each price
+	// provider, i.e. the state of the provider's circuit breaker.
+	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
+	// MarketMap defines a method for fetching the latest market map
+	// configuration.
+	MarketMap(context.Context, *QueryMarketMapRequest) (*QueryMarketMapResponse, error)
+}
+
+// UnimplementedOracleServer can be embedded to have forward compatible implementations.
+type UnimplementedOracleServer struct {
+}
+
+func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+}
+func (*UnimplementedOracleServer) StreamPrices(req *StreamPricesRequest, srv Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (*UnimplementedOracleServer) PriceDetails(ctx context.Context, req *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDetails not implemented")
+}
+func (*UnimplementedOracleServer) Providers(ctx context.Context, req *QueryProvidersRequest) (*QueryProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
+func (*UnimplementedOracleServer) MarketMap(ctx context.Context, req *QueryMarketMapRequest) (*QueryMarketMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+}
+
+func RegisterOracleServer(s grpc1.Server, srv OracleServer) {
+	s.RegisterService(&_Oracle_serviceDesc, srv)
+}
+
+func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Prices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/Prices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Prices(ctx, req.(*QueryPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_PriceDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).PriceDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/PriceDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).PriceDetails(ctx, req.(*QueryPriceDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/Providers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Providers(ctx, req.(*QueryProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).MarketMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/MarketMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).MarketMap(ctx, req.(*QueryMarketMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Oracle_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "slinky.service.v1.Oracle",
+	HandlerType: (*OracleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Prices",
+			Handler:    _Oracle_Prices_Handler,
+		},
+		{
+			MethodName: "PriceDetails",
+			Handler:    _Oracle_PriceDetails_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Oracle_Providers_Handler,
+		},
+		{
+			MethodName: "MarketMap",
+			Handler:    _Oracle_MarketMap_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "slinky/service/v1/oracle.proto",
+}
+
+func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dispersion) > 0 {
+		for k := range m.Dispersion {
+			v := m.Dispersion[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MarketData) > 0 {
+		for k := range m.MarketData {
+			v := m.MarketData[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Prices) > 0 {
+		for k := range m.Prices {
+			v := m.Prices[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BestAsk) > 0 {
+		i -= len(m.BestAsk)
+		copy(dAtA[i:], m.BestAsk)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BestAsk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BestBid) > 0 {
+		i -= len(m.BestBid)
+		copy(dAtA[i:], m.BestBid)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BestBid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Markets) > 0 {
+		for k := range m.Markets {
+			v := m.Markets[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketPriceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPriceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPriceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatedPrice) > 0 {
+		i -= len(m.AggregatedPrice)
+		copy(dAtA[i:], m.AggregatedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregatedPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MedianPrice) > 0 {
+		i -= len(m.MedianPrice)
+		copy(dAtA[i:], m.MedianPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.MedianPrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPriceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPriceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPriceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ConvertedPrice) > 0 {
+		i -= len(m.ConvertedPrice)
+		copy(dAtA[i:], m.ConvertedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConvertedPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RawPrice) > 0 {
+		i -= len(m.RawPrice)
+		copy(dAtA[i:], m.RawPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RawPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for k := range m.Providers {
+			v := m.Providers[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenUntil):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintOracle(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if m.ConsecutiveOutliers != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ConsecutiveOutliers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketMap != nil {
+		{
+			size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for k, v := range m.Prices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.MarketData) > 0 {
+		for k, v := range m.MarketData {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Dispersion) > 0 {
+		for k, v := range m.Dispersion {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MarketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.BestBid)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.BestAsk)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for k, v := range m.Markets {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *MarketPriceDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.MedianPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.AggregatedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderPriceDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.RawPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ConvertedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for k, v := range m.Providers {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ProviderStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovOracle(uint64(m.ConsecutiveFailures))
+	}
+	if m.ConsecutiveOutliers != 0 {
+		n += 1 + sovOracle(uint64(m.ConsecutiveOutliers))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenUntil)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *QueryMarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketMap != nil {
+		l = m.MarketMap.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Prices[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketData == nil {
+				m.MarketData = make(map[string]MarketData)
+			}
+			var mapkey string
+			mapvalue := &MarketData{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MarketData{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MarketData[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispersion == nil {
+				m.Dispersion = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Dispersion[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestBid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestAsk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Markets == nil {
+				m.Markets = make(map[string]MarketPriceDetails)
+			}
+			var mapkey string
+			mapvalue := &MarketPriceDetails{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MarketPriceDetails{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Markets[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketPriceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPriceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPriceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderPriceDetails{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MedianPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPriceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPriceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPriceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryMarketMapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketMap != nil {
-		l = m.MarketMap.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -597,10 +3528,10 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -624,7 +3555,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -647,15 +3578,15 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -682,11 +3613,11 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prices == nil {
-				m.Prices = make(map[string]string)
+			if m.Providers == nil {
+				m.Providers = make(map[string]ProviderStatus)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &ProviderStatus{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -735,7 +3666,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowOracle
@@ -745,24 +3676,26 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthOracle
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthOracle
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &ProviderStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipOracle(dAtA[iNdEx:])
@@ -778,11 +3711,246 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Prices[mapkey] = mapvalue
+			m.Providers[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveOutliers", wireType)
+			}
+			m.ConsecutiveOutliers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveOutliers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -809,7 +3977,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -345,6 +345,7 @@ func NewSimApp(
 	aggregatorFn := voteweighted.MedianFromContext(
 		app.Logger(),
 		app.StakingKeeper,
+		app.MarketMapKeeper,
 		voteweighted.DefaultPowerThreshold,
 	)
 
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -68,6 +69,27 @@ func (k *Keeper) GetMarket(ctx sdk.Context, tickerStr string) (types.Market, err
 	return k.markets.Get(ctx, types.TickerString(tickerStr))
 }
 
+// GetPowerThreshold returns the power threshold of a market by its currency pair string ID. False
+// is returned if the market does not configure a power threshold, in which case the caller's
+// default threshold applies.
+func (k *Keeper) GetPowerThreshold(ctx sdk.Context, tickerStr string) (math.LegacyDec, bool, error) {
+	market, err := k.GetMarket(ctx, tickerStr)
+	if err != nil {
+		return math.LegacyDec{}, false, err
+	}
+
+	if market.Ticker.PowerThreshold == "" {
+		return math.LegacyDec{}, false, nil
+	}
+
+	threshold, err := math.LegacyNewDecFromStr(market.Ticker.PowerThreshold)
+	if err != nil {
+		return math.LegacyDec{}, false, err
+	}
+
+	return threshold, true, nil
+}
+
 // GetAllMarkets returns the set of Market objects currently stored in state
 // as a map[TickerString] -> Markets.
 func (k *Keeper) GetAllMarkets(ctx sdk.Context) (map[string]types.Market, error) {
@@ -82,6 +82,35 @@ func (q queryServerImpl) LastUpdated(goCtx context.Context, req *types.LastUpdat
 	return &types.LastUpdatedResponse{LastUpdated: lastUpdated}, nil
 }
 
+// PowerThreshold returns the power threshold configured by the requested market stored in the
+// x/marketmap module. If the market does not configure one, no threshold is returned and the
+// response is marked as default, as the applied default is determined by the application.
+func (q queryServerImpl) PowerThreshold(goCtx context.Context, req *types.PowerThresholdRequest) (*types.PowerThresholdResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if err := req.CurrencyPair.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	threshold, found, err := q.k.GetPowerThreshold(ctx, req.CurrencyPair.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &types.PowerThresholdResponse{IsDefault: true}, nil
+	}
+
+	return &types.PowerThresholdResponse{
+		PowerThreshold: threshold.String(),
+	}, nil
+}
+
 // Params returns the parameters stored in the x/marketmap module.
 func (q queryServerImpl) Params(goCtx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
 	if req == nil {
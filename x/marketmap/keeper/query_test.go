@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 	"github.com/skip-mev/slinky/x/marketmap/keeper"
 	"github.com/skip-mev/slinky/x/marketmap/types"
@@ -101,6 +103,85 @@ func (s *KeeperTestSuite) TestMarket() {
 	})
 }
 
+func (s *KeeperTestSuite) TestGetPowerThreshold() {
+	s.Run("errors for market that does not exist", func() {
+		_, _, err := s.keeper.GetPowerThreshold(s.ctx, btcusdt.Ticker.String())
+		s.Require().Error(err)
+	})
+
+	s.Run("not found for market with default power threshold", func() {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		_, found, err := s.keeper.GetPowerThreshold(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("found for market with configured power threshold", func() {
+		market := ethusdt
+		market.Ticker.PowerThreshold = "0.5"
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, market))
+
+		threshold, found, err := s.keeper.GetPowerThreshold(s.ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(sdkmath.LegacyNewDecWithPrec(5, 1), threshold)
+	})
+}
+
+func (s *KeeperTestSuite) TestPowerThreshold() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Run("invalid for nil request", func() {
+		_, err := qs.PowerThreshold(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("run query with invalid currency pair", func() {
+		_, err := qs.PowerThreshold(s.ctx, &types.PowerThresholdRequest{})
+		s.Require().Error(err)
+	})
+
+	s.Run("run query with no state", func() {
+		_, err := qs.PowerThreshold(s.ctx, &types.PowerThresholdRequest{
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("run query for market with default power threshold", func() {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		resp, err := qs.PowerThreshold(s.ctx, &types.PowerThresholdRequest{
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+		})
+		s.Require().NoError(err)
+
+		expected := &types.PowerThresholdResponse{
+			IsDefault: true,
+		}
+
+		s.Require().Equal(expected, resp)
+	})
+
+	s.Run("run query for market with configured power threshold", func() {
+		market := ethusdt
+		market.Ticker.PowerThreshold = "0.5"
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, market))
+
+		resp, err := qs.PowerThreshold(s.ctx, &types.PowerThresholdRequest{
+			CurrencyPair: market.Ticker.CurrencyPair,
+		})
+		s.Require().NoError(err)
+
+		expected := &types.PowerThresholdResponse{
+			PowerThreshold: "0.500000000000000000",
+		}
+
+		s.Require().Equal(expected, resp)
+	})
+}
+
 func (s *KeeperTestSuite) TestParams() {
 	params := types.DefaultParams()
 	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
@@ -92,6 +92,11 @@ type Ticker struct {
 	// MinProviderCount is the minimum number of providers required to consider
 	// the ticker valid.
 	MinProviderCount uint64 `protobuf:"varint,3,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
+	// PowerThreshold is the fraction of the total voting power, as a decimal in
+	// (0, 1], that must submit a price for the ticker for the stake-weighted
+	// median price to be written to state. If empty, the default power
+	// threshold is used.
+	PowerThreshold string `protobuf:"bytes,4,opt,name=power_threshold,json=powerThreshold,proto3" json:"power_threshold,omitempty"`
 	// Enabled is the flag that denotes if the Ticker is enabled for price
 	// fetching by an oracle.
 	Enabled bool `protobuf:"varint,14,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -153,6 +158,13 @@ func (m *Ticker) GetMinProviderCount() uint64 {
 	return 0
 }
 
+func (m *Ticker) GetPowerThreshold() string {
+	if m != nil {
+		return m.PowerThreshold
+	}
+	return ""
+}
+
 func (m *Ticker) GetEnabled() bool {
 	if m != nil {
 		return m.Enabled
@@ -313,42 +325,43 @@ func init() {
 func init() { proto.RegisterFile("slinky/marketmap/v1/market.proto", fileDescriptor_fefe265720fc8a78) }
 
 var fileDescriptor_fefe265720fc8a78 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x8a, 0x13, 0x4d,
-	0x14, 0xed, 0x4a, 0xf2, 0x65, 0x92, 0x9a, 0x4c, 0x92, 0xaf, 0x14, 0x69, 0x22, 0xf6, 0x34, 0xc9,
-	0xa6, 0xc1, 0xb1, 0x43, 0xc6, 0x8d, 0xce, 0x32, 0x41, 0xf1, 0x87, 0xd1, 0xa1, 0x1d, 0x10, 0xdc,
-	0x34, 0x95, 0x4e, 0x25, 0x53, 0x24, 0x5d, 0xd5, 0x54, 0x57, 0x1a, 0xe3, 0xca, 0x47, 0x70, 0xe9,
-	0x52, 0xf0, 0x31, 0x7c, 0x81, 0x59, 0xce, 0x4a, 0x5c, 0x88, 0x48, 0x82, 0xef, 0x21, 0x5d, 0x5d,
-	0xc9, 0x74, 0x20, 0xc2, 0xec, 0xee, 0xbd, 0x75, 0xea, 0xdc, 0x3a, 0xa7, 0xee, 0x85, 0x76, 0x3c,
-	0xa3, 0x6c, 0xba, 0xe8, 0x86, 0x58, 0x4c, 0x89, 0x0c, 0x71, 0xd4, 0x4d, 0x7a, 0x3a, 0x71, 0x23,
-	0xc1, 0x25, 0x47, 0xb7, 0x32, 0x84, 0xbb, 0x41, 0xb8, 0x49, 0xaf, 0x75, 0x7b, 0xc2, 0x27, 0x5c,
-	0x9d, 0x77, 0xd3, 0x28, 0x83, 0xb6, 0x3a, 0x9a, 0x4c, 0x2e, 0x22, 0x12, 0xa7, 0x44, 0xc1, 0x5c,
-	0x08, 0xc2, 0x82, 0x85, 0x1f, 0x61, 0x2a, 0x32, 0x50, 0xfb, 0x2b, 0x80, 0xe5, 0x53, 0xc5, 0x85,
-	0x1e, 0xc3, 0xb2, 0xa4, 0xc1, 0x94, 0x08, 0x13, 0xd8, 0xc0, 0xd9, 0x3f, 0xbe, 0xeb, 0xee, 0xe8,
-	0xe5, 0x9e, 0x2b, 0x48, 0xbf, 0x74, 0xf9, 0xeb, 0xd0, 0xf0, 0xf4, 0x05, 0x74, 0x0e, 0x9b, 0x91,
-	0xe0, 0x09, 0x1d, 0x11, 0xe1, 0x07, 0x9c, 0x8d, 0xe9, 0x24, 0x36, 0x0b, 0x76, 0xd1, 0xd9, 0x3f,
-	0xee, 0xec, 0x24, 0x39, 0xd3, 0xe0, 0x81, 0xc2, 0x6a, 0xb2, 0x46, 0xb4, 0x55, 0x8d, 0x4f, 0x2a,
-	0x9f, 0xbf, 0x1c, 0x1a, 0x1f, 0x7f, 0xda, 0x46, 0xfb, 0x0f, 0x80, 0xe5, 0xac, 0x31, 0x7a, 0x06,
-	0x0f, 0xb6, 0x74, 0xe8, 0xc7, 0xde, 0x5b, 0xf7, 0x51, 0x6a, 0xd3, 0x1e, 0x03, 0x8d, 0x3a, 0xc3,
-	0x74, 0xfd, 0xdc, 0x5a, 0x90, 0xab, 0xa1, 0x16, 0xac, 0x8c, 0x48, 0x40, 0x43, 0x3c, 0x4b, 0x1f,
-	0x0b, 0x9c, 0x92, 0xb7, 0xc9, 0xd1, 0x11, 0x44, 0x21, 0x65, 0x7e, 0x4e, 0xd4, 0x9c, 0x49, 0xb3,
-	0xa8, 0x50, 0xcd, 0x90, 0xb2, 0x6b, 0x01, 0x73, 0x26, 0x91, 0x09, 0xf7, 0x08, 0xc3, 0xc3, 0x19,
-	0x19, 0x99, 0x75, 0x1b, 0x38, 0x15, 0x6f, 0x9d, 0xa2, 0x0e, 0x3c, 0x08, 0x89, 0xc4, 0x23, 0x2c,
-	0xb1, 0xff, 0xe2, 0xcd, 0xeb, 0x57, 0x66, 0xc3, 0x06, 0x4e, 0xd5, 0xab, 0xad, 0x8b, 0x69, 0x2d,
-	0xa7, 0xf3, 0x3b, 0x80, 0xf5, 0x6d, 0x6f, 0x10, 0x82, 0x25, 0x86, 0x43, 0xa2, 0x64, 0x56, 0x3d,
-	0x15, 0x23, 0x07, 0x36, 0xf9, 0x78, 0xec, 0x07, 0x17, 0x98, 0x32, 0x5f, 0xff, 0x59, 0x41, 0x9d,
-	0xd7, 0xf9, 0x78, 0x3c, 0x48, 0xcb, 0xda, 0xad, 0xe7, 0xf0, 0x7f, 0xc6, 0x45, 0x88, 0x67, 0xf4,
-	0x03, 0xf1, 0x87, 0xda, 0xb1, 0xe2, 0x0d, 0x1c, 0xf3, 0x1a, 0x9b, 0x7b, 0xfd, 0xcc, 0xae, 0x3b,
-	0xb0, 0x4c, 0x59, 0x42, 0x84, 0x34, 0x4b, 0x4a, 0xa3, 0xce, 0x6e, 0x24, 0xb1, 0xfd, 0x0d, 0xc0,
-	0x6a, 0x36, 0x66, 0xa7, 0x38, 0x42, 0x2f, 0xe1, 0x5e, 0x36, 0x0e, 0xb1, 0x09, 0xd4, 0x94, 0xdc,
-	0xdf, 0x39, 0x25, 0x9b, 0x0b, 0x3a, 0x8a, 0x9f, 0x30, 0x29, 0x16, 0xfa, 0x2f, 0xd7, 0x0c, 0xad,
-	0xb7, 0xb0, 0x96, 0x3f, 0x46, 0x4d, 0x58, 0x9c, 0x92, 0x85, 0xf6, 0x2b, 0x0d, 0x51, 0x0f, 0xfe,
-	0x97, 0xe0, 0xd9, 0x9c, 0x28, 0x8f, 0xfe, 0x35, 0xd7, 0x19, 0x87, 0x97, 0x21, 0x4f, 0x0a, 0x8f,
-	0xc0, 0xf5, 0xb7, 0xf4, 0x9f, 0x5e, 0x2e, 0x2d, 0x70, 0xb5, 0xb4, 0xc0, 0xef, 0xa5, 0x05, 0x3e,
-	0xad, 0x2c, 0xe3, 0x6a, 0x65, 0x19, 0x3f, 0x56, 0x96, 0xf1, 0xee, 0x68, 0x42, 0xe5, 0xc5, 0x7c,
-	0xe8, 0x06, 0x3c, 0xec, 0xc6, 0x53, 0x1a, 0x3d, 0x08, 0x49, 0xd2, 0xd5, 0x7b, 0xf7, 0x3e, 0xb7,
-	0xc6, 0xca, 0xe3, 0x61, 0x59, 0xed, 0xdc, 0xc3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x09, 0xf7,
-	0x02, 0xad, 0xe7, 0x03, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x8e, 0xd3, 0x3c,
+	0x14, 0x8d, 0xdb, 0x7e, 0x9d, 0x8e, 0xe7, 0xa7, 0xfd, 0x0c, 0x42, 0x51, 0x11, 0x99, 0x68, 0x66,
+	0x41, 0x24, 0x86, 0x54, 0x1d, 0x36, 0x30, 0xcb, 0x56, 0x20, 0x7e, 0x34, 0x30, 0x0a, 0x95, 0x90,
+	0xd8, 0x44, 0x6e, 0xea, 0xb6, 0x56, 0x13, 0x3b, 0x72, 0xdc, 0x40, 0x59, 0xf1, 0x04, 0x88, 0x25,
+	0x4b, 0x24, 0x1e, 0x83, 0x17, 0x98, 0xe5, 0xac, 0x10, 0x0b, 0x84, 0x50, 0xfb, 0x22, 0x28, 0x8e,
+	0xd3, 0x69, 0xa5, 0x0a, 0xcd, 0xce, 0xf7, 0xf8, 0xf8, 0xdc, 0x7b, 0x8f, 0xef, 0x85, 0x76, 0x12,
+	0x52, 0x36, 0x99, 0xb5, 0x22, 0x2c, 0x26, 0x44, 0x46, 0x38, 0x6e, 0xa5, 0x6d, 0x1d, 0xb8, 0xb1,
+	0xe0, 0x92, 0xa3, 0x1b, 0x39, 0xc3, 0x5d, 0x32, 0xdc, 0xb4, 0xdd, 0xbc, 0x39, 0xe2, 0x23, 0xae,
+	0xee, 0x5b, 0xd9, 0x29, 0xa7, 0x36, 0x8f, 0xb4, 0x98, 0x9c, 0xc5, 0x24, 0xc9, 0x84, 0x82, 0xa9,
+	0x10, 0x84, 0x05, 0x33, 0x3f, 0xc6, 0x54, 0xe4, 0xa4, 0xc3, 0x6f, 0x00, 0x56, 0xcf, 0x94, 0x16,
+	0x7a, 0x04, 0xab, 0x92, 0x06, 0x13, 0x22, 0x4c, 0x60, 0x03, 0x67, 0xe7, 0xe4, 0xb6, 0xbb, 0x21,
+	0x97, 0xdb, 0x53, 0x94, 0x4e, 0xe5, 0xe2, 0xf7, 0x81, 0xe1, 0xe9, 0x07, 0xa8, 0x07, 0x1b, 0xb1,
+	0xe0, 0x29, 0x1d, 0x10, 0xe1, 0x07, 0x9c, 0x0d, 0xe9, 0x28, 0x31, 0x4b, 0x76, 0xd9, 0xd9, 0x39,
+	0x39, 0xda, 0x28, 0x72, 0xae, 0xc9, 0x5d, 0xc5, 0xd5, 0x62, 0xf5, 0x78, 0x0d, 0x4d, 0x4e, 0x6b,
+	0x5f, 0xbe, 0x1e, 0x18, 0x1f, 0x7f, 0xd9, 0xc6, 0xe1, 0xa7, 0x12, 0xac, 0xe6, 0x89, 0xd1, 0x53,
+	0xb8, 0xb7, 0xd6, 0x87, 0x2e, 0xf6, 0x4e, 0x91, 0x47, 0x75, 0x9b, 0xe5, 0xe8, 0x6a, 0xd6, 0x39,
+	0xa6, 0x45, 0xb9, 0xbb, 0xc1, 0x0a, 0x86, 0x9a, 0xb0, 0x36, 0x20, 0x01, 0x8d, 0x70, 0x98, 0x15,
+	0x0b, 0x9c, 0x8a, 0xb7, 0x8c, 0xd1, 0x31, 0x44, 0x11, 0x65, 0xfe, 0x4a, 0x53, 0x53, 0x26, 0xcd,
+	0xb2, 0x62, 0x35, 0x22, 0xca, 0xae, 0x1a, 0x98, 0x32, 0x89, 0xee, 0xc2, 0x7a, 0xcc, 0xdf, 0x11,
+	0xe1, 0xcb, 0xb1, 0x20, 0xc9, 0x98, 0x87, 0x03, 0xb3, 0x62, 0x03, 0x67, 0xdb, 0xdb, 0x57, 0x70,
+	0xaf, 0x40, 0x91, 0x09, 0xb7, 0x08, 0xc3, 0xfd, 0x90, 0x0c, 0xcc, 0x7d, 0x1b, 0x38, 0x35, 0xaf,
+	0x08, 0xd1, 0x11, 0xdc, 0x8b, 0x88, 0xc4, 0x03, 0x2c, 0xb1, 0xff, 0xfc, 0xf5, 0xab, 0x97, 0x66,
+	0x5d, 0x09, 0xec, 0x16, 0x60, 0x86, 0xad, 0x18, 0xf2, 0x03, 0xc0, 0xfd, 0x75, 0x13, 0x11, 0x82,
+	0x15, 0x86, 0x23, 0xa2, 0xfc, 0xd8, 0xf6, 0xd4, 0x19, 0x39, 0xb0, 0xc1, 0x87, 0x43, 0x3f, 0x18,
+	0x63, 0xca, 0x7c, 0xfd, 0xb9, 0xa5, 0xbc, 0x32, 0x3e, 0x1c, 0x76, 0x33, 0x58, 0xdb, 0xfa, 0x0c,
+	0xfe, 0xcf, 0xb8, 0x88, 0x70, 0x48, 0x3f, 0x10, 0xbf, 0xaf, 0xad, 0x2d, 0x5f, 0xc3, 0x5a, 0xaf,
+	0xbe, 0x7c, 0xd7, 0xc9, 0x7d, 0xbd, 0x05, 0xab, 0x94, 0xa5, 0x44, 0x48, 0x65, 0x42, 0xcd, 0xd3,
+	0xd1, 0xb5, 0x5a, 0x3c, 0xfc, 0x0e, 0xe0, 0x76, 0x3e, 0x8f, 0x67, 0x38, 0x46, 0x2f, 0xe0, 0x56,
+	0x3e, 0x37, 0x89, 0x09, 0xd4, 0x38, 0xdd, 0xdb, 0x38, 0x4e, 0xcb, 0x07, 0xfa, 0x94, 0x3c, 0x66,
+	0x52, 0xcc, 0xf4, 0xa7, 0x17, 0x0a, 0xcd, 0x37, 0x70, 0x77, 0xf5, 0x1a, 0x35, 0x60, 0x79, 0x42,
+	0x66, 0xda, 0xaf, 0xec, 0x88, 0xda, 0xf0, 0xbf, 0x14, 0x87, 0x53, 0x62, 0x96, 0xfe, 0xb1, 0x00,
+	0xb9, 0x86, 0x97, 0x33, 0x4f, 0x4b, 0x0f, 0xc1, 0xd5, 0xb7, 0x74, 0x9e, 0x5c, 0xcc, 0x2d, 0x70,
+	0x39, 0xb7, 0xc0, 0x9f, 0xb9, 0x05, 0x3e, 0x2f, 0x2c, 0xe3, 0x72, 0x61, 0x19, 0x3f, 0x17, 0x96,
+	0xf1, 0xf6, 0x78, 0x44, 0xe5, 0x78, 0xda, 0x77, 0x03, 0x1e, 0xb5, 0x92, 0x09, 0x8d, 0xef, 0x47,
+	0x24, 0x6d, 0xe9, 0x05, 0x7d, 0xbf, 0xb2, 0xef, 0xca, 0xe3, 0x7e, 0x55, 0x2d, 0xe7, 0x83, 0xbf,
+	0x03, 0x00, 0xef, 0x11, 0x1e, 0x8d, 0x10, 0x04, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -435,6 +448,13 @@ func (m *Ticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x70
 	}
+	if len(m.PowerThreshold) > 0 {
+		i -= len(m.PowerThreshold)
+		copy(dAtA[i:], m.PowerThreshold)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.PowerThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MinProviderCount != 0 {
 		i = encodeVarintMarket(dAtA, i, uint64(m.MinProviderCount))
 		i--
@@ -613,6 +633,10 @@ func (m *Ticker) Size() (n int) {
 	if m.MinProviderCount != 0 {
 		n += 1 + sovMarket(uint64(m.MinProviderCount))
 	}
+	l = len(m.PowerThreshold)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
 	if m.Enabled {
 		n += 2
 	}
@@ -892,6 +916,38 @@ func (m *Ticker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 14:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
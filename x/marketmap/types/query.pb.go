@@ -229,6 +229,112 @@ func (m *MarketResponse) GetMarket() Market {
 	return Market{}
 }
 
+// PowerThresholdRequest is the query request for the PowerThreshold query.
+// It takes the currency pair of the market as an argument.
+type PowerThresholdRequest struct {
+	// CurrencyPair is the currency pair associated with the market being
+	// requested.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+}
+
+func (m *PowerThresholdRequest) Reset()         { *m = PowerThresholdRequest{} }
+func (m *PowerThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*PowerThresholdRequest) ProtoMessage()    {}
+func (*PowerThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{4}
+}
+func (m *PowerThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerThresholdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PowerThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerThresholdRequest.Merge(m, src)
+}
+func (m *PowerThresholdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerThresholdRequest proto.InternalMessageInfo
+
+func (m *PowerThresholdRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+// PowerThresholdResponse is the query response for the PowerThreshold query.
+type PowerThresholdResponse struct {
+	// PowerThreshold is the fraction of the total voting power that must submit
+	// a price for the market for its price to be written to state. This is
+	// empty if the market does not configure a power threshold.
+	PowerThreshold string `protobuf:"bytes,1,opt,name=power_threshold,json=powerThreshold,proto3" json:"power_threshold,omitempty"`
+	// IsDefault is true if the market does not configure a power threshold, in
+	// which case the default threshold configured by the application applies.
+	IsDefault bool `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+}
+
+func (m *PowerThresholdResponse) Reset()         { *m = PowerThresholdResponse{} }
+func (m *PowerThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*PowerThresholdResponse) ProtoMessage()    {}
+func (*PowerThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{5}
+}
+func (m *PowerThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PowerThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerThresholdResponse.Merge(m, src)
+}
+func (m *PowerThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerThresholdResponse proto.InternalMessageInfo
+
+func (m *PowerThresholdResponse) GetPowerThreshold() string {
+	if m != nil {
+		return m.PowerThreshold
+	}
+	return ""
+}
+
+func (m *PowerThresholdResponse) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
+	}
+	return false
+}
+
 // ParamsRequest is the request type for the Query/Params RPC method.
 type ParamsRequest struct {
 }
@@ -237,7 +343,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b5d6ff68f3c474a0, []int{4}
+	return fileDescriptor_b5d6ff68f3c474a0, []int{6}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +381,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b5d6ff68f3c474a0, []int{5}
+	return fileDescriptor_b5d6ff68f3c474a0, []int{7}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +426,7 @@ func (m *LastUpdatedRequest) Reset()         { *m = LastUpdatedRequest{} }
 func (m *LastUpdatedRequest) String() string { return proto.CompactTextString(m) }
 func (*LastUpdatedRequest) ProtoMessage()    {}
 func (*LastUpdatedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b5d6ff68f3c474a0, []int{6}
+	return fileDescriptor_b5d6ff68f3c474a0, []int{8}
 }
 func (m *LastUpdatedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +465,7 @@ func (m *LastUpdatedResponse) Reset()         { *m = LastUpdatedResponse{} }
 func (m *LastUpdatedResponse) String() string { return proto.CompactTextString(m) }
 func (*LastUpdatedResponse) ProtoMessage()    {}
 func (*LastUpdatedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b5d6ff68f3c474a0, []int{7}
+	return fileDescriptor_b5d6ff68f3c474a0, []int{9}
 }
 func (m *LastUpdatedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,6 +506,8 @@ func init() {
 	proto.RegisterType((*MarketMapResponse)(nil), "slinky.marketmap.v1.MarketMapResponse")
 	proto.RegisterType((*MarketRequest)(nil), "slinky.marketmap.v1.MarketRequest")
 	proto.RegisterType((*MarketResponse)(nil), "slinky.marketmap.v1.MarketResponse")
+	proto.RegisterType((*PowerThresholdRequest)(nil), "slinky.marketmap.v1.PowerThresholdRequest")
+	proto.RegisterType((*PowerThresholdResponse)(nil), "slinky.marketmap.v1.PowerThresholdResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "slinky.marketmap.v1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "slinky.marketmap.v1.ParamsResponse")
 	proto.RegisterType((*LastUpdatedRequest)(nil), "slinky.marketmap.v1.LastUpdatedRequest")
@@ -409,41 +517,47 @@ func init() {
 func init() { proto.RegisterFile("slinky/marketmap/v1/query.proto", fileDescriptor_b5d6ff68f3c474a0) }
 
 var fileDescriptor_b5d6ff68f3c474a0 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x96, 0x10, 0xc8, 0xa4, 0x29, 0xb0, 0xed, 0x21, 0xa4, 0xd4, 0x75, 0x1d, 0x51, 0x82,
-	0x04, 0xb6, 0x5a, 0x2e, 0x70, 0x6d, 0x25, 0x04, 0x2a, 0x95, 0x4a, 0x24, 0x0e, 0x70, 0x89, 0xb6,
-	0xce, 0xca, 0xb5, 0x12, 0x7b, 0xb7, 0xfe, 0x88, 0xc8, 0xb5, 0x47, 0xb8, 0x20, 0x21, 0xf1, 0x9b,
-	0x7a, 0xac, 0xc4, 0x85, 0x13, 0x42, 0x09, 0x3f, 0x04, 0x79, 0x3f, 0x52, 0x87, 0x26, 0xce, 0x2d,
-	0x3b, 0xfb, 0xe6, 0xbd, 0xb7, 0x6f, 0x26, 0x86, 0xed, 0x78, 0xe0, 0x87, 0xfd, 0x91, 0x13, 0x90,
-	0xa8, 0x4f, 0x93, 0x80, 0x70, 0x67, 0xb8, 0xe7, 0x9c, 0xa7, 0x34, 0x1a, 0xd9, 0x3c, 0x62, 0x09,
-	0xc3, 0xeb, 0x12, 0x60, 0x4f, 0x01, 0xf6, 0x70, 0xaf, 0xb9, 0xe1, 0x31, 0x8f, 0x89, 0x7b, 0x27,
-	0xfb, 0x25, 0xa1, 0xcd, 0x47, 0x1e, 0x63, 0xde, 0x80, 0x3a, 0x84, 0xfb, 0x0e, 0x09, 0x43, 0x96,
-	0x90, 0xc4, 0x67, 0x61, 0xac, 0x6e, 0x5b, 0x4a, 0x29, 0x19, 0x71, 0x1a, 0x67, 0x2a, 0x6e, 0x1a,
-	0x45, 0x34, 0x74, 0x47, 0x5d, 0x4e, 0xfc, 0x48, 0x81, 0xcc, 0x79, 0x76, 0xe4, 0xa1, 0x08, 0xc1,
-	0x49, 0x44, 0x02, 0x25, 0x64, 0x61, 0xb8, 0x7f, 0x2c, 0x2e, 0x8f, 0x09, 0xef, 0xd0, 0xf3, 0x94,
-	0xc6, 0x89, 0xf5, 0x03, 0xc1, 0x83, 0x5c, 0x31, 0xe6, 0x2c, 0x8c, 0x29, 0x3e, 0x04, 0x90, 0x34,
-	0xdd, 0x80, 0xf0, 0x06, 0x32, 0x51, 0xbb, 0xb6, 0x6f, 0xd8, 0x73, 0x1e, 0x6c, 0x4f, 0x7b, 0x0f,
-	0xca, 0x97, 0xbf, 0xb7, 0x4b, 0x9d, 0x6a, 0xa0, 0x0b, 0x78, 0x07, 0x56, 0x07, 0x24, 0x4e, 0xba,
-	0x29, 0xef, 0x91, 0x84, 0xf6, 0x1a, 0x2b, 0x26, 0x6a, 0x97, 0x3b, 0xb5, 0xac, 0xf6, 0x41, 0x96,
-	0xf0, 0x43, 0xb8, 0xeb, 0x9e, 0x11, 0x3f, 0xec, 0xfa, 0xbd, 0xc6, 0x2d, 0x13, 0xb5, 0xab, 0x9d,
-	0x3b, 0xe2, 0xfc, 0xb6, 0x67, 0x7d, 0x84, 0xba, 0xe4, 0x56, 0x4e, 0xf1, 0x1b, 0xa8, 0xcf, 0x04,
-	0xa3, 0x6c, 0x6d, 0x69, 0x5b, 0x22, 0xbe, 0xcc, 0xd2, 0xa1, 0x42, 0x9d, 0x10, 0x3f, 0x52, 0xae,
-	0x56, 0xdd, 0x5c, 0xcd, 0x3a, 0x82, 0x35, 0x4d, 0xad, 0xde, 0xfb, 0x0a, 0x2a, 0xd2, 0xb7, 0x22,
-	0xdd, 0x2c, 0x78, 0xab, 0xa2, 0x54, 0x0d, 0xd6, 0x3d, 0xa8, 0x9f, 0x88, 0x90, 0x75, 0xa2, 0x47,
-	0xb0, 0xa6, 0x0b, 0xd7, 0xec, 0x72, 0x0e, 0x85, 0xec, 0xb2, 0x49, 0xb3, 0xcb, 0x06, 0x6b, 0x03,
-	0xf0, 0xbb, 0xeb, 0xbc, 0xb4, 0xc4, 0x4b, 0x58, 0x9f, 0xa9, 0x2a, 0x9d, 0xff, 0x03, 0x47, 0x37,
-	0x02, 0xdf, 0xff, 0x52, 0x86, 0xdb, 0xef, 0xb3, 0x25, 0xc6, 0x17, 0x08, 0xaa, 0xd3, 0xe1, 0xe1,
-	0xc7, 0xc5, 0xc3, 0x55, 0xc2, 0xcd, 0xdd, 0x65, 0x30, 0xe9, 0xc4, 0xda, 0xbd, 0xf8, 0xf9, 0xf7,
-	0xfb, 0x8a, 0x89, 0x0d, 0x67, 0xf1, 0xda, 0x06, 0x84, 0xe3, 0x21, 0x54, 0x64, 0x33, 0xb6, 0x0a,
-	0x98, 0xb5, 0x7a, 0xab, 0x10, 0xa3, 0xa4, 0x5b, 0x42, 0x7a, 0x0b, 0x6f, 0x16, 0x48, 0xe3, 0xaf,
-	0x08, 0x6a, 0xb9, 0x04, 0xf1, 0x93, 0xb9, 0xcc, 0x37, 0x93, 0x6f, 0xb6, 0x97, 0x03, 0x95, 0x8f,
-	0xa7, 0xc2, 0x47, 0x0b, 0xef, 0xcc, 0xf5, 0x91, 0x9f, 0x53, 0x96, 0x82, 0x1c, 0xfe, 0x82, 0x14,
-	0x66, 0xf6, 0x6b, 0x41, 0x0a, 0xb3, 0x2b, 0xb7, 0x24, 0x05, 0xb9, 0x5c, 0x07, 0xaf, 0x2f, 0xc7,
-	0x06, 0xba, 0x1a, 0x1b, 0xe8, 0xcf, 0xd8, 0x40, 0xdf, 0x26, 0x46, 0xe9, 0x6a, 0x62, 0x94, 0x7e,
-	0x4d, 0x8c, 0xd2, 0xa7, 0x67, 0x9e, 0x9f, 0x9c, 0xa5, 0xa7, 0xb6, 0xcb, 0x02, 0x27, 0xee, 0xfb,
-	0xfc, 0x79, 0x40, 0x87, 0x9a, 0xe9, 0x73, 0x8e, 0x4b, 0xfc, 0xe7, 0x4e, 0x2b, 0xe2, 0xf3, 0xf2,
-	0xe2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x7b, 0x92, 0x92, 0x33, 0x05, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0x41, 0x40, 0xf6, 0x01, 0x8b, 0x0e, 0x68, 0xb0, 0x48, 0x59, 0xba, 0x0a, 0xab, 0x62,
+	0x1b, 0xf0, 0xa2, 0x57, 0x30, 0x46, 0x83, 0x24, 0xd8, 0xe8, 0x41, 0x2f, 0x75, 0xd8, 0x8e, 0xbb,
+	0x0d, 0xdb, 0xce, 0xd0, 0x1f, 0xab, 0x7b, 0xe5, 0xea, 0xc5, 0xc4, 0x44, 0xff, 0x01, 0xff, 0x18,
+	0x8e, 0x24, 0x5e, 0x3c, 0x19, 0x03, 0xfe, 0x21, 0xa6, 0x33, 0x53, 0xd8, 0x5d, 0x4a, 0xe1, 0xe0,
+	0xad, 0x7d, 0xef, 0x7b, 0xef, 0xfb, 0xe6, 0xbd, 0x6f, 0x06, 0x16, 0xa2, 0xb6, 0x17, 0xec, 0x76,
+	0x2d, 0x9f, 0x84, 0xbb, 0x34, 0xf6, 0x09, 0xb7, 0x3a, 0xab, 0xd6, 0x5e, 0x42, 0xc3, 0xae, 0xc9,
+	0x43, 0x16, 0x33, 0x3c, 0x2d, 0x01, 0xe6, 0x09, 0xc0, 0xec, 0xac, 0x6a, 0x33, 0x4d, 0xd6, 0x64,
+	0x22, 0x6f, 0xa5, 0x5f, 0x12, 0xaa, 0xdd, 0x6e, 0x32, 0xd6, 0x6c, 0x53, 0x8b, 0x70, 0xcf, 0x22,
+	0x41, 0xc0, 0x62, 0x12, 0x7b, 0x2c, 0x88, 0x54, 0xb6, 0xa6, 0x98, 0xe2, 0x2e, 0xa7, 0x51, 0xca,
+	0xd2, 0x48, 0xc2, 0x90, 0x06, 0x8d, 0xae, 0xc3, 0x89, 0x17, 0x2a, 0x50, 0x35, 0x4f, 0x8e, 0xfc,
+	0x29, 0x42, 0x70, 0x12, 0x12, 0x5f, 0x11, 0x19, 0x18, 0xae, 0x6d, 0x89, 0xe4, 0x16, 0xe1, 0x36,
+	0xdd, 0x4b, 0x68, 0x14, 0x1b, 0xdf, 0x10, 0x5c, 0xef, 0x09, 0x46, 0x9c, 0x05, 0x11, 0xc5, 0x1b,
+	0x00, 0xb2, 0x8d, 0xe3, 0x13, 0x3e, 0x8b, 0xaa, 0xa8, 0x3e, 0xbe, 0xa6, 0x9b, 0x39, 0x07, 0x36,
+	0x4f, 0x6a, 0xd7, 0x87, 0x0f, 0x7e, 0x2f, 0x94, 0xec, 0xb2, 0x9f, 0x05, 0xf0, 0x22, 0x4c, 0xb4,
+	0x49, 0x14, 0x3b, 0x09, 0x77, 0x49, 0x4c, 0xdd, 0xd9, 0xa1, 0x2a, 0xaa, 0x0f, 0xdb, 0xe3, 0x69,
+	0xec, 0x8d, 0x0c, 0xe1, 0x5b, 0x30, 0xd6, 0x68, 0x11, 0x2f, 0x70, 0x3c, 0x77, 0xf6, 0x4a, 0x15,
+	0xd5, 0xcb, 0xf6, 0x55, 0xf1, 0xff, 0xc2, 0x35, 0xde, 0xc2, 0xa4, 0xec, 0xad, 0x94, 0xe2, 0xe7,
+	0x30, 0xd9, 0x37, 0x18, 0x25, 0x6b, 0x3e, 0x93, 0x25, 0xc6, 0x97, 0x4a, 0xda, 0x50, 0xa8, 0x6d,
+	0xe2, 0x85, 0x4a, 0xd5, 0x44, 0xa3, 0x27, 0x66, 0x6c, 0x42, 0x25, 0x6b, 0xad, 0xce, 0xfb, 0x04,
+	0x46, 0xa5, 0x6e, 0xd5, 0x74, 0xae, 0xe0, 0xac, 0xaa, 0xa5, 0x2a, 0x30, 0x08, 0xdc, 0xd8, 0x66,
+	0x1f, 0x69, 0xf8, 0xba, 0x15, 0xd2, 0xa8, 0xc5, 0xda, 0xee, 0xff, 0xd7, 0xfb, 0x1e, 0x6e, 0x0e,
+	0x52, 0x28, 0xdd, 0xcb, 0x30, 0xc5, 0xd3, 0x8c, 0x13, 0x67, 0x29, 0xc1, 0x52, 0xb6, 0x2b, 0xbc,
+	0xaf, 0x00, 0xcf, 0x03, 0x78, 0x91, 0xe3, 0xd2, 0x0f, 0x24, 0x69, 0xc7, 0x62, 0x13, 0x63, 0x76,
+	0xd9, 0x8b, 0x9e, 0xca, 0x80, 0x31, 0x05, 0x93, 0xdb, 0xc2, 0x29, 0x99, 0x2d, 0x36, 0xa1, 0x92,
+	0x05, 0x4e, 0x47, 0x24, 0xcd, 0x54, 0x38, 0x22, 0x59, 0x94, 0x8d, 0x48, 0x16, 0x18, 0x33, 0x80,
+	0x5f, 0x9e, 0x2e, 0x3d, 0xa3, 0x78, 0x0c, 0xd3, 0x7d, 0x51, 0xc5, 0x33, 0xe8, 0x1a, 0x74, 0xc6,
+	0x35, 0x6b, 0x3f, 0x46, 0x60, 0xe4, 0x55, 0x7a, 0x13, 0xf1, 0x3e, 0x82, 0xf2, 0x89, 0x03, 0xf1,
+	0xdd, 0x62, 0x87, 0x2a, 0x62, 0x6d, 0xe9, 0x22, 0x98, 0x54, 0x62, 0x2c, 0xed, 0xff, 0xfc, 0xfb,
+	0x75, 0xa8, 0x8a, 0x75, 0xeb, 0xfc, 0xbb, 0xe7, 0x13, 0x8e, 0x3b, 0x30, 0x2a, 0x8b, 0xb1, 0x51,
+	0xd0, 0x39, 0x63, 0xaf, 0x15, 0x62, 0x14, 0x75, 0x4d, 0x50, 0xcf, 0xe3, 0xb9, 0x02, 0x6a, 0xfc,
+	0x19, 0xc1, 0x78, 0xcf, 0x04, 0xf1, 0x72, 0x6e, 0xe7, 0xb3, 0x93, 0xd7, 0xea, 0x17, 0x03, 0x95,
+	0x8e, 0x7b, 0x42, 0x47, 0x0d, 0x2f, 0xe6, 0xea, 0xe8, 0xdd, 0x13, 0xfe, 0x8e, 0xa0, 0xd2, 0xef,
+	0x52, 0x7c, 0x3f, 0xdf, 0x22, 0x79, 0xb7, 0x45, 0x7b, 0x70, 0x29, 0xac, 0x92, 0xb5, 0x22, 0x64,
+	0x2d, 0xe1, 0x3b, 0xb9, 0xb2, 0x06, 0x6e, 0x44, 0xba, 0x1f, 0x69, 0xcb, 0x73, 0xf6, 0xd3, 0xe7,
+	0x7c, 0xad, 0x56, 0x88, 0xb9, 0xd4, 0x7e, 0xa4, 0xed, 0xd7, 0x9f, 0x1d, 0x1c, 0xe9, 0xe8, 0xf0,
+	0x48, 0x47, 0x7f, 0x8e, 0x74, 0xf4, 0xe5, 0x58, 0x2f, 0x1d, 0x1e, 0xeb, 0xa5, 0x5f, 0xc7, 0x7a,
+	0xe9, 0xdd, 0x4a, 0xd3, 0x8b, 0x5b, 0xc9, 0x8e, 0xd9, 0x60, 0xbe, 0x15, 0xed, 0x7a, 0xfc, 0xa1,
+	0x4f, 0x3b, 0x59, 0xa7, 0x4f, 0x3d, 0xbd, 0xc4, 0x13, 0xb1, 0x33, 0x2a, 0x5e, 0xef, 0x47, 0xff,
+	0x06, 0x00, 0xd9, 0xbd, 0xdb, 0x8f, 0x92, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +580,9 @@ type QueryClient interface {
 	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
 	// LastUpdated returns the last height the market map was updated at.
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
+	// PowerThreshold returns the power threshold configured by a market stored
+	// in the x/marketmap module.
+	PowerThreshold(ctx context.Context, in *PowerThresholdRequest, opts ...grpc.CallOption) (*PowerThresholdResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
@@ -505,6 +622,15 @@ func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, o
 	return out, nil
 }
 
+func (c *queryClient) PowerThreshold(ctx context.Context, in *PowerThresholdRequest, opts ...grpc.CallOption) (*PowerThresholdResponse, error) {
+	out := new(PowerThresholdResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/PowerThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
 	out := new(ParamsResponse)
 	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/Params", in, out, opts...)
@@ -524,6 +650,9 @@ type QueryServer interface {
 	Market(context.Context, *MarketRequest) (*MarketResponse, error)
 	// LastUpdated returns the last height the market map was updated at.
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
+	// PowerThreshold returns the power threshold configured by a market stored
+	// in the x/marketmap module.
+	PowerThreshold(context.Context, *PowerThresholdRequest) (*PowerThresholdResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
@@ -541,6 +670,9 @@ func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest)
 func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
 }
+func (*UnimplementedQueryServer) PowerThreshold(ctx context.Context, req *PowerThresholdRequest) (*PowerThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerThreshold not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -603,6 +735,24 @@ func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PowerThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PowerThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/PowerThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PowerThreshold(ctx, req.(*PowerThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
@@ -637,6 +787,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastUpdated",
 			Handler:    _Query_LastUpdated_Handler,
 		},
+		{
+			MethodName: "PowerThreshold",
+			Handler:    _Query_PowerThreshold_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -780,6 +934,79 @@ func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PowerThresholdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PowerThreshold) > 0 {
+		i -= len(m.PowerThreshold)
+		copy(dAtA[i:], m.PowerThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PowerThreshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -947,6 +1174,33 @@ func (m *MarketResponse) Size() (n int) {
 	return n
 }
 
+func (m *PowerThresholdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PowerThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PowerThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsDefault {
+		n += 2
+	}
+	return n
+}
+
 func (m *ParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1344,6 +1598,191 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PowerThresholdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerThresholdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
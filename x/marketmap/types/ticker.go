@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+
 	"github.com/skip-mev/slinky/pkg/json"
 
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
@@ -19,6 +21,11 @@ const (
 	MaxMetadataJSONFieldLength = 16384
 )
 
+// DefaultPowerThreshold is the fraction of the total voting power that must submit a price
+// for a ticker that does not configure a power threshold. We provide a default supermajority
+// threshold of 2/3+.
+var DefaultPowerThreshold = math.LegacyNewDecWithPrec(667, 3)
+
 // NewTicker returns a new Ticker instance. A Ticker represents a price feed for
 // a given asset pair i.e. BTC/USD. The price feed is scaled to a number of decimal
 // places and has a minimum number of providers required to consider the ticker valid.
@@ -52,6 +59,17 @@ func (t *Ticker) ValidateBasic() error {
 		return err
 	}
 
+	if t.PowerThreshold != "" {
+		threshold, err := math.LegacyNewDecFromStr(t.PowerThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid power threshold %s for %s: %w", t.PowerThreshold, t.CurrencyPair.String(), err)
+		}
+
+		if !threshold.IsPositive() || threshold.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("power threshold must be greater than 0 and at most 1; got %s for %s", t.PowerThreshold, t.CurrencyPair.String())
+		}
+	}
+
 	if len(t.Metadata_JSON) > MaxMetadataJSONFieldLength {
 		return fmt.Errorf("metadata json field is longer than maximum length of %d", MaxMetadataJSONFieldLength)
 	}
@@ -68,6 +86,7 @@ func (t *Ticker) Equal(other Ticker) bool {
 	return t.CurrencyPair.Equal(other.CurrencyPair) &&
 		t.Decimals == other.Decimals &&
 		t.MinProviderCount == other.MinProviderCount &&
+		t.PowerThreshold == other.PowerThreshold &&
 		t.Metadata_JSON == other.Metadata_JSON &&
 		t.Enabled == other.Enabled
 }
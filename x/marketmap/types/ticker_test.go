@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+
 	"github.com/skip-mev/slinky/testutil"
 
 	"github.com/stretchr/testify/require"
@@ -134,6 +135,84 @@ func TestTicker(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "0.5",
+			},
+			expErr: false,
+		},
+		{
+			name: "valid power threshold of one",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "1",
+			},
+			expErr: false,
+		},
+		{
+			name: "zero power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "0",
+			},
+			expErr: true,
+		},
+		{
+			name: "negative power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "-0.5",
+			},
+			expErr: true,
+		},
+		{
+			name: "power threshold greater than one",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "1.01",
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "half",
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -257,6 +336,29 @@ func TestTickerEqual(t *testing.T) {
 			exp: false,
 		},
 
+		{
+			name: "different power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "0.5",
+			},
+			other: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   "0.6",
+			},
+			exp: false,
+		},
+
 		{
 			name: "different metadata",
 			ticker: types.Ticker{
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetPriceHistoryCmd(),
+		GetTWAPCmd(),
+		GetMedianPriceCmd(),
 	)
 
 	return cmd
@@ -95,3 +99,120 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPriceHistoryCmd returns the cli-command that queries the price history for a given CurrencyPair. This is essentially a wrapper around the module's
+// QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
+func GetPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [base] [quote]",
+		Short: "Query for the price history of a specified currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price history
+			res, err := qc.GetPriceHistory(cmd.Context(), &types.GetPriceHistoryRequest{
+				CurrencyPair: cp,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTWAPCmd returns the cli-command that queries the time-weighted average price of a given CurrencyPair over a window (in seconds). This is
+// essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn
+// embedded in the clientCtx.
+func GetTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [base] [quote] [window-seconds]",
+		Short: "Query for the time-weighted average price of a specified currency-pair over a window",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair + window from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+			window, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the TWAP
+			res, err := qc.GetTWAP(cmd.Context(), &types.GetTWAPRequest{
+				CurrencyPair:  cp,
+				WindowSeconds: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMedianPriceCmd returns the cli-command that queries the median price of a given CurrencyPair over a window (in seconds). This is
+// essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn
+// embedded in the clientCtx.
+func GetMedianPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "median-price [base] [quote] [window-seconds]",
+		Short: "Query for the median price of a specified currency-pair over a window",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair + window from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+			window, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the median price
+			res, err := qc.GetMedianPrice(cmd.Context(), &types.GetMedianPriceRequest{
+				CurrencyPair:  cp,
+				WindowSeconds: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -24,6 +24,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		if err := k.currencyPairs.Set(ctx, cpg.CurrencyPair.String(), state); err != nil {
 			panic(fmt.Errorf("error in genesis: %w", err))
 		}
+
+		if err := k.setPriceHistory(ctx, cpg.Id, cpg.Nonce, cpg.PriceHistory); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
 	}
 
 	// set the next ID to state
@@ -57,12 +61,18 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	// next, iterate over NonceKey to retrieve any CurrencyPairs that have not yet been traversed (CurrencyPairs w/ no Price info)
 	err = k.IterateCurrencyPairs(ctx, func(cp slinkytypes.CurrencyPair, cps types.CurrencyPairState) {
-		// append the currency pair + state to the genesis state
+		history, err := k.getPriceHistory(ctx, cps.Id)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
+		// append the currency pair + state (and its price history) to the genesis state
 		gs.CurrencyPairGenesis = append(gs.CurrencyPairGenesis, types.CurrencyPairGenesis{
 			CurrencyPair:      cp,
 			Id:                cps.Id,
 			Nonce:             cps.Nonce,
 			CurrencyPairPrice: cps.Price,
+			PriceHistory:      history,
 		})
 	})
 	if err != nil {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -129,3 +130,125 @@ func (q queryServer) GetPrices(goCtx context.Context, req *types.GetPricesReques
 		Prices: prices,
 	}, nil
 }
+
+// GetPriceHistory gets the QuotePrices retained in the module's price history for a given CurrencyPair, ordered from
+// oldest to newest. If the request is nil, or the CurrencyPair is invalid / not tracked by the module, this method fails.
+func (q queryServer) GetPriceHistory(goCtx context.Context, req *types.GetPriceHistoryRequest) (*types.GetPriceHistoryResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	history, err := q.k.GetPriceHistoryForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, fmt.Errorf("no price history reported for CurrencyPair: %s: %w", cp.String(), err)
+	}
+
+	id, decimals, err := q.getIDAndDecimals(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPriceHistoryResponse{
+		Prices:   history,
+		Decimals: decimals,
+		Id:       id,
+	}, nil
+}
+
+// GetTWAP gets the time-weighted average price of a given CurrencyPair over the requested window (ending at the current
+// block time). If the request is nil, the window is zero, or no price history exists for the CurrencyPair, this method fails.
+func (q queryServer) GetTWAP(goCtx context.Context, req *types.GetTWAPRequest) (*types.GetTWAPResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	if req.WindowSeconds == 0 {
+		return nil, fmt.Errorf("window cannot be zero")
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, err := q.k.GetTWAPForCurrencyPair(ctx, cp, time.Duration(req.WindowSeconds)*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("error computing TWAP for CurrencyPair: %s: %w", cp.String(), err)
+	}
+
+	id, decimals, err := q.getIDAndDecimals(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTWAPResponse{
+		Price:    price,
+		Decimals: decimals,
+		Id:       id,
+	}, nil
+}
+
+// GetMedianPrice gets the median of the QuotePrices reported for a given CurrencyPair over the requested window (ending at the
+// current block time). If the request is nil, the window is zero, or no price history exists for the CurrencyPair, this method fails.
+func (q queryServer) GetMedianPrice(goCtx context.Context, req *types.GetMedianPriceRequest) (*types.GetMedianPriceResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	if req.WindowSeconds == 0 {
+		return nil, fmt.Errorf("window cannot be zero")
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, err := q.k.GetMedianPriceForCurrencyPair(ctx, cp, time.Duration(req.WindowSeconds)*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("error computing median price for CurrencyPair: %s: %w", cp.String(), err)
+	}
+
+	id, decimals, err := q.getIDAndDecimals(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetMedianPriceResponse{
+		Price:    price,
+		Decimals: decimals,
+		Id:       id,
+	}, nil
+}
+
+// getIDAndDecimals returns the ID and decimals for the given CurrencyPair.
+func (q queryServer) getIDAndDecimals(ctx sdk.Context, cp slinkytypes.CurrencyPair) (uint64, uint64, error) {
+	id, ok := q.k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
+		return 0, 0, fmt.Errorf("no ID found for CurrencyPair: %s", cp.String())
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return id, decimals, nil
+}
@@ -67,6 +67,12 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// priceHistory is the bounded history of QuotePrices per CP, keyed by (CP ID, nonce).
+	priceHistory collections.Map[collections.Pair[uint64, uint64], types.QuotePrice]
+
+	// priceHistorySize is the number of QuotePrices retained per CP in priceHistory.
+	priceHistorySize uint64
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -77,6 +83,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	mmKeeper types.MarketMapKeeper,
 	authority sdk.AccAddress,
+	opts ...Option,
 ) Keeper {
 	// create a new schema builder
 	sb := collections.NewSchemaBuilder(ss)
@@ -98,6 +105,14 @@ func NewKeeper(
 		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
 		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
 		idIndex:            idMulti,
+		priceHistory: collections.NewMap(
+			sb, types.PriceHistoryKeyPrefix, "price_history",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.QuotePrice](cdc),
+		),
+	}
+
+	for _, opt := range opts {
+		opt(&k)
 	}
 
 	// create the schema
@@ -110,16 +125,20 @@ func NewKeeper(
 	return k
 }
 
-// RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice from the module's store.
+// RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice (and price history) from the module's store.
 func (k *Keeper) RemoveCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
 	// check if the currency pair exists.
-	if !k.HasCurrencyPair(ctx, cp) {
+	id, ok := k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
 		return types.NewCurrencyPairNotExistError(cp)
 	}
 
 	if err := k.currencyPairs.Remove(ctx, cp.String()); err != nil {
 		return err
 	}
+	if err := k.clearPriceHistory(ctx, id); err != nil {
+		return err
+	}
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
@@ -211,7 +230,11 @@ func (k *Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.Currenc
 	}
 
 	// set the updated state
-	return k.currencyPairs.Set(ctx, cp.String(), cps)
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	return k.appendPriceHistory(ctx, cps.Id, cps.Nonce, qp)
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
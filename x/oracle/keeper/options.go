@@ -0,0 +1,12 @@
+package keeper
+
+// Option is a function that enables optional configuration of the x/oracle Keeper.
+type Option func(*Keeper)
+
+// WithPriceHistorySize returns an Option that configures the Keeper to retain the last size
+// QuotePrices of each CurrencyPair in state. A size of zero disables the price history.
+func WithPriceHistorySize(size uint64) Option {
+	return func(k *Keeper) {
+		k.priceHistorySize = size
+	}
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// GetPriceHistorySize returns the number of QuotePrices retained per CurrencyPair in the price history.
+func (k *Keeper) GetPriceHistorySize() uint64 {
+	return k.priceHistorySize
+}
+
+// GetPriceHistoryForCurrencyPair returns the QuotePrices retained in the price history for the given
+// CurrencyPair, ordered from oldest to newest. If the CurrencyPair does not exist, this method errors.
+func (k *Keeper) GetPriceHistoryForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (types.PriceHistory, error) {
+	id, ok := k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
+		return nil, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	return k.getPriceHistory(ctx, id)
+}
+
+// GetTWAPForCurrencyPair returns the time-weighted average price of the given CurrencyPair over the
+// window ending at the current block time. This method errors if the CurrencyPair does not exist, or
+// if no price history is stored for it.
+func (k *Keeper) GetTWAPForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, window time.Duration) (sdkmath.Int, error) {
+	history, err := k.GetPriceHistoryForCurrencyPair(ctx, cp)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if len(history) == 0 {
+		return sdkmath.Int{}, types.NewPriceHistoryNotExistError(cp)
+	}
+
+	end := ctx.BlockTime()
+	return history.TWAP(end.Add(-window), end)
+}
+
+// GetMedianPriceForCurrencyPair returns the median of the QuotePrices reported for the given CurrencyPair
+// over the window ending at the current block time. This method errors if the CurrencyPair does not exist,
+// or if no price history is stored for it.
+func (k *Keeper) GetMedianPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, window time.Duration) (sdkmath.Int, error) {
+	history, err := k.GetPriceHistoryForCurrencyPair(ctx, cp)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if len(history) == 0 {
+		return sdkmath.Int{}, types.NewPriceHistoryNotExistError(cp)
+	}
+
+	return history.Median(ctx.BlockTime().Add(-window))
+}
+
+// appendPriceHistory writes the QuotePrice for the given nonce of a CurrencyPair to the price history, and
+// prunes all entries that are no longer within the last priceHistorySize updates. This is a no-op if the
+// price history is disabled.
+func (k *Keeper) appendPriceHistory(ctx sdk.Context, id, nonce uint64, qp types.QuotePrice) error {
+	if k.priceHistorySize == 0 {
+		return nil
+	}
+
+	if err := k.priceHistory.Set(ctx, collections.Join(id, nonce), qp); err != nil {
+		return err
+	}
+
+	if nonce < k.priceHistorySize {
+		return nil
+	}
+
+	// nonces are incremented by one on every update, so every nonce <= nonce - size is out of range
+	rng := collections.NewPrefixedPairRange[uint64, uint64](id).EndExclusive(nonce - k.priceHistorySize + 1)
+	return k.priceHistory.Clear(ctx, rng)
+}
+
+// getPriceHistory returns the QuotePrices retained in the price history for the given CurrencyPair ID, ordered
+// from oldest to newest.
+func (k *Keeper) getPriceHistory(ctx sdk.Context, id uint64) (types.PriceHistory, error) {
+	it, err := k.priceHistory.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](id))
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// setPriceHistory writes the given price history, ordered from oldest to newest, for the CurrencyPair ID, where the
+// last entry corresponds to the given nonce and each preceding entry to the nonce before it. Only the most recent
+// priceHistorySize entries are retained, i.e. no history is written if the price history is disabled.
+func (k *Keeper) setPriceHistory(ctx sdk.Context, id, nonce uint64, history types.PriceHistory) error {
+	if uint64(len(history)) > k.priceHistorySize {
+		history = history[uint64(len(history))-k.priceHistorySize:]
+	}
+
+	start := nonce - uint64(len(history)) + 1
+	for i, qp := range history {
+		if err := k.priceHistory.Set(ctx, collections.Join(id, start+uint64(i)), qp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clearPriceHistory removes all price history stored for the given CurrencyPair ID.
+func (k *Keeper) clearPriceHistory(ctx sdk.Context, id uint64) error {
+	return k.priceHistory.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint64](id))
+}
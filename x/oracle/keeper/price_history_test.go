@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/keeper"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) SetupWithPriceHistory(size uint64) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ss := runtime.NewKVStoreService(key)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.oracleKeeper = keeper.NewKeeper(ss, encCfg.Codec, nil, moduleAuthAddr, keeper.WithPriceHistorySize(size))
+	s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+
+	s.Require().NotPanics(func() {
+		s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+	})
+}
+
+func (s *KeeperTestSuite) TestPriceHistory() {
+	cp := slinkytypes.NewCurrencyPair("AA", "BB")
+	start := time.Now().UTC()
+
+	setPrices := func(prices ...int64) {
+		for i, price := range prices {
+			s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+				Price:          sdkmath.NewInt(price),
+				BlockTimestamp: start.Add(time.Duration(i) * time.Second),
+				BlockHeight:    uint64(i),
+			}))
+		}
+	}
+
+	s.Run("no history is stored if the price history is disabled", func() {
+		s.SetupWithPriceHistory(0)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		setPrices(100, 200)
+
+		history, err := s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+
+		_, err = s.oracleKeeper.GetTWAPForCurrencyPair(s.ctx, cp, time.Minute)
+		s.Require().Error(err)
+	})
+
+	s.Run("history for a non-existent currency pair errors", func() {
+		s.SetupWithPriceHistory(3)
+
+		_, err := s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().Error(err)
+	})
+
+	s.Run("history is bounded by the configured size", func() {
+		s.SetupWithPriceHistory(3)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		setPrices(100, 200, 300, 400, 500)
+
+		history, err := s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(history, 3)
+		s.Require().Equal(sdkmath.NewInt(300), history[0].Price)
+		s.Require().Equal(sdkmath.NewInt(400), history[1].Price)
+		s.Require().Equal(sdkmath.NewInt(500), history[2].Price)
+	})
+
+	s.Run("history is removed with the currency pair", func() {
+		s.SetupWithPriceHistory(3)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		setPrices(100, 200)
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		history, err := s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+
+	s.Run("TWAP and median over the window ending at the block time", func() {
+		s.SetupWithPriceHistory(10)
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+		setPrices(100, 200, 600)
+		s.ctx = s.ctx.WithBlockTime(start.Add(3 * time.Second))
+
+		twap, err := s.oracleKeeper.GetTWAPForCurrencyPair(s.ctx, cp, 3*time.Second)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(300), twap)
+
+		median, err := s.oracleKeeper.GetMedianPriceForCurrencyPair(s.ctx, cp, 3*time.Second)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), median)
+
+		qs := keeper.NewQueryServer(s.oracleKeeper)
+		res, err := qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: cp, WindowSeconds: 3})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(300), res.Price)
+
+		_, err = qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: cp})
+		s.Require().Error(err)
+
+		historyRes, err := qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().Len(historyRes.Prices, 3)
+	})
+}
+
+func (s *KeeperTestSuite) TestPriceHistoryGenesis() {
+	cp := slinkytypes.NewCurrencyPair("AA", "BB")
+	start := time.Now().UTC()
+
+	history := types.PriceHistory{
+		{Price: sdkmath.NewInt(100), BlockTimestamp: start, BlockHeight: 1},
+		{Price: sdkmath.NewInt(200), BlockTimestamp: start.Add(time.Second), BlockHeight: 2},
+	}
+	gs := types.GenesisState{
+		CurrencyPairGenesis: []types.CurrencyPairGenesis{
+			{
+				CurrencyPair:      cp,
+				CurrencyPairPrice: &history[1],
+				Nonce:             5,
+				Id:                0,
+				PriceHistory:      history,
+			},
+		},
+		NextId: 1,
+	}
+
+	s.Run("price history is imported and exported", func() {
+		s.SetupWithPriceHistory(3)
+		s.oracleKeeper.InitGenesis(s.ctx, gs)
+
+		got, err := s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(got, 2)
+		for i := range history {
+			checkQuotePriceEqual(s.T(), history[i], got[i])
+		}
+
+		// the imported history is pruned relative to the genesis nonce
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{Price: sdkmath.NewInt(300)}))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{Price: sdkmath.NewInt(400)}))
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(egs.CurrencyPairGenesis, 1)
+		s.Require().Equal(uint64(7), egs.CurrencyPairGenesis[0].Nonce)
+
+		exported := egs.CurrencyPairGenesis[0].PriceHistory
+		s.Require().Len(exported, 3)
+		s.Require().Equal(sdkmath.NewInt(200), exported[0].Price)
+		s.Require().Equal(sdkmath.NewInt(300), exported[1].Price)
+		s.Require().Equal(sdkmath.NewInt(400), exported[2].Price)
+	})
+
+	s.Run("only the most recent entries are imported into a smaller price history", func() {
+		s.SetupWithPriceHistory(3)
+		s.oracleKeeper.InitGenesis(s.ctx, gs)
+		exported := s.oracleKeeper.ExportGenesis(s.ctx)
+
+		s.SetupWithPriceHistory(1)
+		s.oracleKeeper.InitGenesis(s.ctx, *exported)
+
+		got, err := s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(got, 1)
+		checkQuotePriceEqual(s.T(), history[1], got[0])
+
+		// the retained entry is keyed by the genesis nonce, so it is pruned by the next update
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{Price: sdkmath.NewInt(300)}))
+
+		got, err = s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(got, 1)
+		s.Require().Equal(sdkmath.NewInt(300), got[0].Price)
+	})
+
+	s.Run("no price history is imported if the price history is disabled", func() {
+		s.SetupWithPriceHistory(0)
+		s.Require().NotPanics(func() {
+			s.oracleKeeper.InitGenesis(s.ctx, gs)
+		})
+
+		got, err := s.oracleKeeper.GetPriceHistoryForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Empty(got)
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Empty(egs.CurrencyPairGenesis[0].PriceHistory)
+	})
+}
@@ -202,6 +202,7 @@ func ProvideModule(in Inputs) Outputs {
 		in.Cdc,
 		in.MarketMapKeeper,
 		authority,
+		keeper.WithPriceHistorySize(in.Config.PriceHistorySize),
 	)
 
 	m := NewAppModule(in.Cdc, oracleKeeper)
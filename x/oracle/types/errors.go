@@ -41,3 +41,15 @@ func NewCurrencyPairAlreadyExistsError(cp slinkytypes.CurrencyPair) CurrencyPair
 func (e CurrencyPairAlreadyExistsError) Error() string {
 	return fmt.Sprintf("currency pair already exists: %s", e.cp)
 }
+
+func NewPriceHistoryNotExistError(cp slinkytypes.CurrencyPair) PriceHistoryNotExistError {
+	return PriceHistoryNotExistError{cp.String()}
+}
+
+type PriceHistoryNotExistError struct {
+	cp string
+}
+
+func (e PriceHistoryNotExistError) Error() string {
+	return fmt.Sprintf("no price history for CurrencyPair: %s", e.cp)
+}
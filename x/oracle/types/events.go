@@ -0,0 +1,13 @@
+package types
+
+// oracle module event types
+
+const (
+	EventTypeHeldBackPrice = "held_back_price"
+
+	AttributeKeyCurrencyPair    = "currency_pair"
+	AttributeKeyPreviousPrice   = "previous_price"
+	AttributeKeyAggregatedPrice = "aggregated_price"
+	AttributeKeyAppliedPrice    = "applied_price"
+	AttributeKeyStatus          = "status"
+)
@@ -21,6 +21,17 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 		return fmt.Errorf("invalid nonce, no price update but non-zero nonce: %v", cpg.Nonce)
 	}
 
+	// the price history is keyed by nonce, so it can retain at most one entry per price update
+	if uint64(len(cpg.PriceHistory)) > cpg.Nonce {
+		return fmt.Errorf("invalid price history, %d entries exceeds nonce: %v", len(cpg.PriceHistory), cpg.Nonce)
+	}
+
+	for _, qp := range cpg.PriceHistory {
+		if err := qp.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid price history: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -168,6 +168,11 @@ type CurrencyPairGenesis struct {
 	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// id is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// price_history is the bounded history of prices for the CurrencyPair,
+	// ordered from oldest to newest. The last entry corresponds to the nonce
+	// above, and each preceding entry to the nonce before it. Only the most
+	// recent entries, up to the configured price history size, are imported.
+	PriceHistory []QuotePrice `protobuf:"bytes,5,rep,name=price_history,json=priceHistory,proto3" json:"price_history"`
 }
 
 func (m *CurrencyPairGenesis) Reset()         { *m = CurrencyPairGenesis{} }
@@ -231,6 +236,13 @@ func (m *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+func (m *CurrencyPairGenesis) GetPriceHistory() []QuotePrice {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -299,39 +311,40 @@ func init() {
 func init() { proto.RegisterFile("slinky/oracle/v1/genesis.proto", fileDescriptor_de36a97821ccc13b) }
 
 var fileDescriptor_de36a97821ccc13b = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0xeb, 0xb6, 0x1b, 0xe0, 0x96, 0xc1, 0xd2, 0x4d, 0x94, 0x0a, 0x92, 0x52, 0x84, 0x54,
-	0x84, 0x66, 0x6b, 0xe5, 0xc2, 0x95, 0xee, 0xc0, 0x7a, 0x40, 0x2a, 0x81, 0x13, 0x97, 0x28, 0x75,
-	0x4c, 0x6a, 0xb5, 0x89, 0xa3, 0xd8, 0xad, 0xd6, 0x37, 0xe0, 0xb8, 0x87, 0xe1, 0x05, 0xb8, 0xf5,
-	0x38, 0x71, 0x02, 0x0e, 0x05, 0xb5, 0x2f, 0x82, 0x62, 0x3b, 0x25, 0x65, 0x3b, 0x70, 0xcb, 0xcf,
-	0xbf, 0x7f, 0xdf, 0xcf, 0xd7, 0x0e, 0xb4, 0xc5, 0x94, 0xc5, 0x93, 0x05, 0xe6, 0xa9, 0x4f, 0xa6,
-	0x14, 0xcf, 0x4f, 0x71, 0x48, 0x63, 0x2a, 0x98, 0x40, 0x49, 0xca, 0x25, 0xb7, 0xee, 0xeb, 0x3c,
-	0xd2, 0x79, 0x34, 0x3f, 0x6d, 0x1d, 0x85, 0x3c, 0xe4, 0x2a, 0x89, 0xb3, 0x2f, 0x5d, 0xd7, 0x72,
-	0x42, 0xce, 0xc3, 0x29, 0xc5, 0x2a, 0x1a, 0xcd, 0x3e, 0x61, 0xc9, 0x22, 0x2a, 0xa4, 0x1f, 0x25,
-	0xa6, 0xe0, 0x21, 0xe1, 0x22, 0xe2, 0xc2, 0xd3, 0x9d, 0x3a, 0x30, 0xa9, 0xa7, 0x46, 0x83, 0x5c,
-	0x24, 0x54, 0x64, 0x12, 0xc8, 0x2c, 0x4d, 0x69, 0x4c, 0x16, 0x5e, 0xe2, 0xb3, 0x54, 0x17, 0x75,
-	0xbe, 0x02, 0x08, 0xdf, 0xcd, 0xb8, 0xa4, 0xc3, 0x94, 0x11, 0x6a, 0xbd, 0x86, 0x7b, 0x49, 0xf6,
-	0xd1, 0x04, 0x6d, 0xd0, 0xbd, 0xd3, 0x7f, 0xb1, 0x5c, 0x39, 0xa5, 0x9f, 0x2b, 0xe7, 0x58, 0x0f,
-	0x16, 0xc1, 0x04, 0x31, 0x8e, 0x23, 0x5f, 0x8e, 0xd1, 0x20, 0x96, 0xdf, 0xbe, 0x9c, 0x40, 0xb3,
-	0x71, 0x10, 0x4b, 0x57, 0x77, 0x5a, 0x6f, 0xe1, 0xbd, 0xd1, 0x94, 0x93, 0x89, 0xb7, 0x95, 0xda,
-	0x2c, 0xb7, 0x41, 0xb7, 0xd6, 0x6b, 0x21, 0x0d, 0x83, 0x72, 0x18, 0xf4, 0x21, 0xaf, 0xe8, 0xdf,
-	0xce, 0x16, 0x5d, 0xfe, 0x72, 0x80, 0x7b, 0xa0, 0x9a, 0xb7, 0x19, 0xeb, 0x09, 0xac, 0xeb, 0x71,
-	0x63, 0xca, 0xc2, 0xb1, 0x6c, 0x56, 0xda, 0xa0, 0x5b, 0x75, 0x6b, 0xea, 0xec, 0x5c, 0x1d, 0x75,
-	0x04, 0x3c, 0x3c, 0x33, 0x68, 0x43, 0x9f, 0xa5, 0xef, 0xa5, 0x2f, 0xa9, 0xf5, 0xaa, 0x48, 0x52,
-	0xeb, 0x3d, 0x42, 0xff, 0x3a, 0x8e, 0xfe, 0x62, 0xf7, 0xab, 0xcb, 0x95, 0x03, 0x72, 0x80, 0x23,
-	0xb8, 0x17, 0xf3, 0x98, 0x50, 0x25, 0xbb, 0xea, 0xea, 0xc0, 0x3a, 0x80, 0x65, 0x16, 0x98, 0xed,
-	0x65, 0x16, 0x74, 0x7e, 0x00, 0xd8, 0x28, 0x6e, 0x7d, 0xa3, 0xef, 0xd7, 0x3a, 0x87, 0x77, 0x77,
-	0x7c, 0x36, 0xfb, 0x1f, 0xe7, 0xfb, 0xd5, 0x6d, 0x64, 0xeb, 0x8b, 0xcd, 0x4a, 0x40, 0xc9, 0xad,
-	0x93, 0xc2, 0x99, 0xe5, 0xc2, 0xc6, 0xce, 0x24, 0x4f, 0xf3, 0x94, 0xff, 0x9b, 0xe7, 0xb0, 0x38,
-	0x6e, 0xb8, 0xcb, 0x56, 0xb9, 0xce, 0x56, 0xdd, 0xb2, 0x7d, 0x06, 0xb0, 0x6e, 0x78, 0xb4, 0x99,
-	0x1e, 0x3c, 0xde, 0x95, 0x62, 0x5e, 0x73, 0x13, 0xb4, 0x2b, 0xdd, 0x5a, 0xef, 0xd9, 0x75, 0x31,
-	0x37, 0x58, 0x63, 0x20, 0x1b, 0xe4, 0x06, 0xd7, 0x1e, 0xc0, 0x5b, 0x31, 0xbd, 0x90, 0x1e, 0x0b,
-	0x8c, 0xeb, 0xfb, 0x59, 0x38, 0x08, 0xfa, 0x67, 0xcb, 0xb5, 0x0d, 0xae, 0xd6, 0x36, 0xf8, 0xbd,
-	0xb6, 0xc1, 0xe5, 0xc6, 0x2e, 0x5d, 0x6d, 0xec, 0xd2, 0xf7, 0x8d, 0x5d, 0xfa, 0xf8, 0x3c, 0x64,
-	0x72, 0x3c, 0x1b, 0x21, 0xc2, 0x23, 0x2c, 0x26, 0x2c, 0x39, 0x89, 0xe8, 0x1c, 0x9b, 0x27, 0x7f,
-	0x91, 0xff, 0x78, 0xca, 0xed, 0xd1, 0xbe, 0x7a, 0x71, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xe8, 0xaa, 0xe3, 0xa1, 0x96, 0x03, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xbd, 0xf9, 0x28, 0xb0, 0x49, 0x0b, 0x75, 0x5a, 0x11, 0x22, 0x70, 0x42, 0x10, 0x52,
+	0x10, 0xea, 0x5a, 0x2d, 0x17, 0xae, 0xa4, 0x87, 0x26, 0x07, 0xa4, 0x60, 0x38, 0x71, 0xb1, 0x9c,
+	0xf5, 0x62, 0xaf, 0x12, 0x7b, 0x2d, 0xef, 0x26, 0x6a, 0xde, 0x80, 0x63, 0x9f, 0x81, 0x67, 0xe0,
+	0x05, 0xb8, 0xe5, 0x58, 0x71, 0x42, 0x1c, 0x02, 0x4a, 0x5e, 0x04, 0x79, 0x77, 0x5d, 0x9c, 0xb6,
+	0x87, 0xde, 0x3c, 0x3b, 0x1f, 0xff, 0xf9, 0xcd, 0x78, 0xa0, 0xc5, 0xa7, 0x34, 0x9e, 0x2c, 0x6c,
+	0x96, 0x7a, 0x78, 0x4a, 0xec, 0xf9, 0xb1, 0x1d, 0x90, 0x98, 0x70, 0xca, 0x51, 0x92, 0x32, 0xc1,
+	0xcc, 0x47, 0xca, 0x8f, 0x94, 0x1f, 0xcd, 0x8f, 0x5b, 0x07, 0x01, 0x0b, 0x98, 0x74, 0xda, 0xd9,
+	0x97, 0x8a, 0x6b, 0xb5, 0x03, 0xc6, 0x82, 0x29, 0xb1, 0xa5, 0x35, 0x9e, 0x7d, 0xb1, 0x05, 0x8d,
+	0x08, 0x17, 0x5e, 0x94, 0xe8, 0x80, 0x27, 0x98, 0xf1, 0x88, 0x71, 0x57, 0x65, 0x2a, 0x43, 0xbb,
+	0x5e, 0xe8, 0x1e, 0xc4, 0x22, 0x21, 0x3c, 0x6b, 0x01, 0xcf, 0xd2, 0x94, 0xc4, 0x78, 0xe1, 0x26,
+	0x1e, 0x4d, 0x55, 0x50, 0xf7, 0x07, 0x80, 0xf0, 0xc3, 0x8c, 0x09, 0x32, 0x4a, 0x29, 0x26, 0xe6,
+	0x3b, 0x58, 0x4d, 0xb2, 0x8f, 0x26, 0xe8, 0x80, 0xde, 0x83, 0xfe, 0xeb, 0xe5, 0xaa, 0x6d, 0xfc,
+	0x5e, 0xb5, 0x0f, 0x55, 0x61, 0xee, 0x4f, 0x10, 0x65, 0x76, 0xe4, 0x89, 0x10, 0x0d, 0x63, 0xf1,
+	0xf3, 0xfb, 0x11, 0xd4, 0x8a, 0xc3, 0x58, 0x38, 0x2a, 0xd3, 0x7c, 0x0f, 0x1f, 0x8e, 0xa7, 0x0c,
+	0x4f, 0xdc, 0xab, 0x56, 0x9b, 0xa5, 0x0e, 0xe8, 0xd5, 0x4e, 0x5a, 0x48, 0xc1, 0xa0, 0x1c, 0x06,
+	0x7d, 0xca, 0x23, 0xfa, 0xf7, 0x33, 0xa1, 0x8b, 0x3f, 0x6d, 0xe0, 0xec, 0xc9, 0xe4, 0x2b, 0x8f,
+	0xf9, 0x1c, 0xd6, 0x55, 0xb9, 0x90, 0xd0, 0x20, 0x14, 0xcd, 0x72, 0x07, 0xf4, 0x2a, 0x4e, 0x4d,
+	0xbe, 0x0d, 0xe4, 0x53, 0x97, 0xc3, 0xfd, 0x53, 0x8d, 0x36, 0xf2, 0x68, 0xfa, 0x51, 0x78, 0x82,
+	0x98, 0x6f, 0x8b, 0x24, 0xb5, 0x93, 0xa7, 0xe8, 0xfa, 0xc4, 0xd1, 0x7f, 0xec, 0x7e, 0x65, 0xb9,
+	0x6a, 0x83, 0x1c, 0xe0, 0x00, 0x56, 0x63, 0x16, 0x63, 0x22, 0xdb, 0xae, 0x38, 0xca, 0x30, 0xf7,
+	0x60, 0x89, 0xfa, 0x5a, 0xbd, 0x44, 0xfd, 0xee, 0xb7, 0x12, 0x6c, 0x14, 0x55, 0xcf, 0xd4, 0x7e,
+	0xcd, 0x01, 0xdc, 0xdd, 0x9a, 0xb3, 0xd6, 0x7f, 0x96, 0xeb, 0xcb, 0x6d, 0x64, 0xf2, 0xc5, 0x64,
+	0xd9, 0x80, 0xe1, 0xd4, 0x71, 0xe1, 0xcd, 0x74, 0x60, 0x63, 0xab, 0x92, 0xab, 0x78, 0x4a, 0x77,
+	0xe6, 0xd9, 0x2f, 0x96, 0x1b, 0x6d, 0xb3, 0x95, 0x6f, 0xb2, 0x55, 0x72, 0x36, 0xf3, 0x0c, 0xee,
+	0x4a, 0x2d, 0x37, 0xa4, 0x5c, 0xb0, 0x74, 0xd1, 0xac, 0x76, 0xca, 0x77, 0xd2, 0x34, 0x9c, 0xba,
+	0x4c, 0x1c, 0xa8, 0xbc, 0xee, 0x57, 0x00, 0xeb, 0x7a, 0x30, 0x6a, 0x2b, 0x2e, 0x3c, 0xdc, 0x66,
+	0xd2, 0x67, 0xd1, 0x04, 0x52, 0xe1, 0xe5, 0x4d, 0x85, 0x5b, 0x66, 0xac, 0xa5, 0x1a, 0xf8, 0x96,
+	0xf1, 0x3f, 0x86, 0xf7, 0x62, 0x72, 0x2e, 0x5c, 0xea, 0xeb, 0xf5, 0xed, 0x64, 0xe6, 0xd0, 0xef,
+	0x9f, 0x2e, 0xd7, 0x16, 0xb8, 0x5c, 0x5b, 0xe0, 0xef, 0xda, 0x02, 0x17, 0x1b, 0xcb, 0xb8, 0xdc,
+	0x58, 0xc6, 0xaf, 0x8d, 0x65, 0x7c, 0x7e, 0x15, 0x50, 0x11, 0xce, 0xc6, 0x08, 0xb3, 0xc8, 0xe6,
+	0x13, 0x9a, 0x1c, 0x45, 0x64, 0x6e, 0xeb, 0xdb, 0x39, 0xcf, 0x2f, 0x58, 0xae, 0x6d, 0xbc, 0x23,
+	0x7f, 0xdd, 0x37, 0xff, 0x06, 0x00, 0xc7, 0x09, 0xbb, 0x3d, 0xdf, 0x03, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -445,6 +458,20 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -586,6 +613,12 @@ func (m *CurrencyPairGenesis) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovGenesis(uint64(m.Id))
 	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1009,6 +1042,40 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, QuotePrice{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
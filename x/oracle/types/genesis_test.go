@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
@@ -114,6 +115,69 @@ func TestGenesisValidation(t *testing.T) {
 			3,
 			false,
 		},
+		{
+			"if the price history is longer than the nonce - fail",
+			[]types.CurrencyPairGenesis{
+				{
+					CurrencyPair: slinkytypes.CurrencyPair{
+						Base:  "AA",
+						Quote: "BB",
+					},
+					CurrencyPairPrice: &types.QuotePrice{
+						Price: sdkmath.NewInt(100),
+					},
+					Nonce: 1,
+					PriceHistory: []types.QuotePrice{
+						{Price: sdkmath.NewInt(90)},
+						{Price: sdkmath.NewInt(100)},
+					},
+				},
+			},
+			1,
+			false,
+		},
+		{
+			"if the price history contains a negative price - fail",
+			[]types.CurrencyPairGenesis{
+				{
+					CurrencyPair: slinkytypes.CurrencyPair{
+						Base:  "AA",
+						Quote: "BB",
+					},
+					CurrencyPairPrice: &types.QuotePrice{
+						Price: sdkmath.NewInt(100),
+					},
+					Nonce: 2,
+					PriceHistory: []types.QuotePrice{
+						{Price: sdkmath.NewInt(-90)},
+						{Price: sdkmath.NewInt(100)},
+					},
+				},
+			},
+			1,
+			false,
+		},
+		{
+			"if the price history is valid - pass",
+			[]types.CurrencyPairGenesis{
+				{
+					CurrencyPair: slinkytypes.CurrencyPair{
+						Base:  "AA",
+						Quote: "BB",
+					},
+					CurrencyPairPrice: &types.QuotePrice{
+						Price: sdkmath.NewInt(100),
+					},
+					Nonce: 5,
+					PriceHistory: []types.QuotePrice{
+						{Price: sdkmath.NewInt(90)},
+						{Price: sdkmath.NewInt(100)},
+					},
+				},
+			},
+			1,
+			true,
+		},
 	}
 
 	for _, tc := range tcs {
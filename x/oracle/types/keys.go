@@ -33,6 +33,10 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// PriceHistoryKeyPrefix is the key-prefix under which the historical QuotePrices of each
+	// currency-pair are stored, keyed by (currency-pair ID, nonce).
+	PriceHistoryKeyPrefix = collections.NewPrefix(6)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
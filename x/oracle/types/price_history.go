@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// PriceHistory is the set of historical QuotePrices stored for a CurrencyPair. The QuotePrices
+// are expected to be ordered by block height (and thus block timestamp) from oldest to newest.
+type PriceHistory []QuotePrice
+
+// TWAP returns the time-weighted average price over the window [start, end]. Each QuotePrice is
+// considered to be in effect from its block timestamp until the block timestamp of the next
+// QuotePrice (or end, for the latest QuotePrice). The QuotePrice in effect at start (if any) is
+// weighted from start onwards. If the history does not cover any time in the window (i.e. all
+// QuotePrices were reported at end), the latest QuotePrice is returned. This method errors if the
+// history is empty, or if no QuotePrice was in effect during the window.
+func (ph PriceHistory) TWAP(start, end time.Time) (sdkmath.Int, error) {
+	if len(ph) == 0 {
+		return sdkmath.Int{}, fmt.Errorf("price history is empty")
+	}
+
+	if end.Before(start) {
+		return sdkmath.Int{}, fmt.Errorf("invalid window: end %s is before start %s", end, start)
+	}
+
+	weighted := sdkmath.ZeroInt()
+	total := sdkmath.ZeroInt()
+	for i := ph.firstInEffect(start); i < len(ph); i++ {
+		qp := ph[i]
+
+		// determine the interval over which the QuotePrice was in effect, clamped to the window
+		segmentStart := qp.BlockTimestamp
+		if segmentStart.Before(start) {
+			segmentStart = start
+		}
+
+		segmentEnd := end
+		if i+1 < len(ph) && ph[i+1].BlockTimestamp.Before(end) {
+			segmentEnd = ph[i+1].BlockTimestamp
+		}
+
+		if !segmentEnd.After(segmentStart) {
+			continue
+		}
+
+		duration := sdkmath.NewInt(segmentEnd.Sub(segmentStart).Milliseconds())
+		weighted = weighted.Add(qp.Price.Mul(duration))
+		total = total.Add(duration)
+	}
+
+	if total.IsZero() {
+		latest := ph[len(ph)-1]
+		if latest.BlockTimestamp.After(end) {
+			return sdkmath.Int{}, fmt.Errorf("no price in effect during window")
+		}
+
+		return latest.Price, nil
+	}
+
+	return weighted.Quo(total), nil
+}
+
+// Median returns the median of the QuotePrices reported at or after start. If no QuotePrice was
+// reported in the window, the latest QuotePrice reported before start (i.e. the price in effect
+// for the entire window) is returned. For an even number of QuotePrices, the median is the
+// average of the two middle prices. This method errors if the history is empty.
+func (ph PriceHistory) Median(start time.Time) (sdkmath.Int, error) {
+	if len(ph) == 0 {
+		return sdkmath.Int{}, fmt.Errorf("price history is empty")
+	}
+
+	prices := make([]sdkmath.Int, 0, len(ph))
+	for _, qp := range ph {
+		if !qp.BlockTimestamp.Before(start) {
+			prices = append(prices, qp.Price)
+		}
+	}
+
+	// no updates in the window, the latest price is in effect for the entire window
+	if len(prices) == 0 {
+		return ph[len(ph)-1].Price, nil
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+
+	middle := len(prices) / 2
+	if len(prices)%2 == 0 {
+		return prices[middle-1].Add(prices[middle]).QuoRaw(2), nil
+	}
+
+	return prices[middle], nil
+}
+
+// firstInEffect returns the index of the QuotePrice that is in effect at start, i.e. the latest
+// QuotePrice reported at or before start. If every QuotePrice was reported after start, 0 is returned.
+func (ph PriceHistory) firstInEffect(start time.Time) int {
+	idx := 0
+	for i, qp := range ph {
+		if qp.BlockTimestamp.After(start) {
+			break
+		}
+
+		idx = i
+	}
+
+	return idx
+}
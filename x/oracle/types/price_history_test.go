@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestPriceHistoryTWAP(t *testing.T) {
+	now := time.Now().UTC()
+
+	tcs := []struct {
+		name     string
+		history  types.PriceHistory
+		start    time.Time
+		end      time.Time
+		expected math.Int
+		expErr   bool
+	}{
+		{
+			"empty history",
+			nil,
+			now.Add(-time.Minute),
+			now,
+			math.Int{},
+			true,
+		},
+		{
+			"single price before the window",
+			types.PriceHistory{
+				{Price: math.NewInt(100), BlockTimestamp: now.Add(-2 * time.Minute)},
+			},
+			now.Add(-time.Minute),
+			now,
+			math.NewInt(100),
+			false,
+		},
+		{
+			"price in effect at start is weighted from start",
+			types.PriceHistory{
+				{Price: math.NewInt(100), BlockTimestamp: now.Add(-2 * time.Minute)},
+				{Price: math.NewInt(200), BlockTimestamp: now.Add(-30 * time.Second)},
+			},
+			now.Add(-time.Minute),
+			now,
+			math.NewInt(150),
+			false,
+		},
+		{
+			"history does not cover the full window",
+			types.PriceHistory{
+				{Price: math.NewInt(100), BlockTimestamp: now.Add(-40 * time.Second)},
+				{Price: math.NewInt(400), BlockTimestamp: now.Add(-10 * time.Second)},
+			},
+			now.Add(-time.Minute),
+			now,
+			math.NewInt(175),
+			false,
+		},
+		{
+			"single price at end",
+			types.PriceHistory{
+				{Price: math.NewInt(100), BlockTimestamp: now},
+			},
+			now.Add(-time.Minute),
+			now,
+			math.NewInt(100),
+			false,
+		},
+		{
+			"end before start",
+			types.PriceHistory{
+				{Price: math.NewInt(100), BlockTimestamp: now},
+			},
+			now,
+			now.Add(-time.Minute),
+			math.Int{},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := tc.history.TWAP(tc.start, tc.end)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.String(), twap.String())
+		})
+	}
+}
+
+func TestPriceHistoryMedian(t *testing.T) {
+	now := time.Now().UTC()
+
+	tcs := []struct {
+		name     string
+		history  types.PriceHistory
+		start    time.Time
+		expected math.Int
+		expErr   bool
+	}{
+		{
+			"empty history",
+			nil,
+			now,
+			math.Int{},
+			true,
+		},
+		{
+			"no prices in the window returns the latest price",
+			types.PriceHistory{
+				{Price: math.NewInt(100), BlockTimestamp: now.Add(-3 * time.Minute)},
+				{Price: math.NewInt(200), BlockTimestamp: now.Add(-2 * time.Minute)},
+			},
+			now.Add(-time.Minute),
+			math.NewInt(200),
+			false,
+		},
+		{
+			"odd number of prices in the window",
+			types.PriceHistory{
+				{Price: math.NewInt(1000), BlockTimestamp: now.Add(-2 * time.Minute)},
+				{Price: math.NewInt(300), BlockTimestamp: now.Add(-30 * time.Second)},
+				{Price: math.NewInt(100), BlockTimestamp: now.Add(-20 * time.Second)},
+				{Price: math.NewInt(200), BlockTimestamp: now.Add(-10 * time.Second)},
+			},
+			now.Add(-time.Minute),
+			math.NewInt(200),
+			false,
+		},
+		{
+			"even number of prices in the window",
+			types.PriceHistory{
+				{Price: math.NewInt(300), BlockTimestamp: now.Add(-30 * time.Second)},
+				{Price: math.NewInt(100), BlockTimestamp: now.Add(-20 * time.Second)},
+				{Price: math.NewInt(200), BlockTimestamp: now.Add(-10 * time.Second)},
+				{Price: math.NewInt(400), BlockTimestamp: now},
+			},
+			now.Add(-time.Minute),
+			math.NewInt(250),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			median, err := tc.history.Median(tc.start)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.String(), median.String())
+		})
+	}
+}
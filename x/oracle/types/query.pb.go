@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -328,6 +330,351 @@ func (m *GetPricesResponse) GetPrices() []GetPriceResponse {
 	return nil
 }
 
+// GetPriceHistoryRequest takes a CurrencyPair whose price history is to be
+// returned.
+type GetPriceHistoryRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+}
+
+func (m *GetPriceHistoryRequest) Reset()         { *m = GetPriceHistoryRequest{} }
+func (m *GetPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryRequest) ProtoMessage()    {}
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{6}
+}
+func (m *GetPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryRequest.Merge(m, src)
+}
+func (m *GetPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPriceHistoryRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+// GetPriceHistoryResponse is the response from the GetPriceHistory grpc method
+// exposed from the x/oracle query service.
+type GetPriceHistoryResponse struct {
+	// prices is the set of historical QuotePrices for the CurrencyPair, ordered
+	// from oldest to newest.
+	Prices []QuotePrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// decimals represents the number of decimals that the quote-prices are
+	// represented in.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// ID represents the identifier for the CurrencyPair.
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GetPriceHistoryResponse) Reset()         { *m = GetPriceHistoryResponse{} }
+func (m *GetPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryResponse) ProtoMessage()    {}
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{7}
+}
+func (m *GetPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryResponse.Merge(m, src)
+}
+func (m *GetPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPriceHistoryResponse) GetPrices() []QuotePrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *GetPriceHistoryResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *GetPriceHistoryResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// GetTWAPRequest takes a CurrencyPair and the size of the window (in seconds,
+// ending at the current block time) over which the TWAP is computed.
+type GetTWAPRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// window_seconds is the length of the window in seconds.
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *GetTWAPRequest) Reset()         { *m = GetTWAPRequest{} }
+func (m *GetTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPRequest) ProtoMessage()    {}
+func (*GetTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{8}
+}
+func (m *GetTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPRequest.Merge(m, src)
+}
+func (m *GetTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTWAPRequest proto.InternalMessageInfo
+
+func (m *GetTWAPRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *GetTWAPRequest) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// GetTWAPResponse is the response from the GetTWAP grpc method exposed from the
+// x/oracle query service.
+type GetTWAPResponse struct {
+	// price is the time-weighted average price over the requested window.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// decimals represents the number of decimals that the price is represented
+	// in.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// ID represents the identifier for the CurrencyPair.
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GetTWAPResponse) Reset()         { *m = GetTWAPResponse{} }
+func (m *GetTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPResponse) ProtoMessage()    {}
+func (*GetTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{9}
+}
+func (m *GetTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPResponse.Merge(m, src)
+}
+func (m *GetTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTWAPResponse proto.InternalMessageInfo
+
+func (m *GetTWAPResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *GetTWAPResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// GetMedianPriceRequest takes a CurrencyPair and the size of the window (in
+// seconds, ending at the current block time) over which the median is
+// computed.
+type GetMedianPriceRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// window_seconds is the length of the window in seconds.
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *GetMedianPriceRequest) Reset()         { *m = GetMedianPriceRequest{} }
+func (m *GetMedianPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMedianPriceRequest) ProtoMessage()    {}
+func (*GetMedianPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{10}
+}
+func (m *GetMedianPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMedianPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMedianPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMedianPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMedianPriceRequest.Merge(m, src)
+}
+func (m *GetMedianPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMedianPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMedianPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMedianPriceRequest proto.InternalMessageInfo
+
+func (m *GetMedianPriceRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *GetMedianPriceRequest) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// GetMedianPriceResponse is the response from the GetMedianPrice grpc method
+// exposed from the x/oracle query service.
+type GetMedianPriceResponse struct {
+	// price is the median price over the requested window.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// decimals represents the number of decimals that the price is represented
+	// in.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// ID represents the identifier for the CurrencyPair.
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GetMedianPriceResponse) Reset()         { *m = GetMedianPriceResponse{} }
+func (m *GetMedianPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMedianPriceResponse) ProtoMessage()    {}
+func (*GetMedianPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{11}
+}
+func (m *GetMedianPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMedianPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMedianPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMedianPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMedianPriceResponse.Merge(m, src)
+}
+func (m *GetMedianPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMedianPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMedianPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMedianPriceResponse proto.InternalMessageInfo
+
+func (m *GetMedianPriceResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *GetMedianPriceResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "slinky.oracle.v1.GetAllCurrencyPairsRequest")
 	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "slinky.oracle.v1.GetAllCurrencyPairsResponse")
@@ -335,46 +682,68 @@ func init() {
 	proto.RegisterType((*GetPriceResponse)(nil), "slinky.oracle.v1.GetPriceResponse")
 	proto.RegisterType((*GetPricesRequest)(nil), "slinky.oracle.v1.GetPricesRequest")
 	proto.RegisterType((*GetPricesResponse)(nil), "slinky.oracle.v1.GetPricesResponse")
+	proto.RegisterType((*GetPriceHistoryRequest)(nil), "slinky.oracle.v1.GetPriceHistoryRequest")
+	proto.RegisterType((*GetPriceHistoryResponse)(nil), "slinky.oracle.v1.GetPriceHistoryResponse")
+	proto.RegisterType((*GetTWAPRequest)(nil), "slinky.oracle.v1.GetTWAPRequest")
+	proto.RegisterType((*GetTWAPResponse)(nil), "slinky.oracle.v1.GetTWAPResponse")
+	proto.RegisterType((*GetMedianPriceRequest)(nil), "slinky.oracle.v1.GetMedianPriceRequest")
+	proto.RegisterType((*GetMedianPriceResponse)(nil), "slinky.oracle.v1.GetMedianPriceResponse")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/query.proto", fileDescriptor_ba8e832073f3a7b0) }
 
 var fileDescriptor_ba8e832073f3a7b0 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xe5, 0x47, 0xd5, 0x1e, 0xf4, 0xd7, 0xd1, 0xc1, 0x72, 0x5d, 0x93, 0x3a, 0x0c, 0x2d,
-	0xa2, 0xb6, 0x52, 0x16, 0x26, 0x04, 0xed, 0x50, 0x60, 0x6a, 0x2d, 0xb1, 0xc0, 0x10, 0xb9, 0xf6,
-	0xc9, 0x9c, 0xe2, 0xf8, 0x5c, 0xdf, 0xd9, 0x22, 0x8c, 0xfc, 0x01, 0x08, 0x89, 0x11, 0xf1, 0xff,
-	0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x72, 0x77, 0x4e, 0x9d, 0xc6, 0x44, 0xd9,
-	0xec, 0x7b, 0xdf, 0xf7, 0xde, 0xf7, 0xde, 0x77, 0x07, 0x0d, 0x16, 0x91, 0xb8, 0x3f, 0x74, 0x68,
-	0xea, 0xf9, 0x11, 0x76, 0xf2, 0xae, 0x73, 0x95, 0xe1, 0x74, 0x68, 0x27, 0x29, 0xe5, 0x14, 0x6d,
-	0x49, 0xd4, 0x96, 0xa8, 0x9d, 0x77, 0xf5, 0x9d, 0x90, 0x86, 0x54, 0x80, 0xce, 0xe4, 0x4b, 0xd6,
-	0xe9, 0x46, 0x48, 0x69, 0x18, 0x61, 0xc7, 0x4b, 0x88, 0xe3, 0xc5, 0x31, 0xe5, 0x1e, 0x27, 0x34,
-	0x66, 0x0a, 0x35, 0xe7, 0x34, 0x42, 0x1c, 0x63, 0x46, 0x0a, 0xbc, 0xa3, 0x70, 0x3e, 0x4c, 0x30,
-	0x9b, 0xc0, 0x7e, 0x96, 0xa6, 0x38, 0xf6, 0x87, 0xbd, 0xc4, 0x23, 0xa9, 0x2c, 0xb2, 0x0c, 0xa8,
-	0x9f, 0x61, 0xfe, 0x32, 0x8a, 0x4e, 0x15, 0x78, 0xee, 0x91, 0x94, 0xb9, 0xf8, 0x2a, 0xc3, 0x8c,
-	0x5b, 0x04, 0xee, 0x56, 0xa2, 0x2c, 0xa1, 0x31, 0xc3, 0xe8, 0x0d, 0xdc, 0x98, 0xe1, 0x64, 0x1a,
-	0x68, 0x37, 0x0e, 0xee, 0x1d, 0xef, 0xd9, 0xca, 0xa0, 0x90, 0xb6, 0xf3, 0xae, 0x5d, 0xee, 0x3f,
-	0x69, 0x5e, 0xff, 0x7e, 0x58, 0x73, 0xd7, 0xfd, 0x32, 0xa7, 0xf5, 0x1e, 0x6e, 0x9e, 0x61, 0x7e,
-	0x9e, 0x12, 0x1f, 0x2b, 0x75, 0xf4, 0x0a, 0xae, 0xcf, 0xd0, 0x6b, 0xa0, 0x0d, 0x96, 0x65, 0xbf,
-	0x5f, 0x66, 0xb7, 0xbe, 0x00, 0xb8, 0x75, 0xcb, 0xae, 0xa6, 0x7f, 0x06, 0x5b, 0xc9, 0xe4, 0x40,
-	0xd1, 0x1a, 0xf6, 0xdd, 0xad, 0xd8, 0x17, 0x19, 0xe5, 0x58, 0x34, 0x09, 0x56, 0xe0, 0xca, 0x06,
-	0xb4, 0x03, 0x5b, 0x31, 0x8d, 0x7d, 0xac, 0xd5, 0xdb, 0xe0, 0xa0, 0xe9, 0xca, 0x1f, 0xa4, 0xc3,
-	0xd5, 0x00, 0xfb, 0x64, 0xe0, 0x45, 0x4c, 0x6b, 0x08, 0x60, 0xfa, 0x8f, 0x36, 0x60, 0x9d, 0x04,
-	0x5a, 0x53, 0x9c, 0xd6, 0x49, 0x60, 0x3d, 0xbf, 0x9d, 0xa7, 0x08, 0x1b, 0x3d, 0x86, 0xdb, 0x33,
-	0x76, 0x7b, 0x24, 0x90, 0x81, 0xae, 0xb9, 0x9b, 0x65, 0x37, 0xaf, 0x03, 0x66, 0xbd, 0x85, 0xdb,
-	0xa5, 0x7e, 0x65, 0xe8, 0x05, 0x5c, 0x11, 0xf3, 0x15, 0x6b, 0xb0, 0xe6, 0x1d, 0xdd, 0x0d, 0x41,
-	0xa5, 0xa5, 0xfa, 0x8e, 0xbf, 0x37, 0x60, 0xeb, 0x62, 0x72, 0x51, 0xd1, 0x0f, 0x00, 0x1f, 0x54,
-	0xac, 0x1e, 0x3d, 0xa9, 0xe4, 0xfc, 0xcf, 0xfd, 0xd1, 0x8f, 0x96, 0xac, 0x96, 0xc3, 0x58, 0x87,
-	0x9f, 0x7f, 0xfe, 0xfd, 0x56, 0xef, 0xa0, 0x7d, 0xa7, 0xe2, 0x6a, 0xf3, 0x9e, 0x17, 0x45, 0x3d,
-	0x4e, 0xfc, 0x3e, 0x4e, 0x19, 0xca, 0xe1, 0x6a, 0xe1, 0x05, 0xed, 0x2f, 0xf2, 0x29, 0x07, 0x59,
-	0x22, 0x0a, 0xab, 0x23, 0xd4, 0xf7, 0xd0, 0x6e, 0xb5, 0xba, 0x5c, 0xfd, 0x27, 0xb8, 0x36, 0x0d,
-	0x1e, 0x2d, 0x60, 0x9d, 0x46, 0xd0, 0x59, 0x58, 0xa3, 0xa4, 0x1f, 0x09, 0x69, 0x13, 0x19, 0x0b,
-	0xa4, 0xd9, 0xc9, 0xe9, 0xf5, 0xc8, 0x04, 0x37, 0x23, 0x13, 0xfc, 0x19, 0x99, 0xe0, 0xeb, 0xd8,
-	0xac, 0xdd, 0x8c, 0xcd, 0xda, 0xaf, 0xb1, 0x59, 0x7b, 0x77, 0x18, 0x12, 0xfe, 0x21, 0xbb, 0xb4,
-	0x7d, 0x3a, 0x70, 0x58, 0x9f, 0x24, 0x47, 0x03, 0x9c, 0x17, 0x54, 0x1f, 0x0b, 0x32, 0xf1, 0x5c,
-	0x2e, 0x57, 0xc4, 0xbb, 0x7f, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x08, 0x5e, 0x0f, 0xa2,
-	0x04, 0x00, 0x00,
+	// 794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x98, 0x1f, 0x85, 0x69, 0xb1, 0x61, 0x0a, 0xd4, 0x5d, 0xcc, 0x62, 0xd6, 0xa0, 0x1a,
+	0x28, 0xbb, 0x82, 0x5e, 0xaa, 0x1e, 0xaa, 0x02, 0x07, 0x43, 0xa5, 0x4a, 0xe0, 0xb6, 0xaa, 0xd4,
+	0x1e, 0xac, 0x65, 0x77, 0x64, 0x46, 0x5e, 0xef, 0x2c, 0x3b, 0x63, 0x53, 0xe7, 0x14, 0x72, 0x49,
+	0x4e, 0x49, 0x24, 0xce, 0xf9, 0x2f, 0x22, 0xe5, 0x5f, 0xe0, 0x88, 0x92, 0x4b, 0x94, 0x03, 0x8a,
+	0x20, 0x7f, 0x48, 0xe4, 0x9d, 0x59, 0xb3, 0xb6, 0x17, 0xe3, 0x48, 0x44, 0xb9, 0x79, 0xe6, 0xbd,
+	0xf7, 0x7d, 0xdf, 0xfb, 0x66, 0xdf, 0x93, 0x61, 0x96, 0x39, 0xc4, 0xad, 0x36, 0x0d, 0xea, 0x9b,
+	0x96, 0x83, 0x8d, 0xc6, 0x86, 0x71, 0x5c, 0xc7, 0x7e, 0x53, 0xf7, 0x7c, 0xca, 0x29, 0x9a, 0x14,
+	0x51, 0x5d, 0x44, 0xf5, 0xc6, 0x86, 0x32, 0x5d, 0xa1, 0x15, 0x1a, 0x04, 0x8d, 0xd6, 0x2f, 0x91,
+	0xa7, 0x7c, 0x6f, 0x51, 0x56, 0xa3, 0xac, 0x2c, 0x02, 0xe2, 0x20, 0x43, 0xd9, 0x0a, 0xa5, 0x15,
+	0x07, 0x1b, 0xa6, 0x47, 0x0c, 0xd3, 0x75, 0x29, 0x37, 0x39, 0xa1, 0x6e, 0x18, 0x55, 0x7b, 0xe8,
+	0x2b, 0xd8, 0xc5, 0x8c, 0x84, 0xf1, 0xbc, 0x8c, 0xf3, 0xa6, 0x87, 0x59, 0x2b, 0x6c, 0xd5, 0x7d,
+	0x1f, 0xbb, 0x56, 0xb3, 0xec, 0x99, 0xc4, 0x17, 0x49, 0x5a, 0x16, 0x2a, 0x45, 0xcc, 0xb7, 0x1c,
+	0x67, 0x47, 0x06, 0xf7, 0x4d, 0xe2, 0xb3, 0x12, 0x3e, 0xae, 0x63, 0xc6, 0x35, 0x02, 0xe7, 0x62,
+	0xa3, 0xcc, 0xa3, 0x2e, 0xc3, 0xe8, 0x77, 0x98, 0xea, 0xc0, 0x64, 0x19, 0x90, 0x1b, 0x2a, 0x7c,
+	0xbd, 0x39, 0xaf, 0xcb, 0xde, 0x03, 0x6a, 0xbd, 0xb1, 0xa1, 0x47, 0xeb, 0xb7, 0x87, 0xcf, 0x2f,
+	0x17, 0x12, 0xa5, 0x09, 0x2b, 0x8a, 0xa9, 0xfd, 0x07, 0xd3, 0x45, 0xcc, 0xf7, 0x7d, 0x62, 0x61,
+	0xc9, 0x8e, 0x76, 0xe1, 0x44, 0x07, 0x7c, 0x06, 0xe4, 0xc0, 0xa0, 0xe8, 0xdf, 0x44, 0xd1, 0xb5,
+	0xa7, 0x00, 0x4e, 0xde, 0xa0, 0x4b, 0xf5, 0x3f, 0xc3, 0x11, 0xaf, 0x75, 0x21, 0x61, 0xb3, 0x7a,
+	0xf7, 0x83, 0xe9, 0x07, 0x75, 0xca, 0x71, 0x50, 0x14, 0xa0, 0x82, 0x92, 0x28, 0x40, 0xd3, 0x70,
+	0xc4, 0xa5, 0xae, 0x85, 0x33, 0xc9, 0x1c, 0x28, 0x0c, 0x97, 0xc4, 0x01, 0x29, 0x70, 0xcc, 0xc6,
+	0x16, 0xa9, 0x99, 0x0e, 0xcb, 0x0c, 0x05, 0x81, 0xf6, 0x19, 0xa5, 0x60, 0x92, 0xd8, 0x99, 0xe1,
+	0xe0, 0x36, 0x49, 0x6c, 0xed, 0xd7, 0x1b, 0x3d, 0xa1, 0xd9, 0x68, 0x15, 0x4e, 0x75, 0xb4, 0x5b,
+	0x26, 0xb6, 0x30, 0x74, 0xbc, 0x94, 0x8e, 0x76, 0xb3, 0x67, 0x33, 0xed, 0x6f, 0x38, 0x15, 0xa9,
+	0x97, 0x0d, 0xfd, 0x06, 0x47, 0x03, 0x7d, 0xe1, 0x33, 0x68, 0xbd, 0x1d, 0x75, 0x9b, 0x20, 0xdd,
+	0x92, 0x75, 0xda, 0x21, 0x9c, 0x0d, 0x33, 0x76, 0x09, 0xe3, 0xd4, 0x6f, 0xde, 0xff, 0x5b, 0x9c,
+	0x02, 0xf8, 0x5d, 0x0f, 0x89, 0xec, 0xe0, 0x97, 0xae, 0x0e, 0xee, 0x7e, 0x93, 0xb6, 0xf6, 0x0e,
+	0xfb, 0x93, 0xb1, 0xf6, 0x0f, 0xb5, 0xed, 0x3f, 0x05, 0x30, 0x55, 0xc4, 0xfc, 0xaf, 0x7f, 0xb6,
+	0xf6, 0xef, 0xbd, 0x41, 0xb4, 0x0c, 0x53, 0x27, 0xc4, 0xb5, 0xe9, 0x49, 0x99, 0x61, 0x8b, 0xba,
+	0x76, 0x28, 0x67, 0x42, 0xdc, 0xfe, 0x29, 0x2e, 0xb5, 0x87, 0x00, 0xa6, 0xdb, 0x1a, 0x64, 0xff,
+	0x5b, 0xd1, 0x4f, 0x72, 0x7c, 0x7b, 0xad, 0x85, 0xfe, 0xee, 0x72, 0x61, 0x46, 0x6c, 0x05, 0x66,
+	0x57, 0x75, 0x42, 0x8d, 0x9a, 0xc9, 0x8f, 0xf4, 0x3d, 0x97, 0xbf, 0x7e, 0xb9, 0x0e, 0x45, 0xa0,
+	0x75, 0x0a, 0xbf, 0xcd, 0x4f, 0xb1, 0xe1, 0x09, 0x80, 0x33, 0x45, 0xcc, 0xff, 0xc0, 0x36, 0x31,
+	0xdd, 0xcf, 0x33, 0x7a, 0x83, 0xba, 0xf1, 0x18, 0xc0, 0xd9, 0x6e, 0x29, 0x5f, 0xc4, 0x94, 0xcd,
+	0x57, 0xa3, 0x70, 0xe4, 0xa0, 0xb5, 0xc7, 0xd1, 0x0b, 0x00, 0xbf, 0x8d, 0x59, 0x7f, 0xe8, 0xc7,
+	0xd8, 0xb9, 0xba, 0x65, 0x87, 0x2a, 0xeb, 0x03, 0x66, 0x8b, 0x6e, 0xb5, 0x95, 0x47, 0x6f, 0x3e,
+	0x9c, 0x25, 0xf3, 0x68, 0xd1, 0x88, 0x59, 0xef, 0xbc, 0x6c, 0x3a, 0x4e, 0x99, 0x13, 0xab, 0x8a,
+	0x7d, 0x86, 0x1a, 0x70, 0x2c, 0x1c, 0x24, 0xb4, 0xd8, 0x6f, 0xd6, 0x85, 0x90, 0x01, 0xd6, 0x81,
+	0x96, 0x0f, 0xd8, 0xe7, 0xd1, 0x5c, 0x3c, 0xbb, 0x70, 0xf3, 0x01, 0x1c, 0x0f, 0x0b, 0x19, 0xea,
+	0x83, 0xda, 0xb6, 0x20, 0xdf, 0x37, 0x47, 0x52, 0x2f, 0x05, 0xd4, 0x2a, 0xca, 0xf6, 0xa1, 0x66,
+	0xe8, 0x0c, 0xc0, 0x74, 0xd7, 0xf6, 0x40, 0x85, 0xdb, 0xe1, 0x3b, 0xb7, 0x98, 0xb2, 0x32, 0x40,
+	0xa6, 0x94, 0xb3, 0x16, 0xc8, 0x59, 0x46, 0xf9, 0x3e, 0x72, 0xca, 0x47, 0x52, 0x81, 0x0f, 0xbf,
+	0x92, 0xa3, 0x8c, 0x72, 0xb1, 0x14, 0x91, 0x4d, 0xa3, 0x2c, 0xf6, 0xc9, 0x90, 0xe4, 0x5a, 0x40,
+	0x9e, 0x45, 0x4a, 0x3c, 0x39, 0x3f, 0x31, 0x3d, 0xf4, 0x4c, 0xec, 0xb0, 0xc8, 0xc4, 0xa0, 0x1f,
+	0x62, 0x91, 0x7b, 0xc7, 0x5b, 0x29, 0xdc, 0x9d, 0x28, 0x95, 0xac, 0x06, 0x4a, 0x96, 0x90, 0x16,
+	0xaf, 0xa4, 0x16, 0x94, 0x08, 0x37, 0xb6, 0x77, 0xce, 0xaf, 0x54, 0x70, 0x71, 0xa5, 0x82, 0xf7,
+	0x57, 0x2a, 0x78, 0x7e, 0xad, 0x26, 0x2e, 0xae, 0xd5, 0xc4, 0xdb, 0x6b, 0x35, 0xf1, 0xef, 0x4a,
+	0x85, 0xf0, 0xa3, 0xfa, 0xa1, 0x6e, 0xd1, 0x9a, 0xc1, 0xaa, 0xc4, 0x5b, 0xaf, 0xe1, 0x46, 0x08,
+	0xf8, 0x7f, 0x08, 0x19, 0xec, 0x94, 0xc3, 0xd1, 0xe0, 0x7f, 0xc9, 0x4f, 0x1f, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0x3b, 0xab, 0xc2, 0xf2, 0x5d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -395,6 +764,17 @@ type QueryClient interface {
 	// that CurrencyPair.
 	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
 	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// Given a CurrencyPair return the QuotePrices retained in the module's
+	// bounded price history for that CurrencyPair, ordered from oldest to newest.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window (in seconds) return the time-weighted
+	// average price of the CurrencyPair over that window, computed from the
+	// module's price history.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Given a CurrencyPair and a window (in seconds) return the median of the
+	// QuotePrices reported for the CurrencyPair over that window, computed from
+	// the module's price history.
+	GetMedianPrice(ctx context.Context, in *GetMedianPriceRequest, opts ...grpc.CallOption) (*GetMedianPriceResponse, error)
 }
 
 type queryClient struct {
@@ -432,6 +812,33 @@ func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMedianPrice(ctx context.Context, in *GetMedianPriceRequest, opts ...grpc.CallOption) (*GetMedianPriceResponse, error) {
+	out := new(GetMedianPriceResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetMedianPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Get all the currency pairs the x/oracle module is tracking price-data for.
@@ -440,6 +847,17 @@ type QueryServer interface {
 	// that CurrencyPair.
 	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
 	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// Given a CurrencyPair return the QuotePrices retained in the module's
+	// bounded price history for that CurrencyPair, ordered from oldest to newest.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window (in seconds) return the time-weighted
+	// average price of the CurrencyPair over that window, computed from the
+	// module's price history.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Given a CurrencyPair and a window (in seconds) return the median of the
+	// QuotePrices reported for the CurrencyPair over that window, computed from
+	// the module's price history.
+	GetMedianPrice(context.Context, *GetMedianPriceRequest) (*GetMedianPriceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -455,6 +873,15 @@ func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequ
 func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
 }
+func (*UnimplementedQueryServer) GetPriceHistory(ctx context.Context, req *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetTWAP(ctx context.Context, req *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (*UnimplementedQueryServer) GetMedianPrice(ctx context.Context, req *GetMedianPriceRequest) (*GetMedianPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedianPrice not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -514,37 +941,103 @@ func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "slinky.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAllCurrencyPairs",
-			Handler:    _Query_GetAllCurrencyPairs_Handler,
-		},
-		{
-			MethodName: "GetPrice",
-			Handler:    _Query_GetPrice_Handler,
-		},
-		{
-			MethodName: "GetPrices",
-			Handler:    _Query_GetPrices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "slinky/oracle/v1/query.proto",
-}
-
-func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMedianPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedianPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMedianPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetMedianPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMedianPrice(ctx, req.(*GetMedianPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "slinky.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllCurrencyPairs",
+			Handler:    _Query_GetAllCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "GetPrice",
+			Handler:    _Query_GetPrice_Handler,
+		},
+		{
+			MethodName: "GetPrices",
+			Handler:    _Query_GetPrices_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "GetMedianPrice",
+			Handler:    _Query_GetMedianPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "slinky/oracle/v1/query.proto",
+}
+
+func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -747,111 +1240,973 @@ func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetAllCurrencyPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetAllCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Decimals != 0 {
-		n += 1 + sovQuery(uint64(m.Decimals))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetMedianPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMedianPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMedianPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMedianPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMedianPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMedianPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetAllCurrencyPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAllCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *GetTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetMedianPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *GetMedianPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, GetPriceResponse{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GetPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -874,12 +2229,45 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -901,7 +2289,7 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -924,15 +2312,15 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -959,11 +2347,49 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, QuotePrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -985,7 +2411,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1008,10 +2434,10 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1047,6 +2473,25 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1068,7 +2513,7 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,17 +2536,17 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1111,48 +2556,27 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
 			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
@@ -1171,7 +2595,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -1211,7 +2635,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMedianPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1234,17 +2658,17 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMedianPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMedianPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1254,24 +2678,44 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1293,7 +2737,7 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetMedianPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1316,17 +2760,17 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMedianPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMedianPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1336,26 +2780,64 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, GetPriceResponse{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])